@@ -48,6 +48,8 @@ type NakamaClient interface {
 	AuthenticateGameCenter(ctx context.Context, in *api.AuthenticateGameCenterRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with Google against the server.
 	AuthenticateGoogle(ctx context.Context, in *api.AuthenticateGoogleRequest, opts ...grpc.CallOption) (*api.Session, error)
+	// Authenticate a user with an OpenID Connect provider configured on the server.
+	AuthenticateOidc(ctx context.Context, in *AuthenticateOidcRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Authenticate a user with Steam against the server.
 	AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest, opts ...grpc.CallOption) (*api.Session, error)
 	// Ban a set of users from a group.
@@ -106,6 +108,8 @@ type NakamaClient interface {
 	LinkGameCenter(ctx context.Context, in *api.AccountGameCenter, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add Google to the social profiles on the current user's account.
 	LinkGoogle(ctx context.Context, in *api.AccountGoogle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Add an OpenID Connect provider identity to the social profiles on the current user's account.
+	LinkOidc(ctx context.Context, in *AccountOidc, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Add Steam to the social profiles on the current user's account.
 	LinkSteam(ctx context.Context, in *api.LinkSteamRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// List a channel's message history.
@@ -144,6 +148,8 @@ type NakamaClient interface {
 	ReadStorageObjects(ctx context.Context, in *api.ReadStorageObjectsRequest, opts ...grpc.CallOption) (*api.StorageObjects, error)
 	// Execute a Lua function on the server.
 	RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.CallOption) (*api.Rpc, error)
+	// Hand the host role of a relayed match held by the current user to another match participant.
+	TransferMatchHost(ctx context.Context, in *TransferMatchHostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove the Apple ID from the social profiles on the current user's account.
 	UnlinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove the custom ID from the social profiles on the current user's account.
@@ -160,6 +166,8 @@ type NakamaClient interface {
 	UnlinkGameCenter(ctx context.Context, in *api.AccountGameCenter, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove Google from the social profiles on the current user's account.
 	UnlinkGoogle(ctx context.Context, in *api.AccountGoogle, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Remove an OpenID Connect provider identity from the social profiles on the current user's account.
+	UnlinkOidc(ctx context.Context, in *AccountOidc, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Remove Steam from the social profiles on the current user's account.
 	UnlinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Update fields in the current user's account.
@@ -300,6 +308,15 @@ func (c *nakamaClient) AuthenticateGoogle(ctx context.Context, in *api.Authentic
 	return out, nil
 }
 
+func (c *nakamaClient) AuthenticateOidc(ctx context.Context, in *AuthenticateOidcRequest, opts ...grpc.CallOption) (*api.Session, error) {
+	out := new(api.Session)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/AuthenticateOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) AuthenticateSteam(ctx context.Context, in *api.AuthenticateSteamRequest, opts ...grpc.CallOption) (*api.Session, error) {
 	out := new(api.Session)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/AuthenticateSteam", in, out, opts...)
@@ -561,6 +578,15 @@ func (c *nakamaClient) LinkGoogle(ctx context.Context, in *api.AccountGoogle, op
 	return out, nil
 }
 
+func (c *nakamaClient) LinkOidc(ctx context.Context, in *AccountOidc, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/LinkOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) LinkSteam(ctx context.Context, in *api.LinkSteamRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/LinkSteam", in, out, opts...)
@@ -732,6 +758,15 @@ func (c *nakamaClient) RpcFunc(ctx context.Context, in *api.Rpc, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *nakamaClient) TransferMatchHost(ctx context.Context, in *TransferMatchHostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/TransferMatchHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) UnlinkApple(ctx context.Context, in *api.AccountApple, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkApple", in, out, opts...)
@@ -804,6 +839,15 @@ func (c *nakamaClient) UnlinkGoogle(ctx context.Context, in *api.AccountGoogle,
 	return out, nil
 }
 
+func (c *nakamaClient) UnlinkOidc(ctx context.Context, in *AccountOidc, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkOidc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nakamaClient) UnlinkSteam(ctx context.Context, in *api.AccountSteam, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/nakama.api.Nakama/UnlinkSteam", in, out, opts...)
@@ -931,6 +975,8 @@ type NakamaServer interface {
 	AuthenticateGameCenter(context.Context, *api.AuthenticateGameCenterRequest) (*api.Session, error)
 	// Authenticate a user with Google against the server.
 	AuthenticateGoogle(context.Context, *api.AuthenticateGoogleRequest) (*api.Session, error)
+	// Authenticate a user with an OpenID Connect provider configured on the server.
+	AuthenticateOidc(context.Context, *AuthenticateOidcRequest) (*api.Session, error)
 	// Authenticate a user with Steam against the server.
 	AuthenticateSteam(context.Context, *api.AuthenticateSteamRequest) (*api.Session, error)
 	// Ban a set of users from a group.
@@ -989,6 +1035,8 @@ type NakamaServer interface {
 	LinkGameCenter(context.Context, *api.AccountGameCenter) (*emptypb.Empty, error)
 	// Add Google to the social profiles on the current user's account.
 	LinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error)
+	// Add an OpenID Connect provider identity to the social profiles on the current user's account.
+	LinkOidc(context.Context, *AccountOidc) (*emptypb.Empty, error)
 	// Add Steam to the social profiles on the current user's account.
 	LinkSteam(context.Context, *api.LinkSteamRequest) (*emptypb.Empty, error)
 	// List a channel's message history.
@@ -1027,6 +1075,8 @@ type NakamaServer interface {
 	ReadStorageObjects(context.Context, *api.ReadStorageObjectsRequest) (*api.StorageObjects, error)
 	// Execute a Lua function on the server.
 	RpcFunc(context.Context, *api.Rpc) (*api.Rpc, error)
+	// Hand the host role of a relayed match held by the current user to another match participant.
+	TransferMatchHost(context.Context, *TransferMatchHostRequest) (*emptypb.Empty, error)
 	// Remove the Apple ID from the social profiles on the current user's account.
 	UnlinkApple(context.Context, *api.AccountApple) (*emptypb.Empty, error)
 	// Remove the custom ID from the social profiles on the current user's account.
@@ -1043,6 +1093,8 @@ type NakamaServer interface {
 	UnlinkGameCenter(context.Context, *api.AccountGameCenter) (*emptypb.Empty, error)
 	// Remove Google from the social profiles on the current user's account.
 	UnlinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error)
+	// Remove an OpenID Connect provider identity from the social profiles on the current user's account.
+	UnlinkOidc(context.Context, *AccountOidc) (*emptypb.Empty, error)
 	// Remove Steam from the social profiles on the current user's account.
 	UnlinkSteam(context.Context, *api.AccountSteam) (*emptypb.Empty, error)
 	// Update fields in the current user's account.
@@ -1108,6 +1160,9 @@ func (UnimplementedNakamaServer) AuthenticateGameCenter(context.Context, *api.Au
 func (UnimplementedNakamaServer) AuthenticateGoogle(context.Context, *api.AuthenticateGoogleRequest) (*api.Session, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateGoogle not implemented")
 }
+func (UnimplementedNakamaServer) AuthenticateOidc(context.Context, *AuthenticateOidcRequest) (*api.Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateOidc not implemented")
+}
 func (UnimplementedNakamaServer) AuthenticateSteam(context.Context, *api.AuthenticateSteamRequest) (*api.Session, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateSteam not implemented")
 }
@@ -1195,6 +1250,9 @@ func (UnimplementedNakamaServer) LinkGameCenter(context.Context, *api.AccountGam
 func (UnimplementedNakamaServer) LinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkGoogle not implemented")
 }
+func (UnimplementedNakamaServer) LinkOidc(context.Context, *AccountOidc) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOidc not implemented")
+}
 func (UnimplementedNakamaServer) LinkSteam(context.Context, *api.LinkSteamRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LinkSteam not implemented")
 }
@@ -1252,6 +1310,9 @@ func (UnimplementedNakamaServer) ReadStorageObjects(context.Context, *api.ReadSt
 func (UnimplementedNakamaServer) RpcFunc(context.Context, *api.Rpc) (*api.Rpc, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcFunc not implemented")
 }
+func (UnimplementedNakamaServer) TransferMatchHost(context.Context, *TransferMatchHostRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferMatchHost not implemented")
+}
 func (UnimplementedNakamaServer) UnlinkApple(context.Context, *api.AccountApple) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlinkApple not implemented")
 }
@@ -1276,6 +1337,9 @@ func (UnimplementedNakamaServer) UnlinkGameCenter(context.Context, *api.AccountG
 func (UnimplementedNakamaServer) UnlinkGoogle(context.Context, *api.AccountGoogle) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlinkGoogle not implemented")
 }
+func (UnimplementedNakamaServer) UnlinkOidc(context.Context, *AccountOidc) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkOidc not implemented")
+}
 func (UnimplementedNakamaServer) UnlinkSteam(context.Context, *api.AccountSteam) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlinkSteam not implemented")
 }
@@ -1538,6 +1602,24 @@ func _Nakama_AuthenticateGoogle_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_AuthenticateOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateOidcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).AuthenticateOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/AuthenticateOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).AuthenticateOidc(ctx, req.(*AuthenticateOidcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_AuthenticateSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AuthenticateSteamRequest)
 	if err := dec(in); err != nil {
@@ -2060,6 +2142,24 @@ func _Nakama_LinkGoogle_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_LinkOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountOidc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).LinkOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/LinkOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).LinkOidc(ctx, req.(*AccountOidc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_LinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.LinkSteamRequest)
 	if err := dec(in); err != nil {
@@ -2402,6 +2502,24 @@ func _Nakama_RpcFunc_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_TransferMatchHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferMatchHostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).TransferMatchHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/TransferMatchHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).TransferMatchHost(ctx, req.(*TransferMatchHostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_UnlinkApple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountApple)
 	if err := dec(in); err != nil {
@@ -2546,6 +2664,24 @@ func _Nakama_UnlinkGoogle_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Nakama_UnlinkOidc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountOidc)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NakamaServer).UnlinkOidc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.api.Nakama/UnlinkOidc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NakamaServer).UnlinkOidc(ctx, req.(*AccountOidc))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Nakama_UnlinkSteam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.AccountSteam)
 	if err := dec(in); err != nil {
@@ -2799,6 +2935,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticateGoogle",
 			Handler:    _Nakama_AuthenticateGoogle_Handler,
 		},
+		{
+			MethodName: "AuthenticateOidc",
+			Handler:    _Nakama_AuthenticateOidc_Handler,
+		},
 		{
 			MethodName: "AuthenticateSteam",
 			Handler:    _Nakama_AuthenticateSteam_Handler,
@@ -2915,6 +3055,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LinkGoogle",
 			Handler:    _Nakama_LinkGoogle_Handler,
 		},
+		{
+			MethodName: "LinkOidc",
+			Handler:    _Nakama_LinkOidc_Handler,
+		},
 		{
 			MethodName: "LinkSteam",
 			Handler:    _Nakama_LinkSteam_Handler,
@@ -2991,6 +3135,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RpcFunc",
 			Handler:    _Nakama_RpcFunc_Handler,
 		},
+		{
+			MethodName: "TransferMatchHost",
+			Handler:    _Nakama_TransferMatchHost_Handler,
+		},
 		{
 			MethodName: "UnlinkApple",
 			Handler:    _Nakama_UnlinkApple_Handler,
@@ -3023,6 +3171,10 @@ var Nakama_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlinkGoogle",
 			Handler:    _Nakama_UnlinkGoogle_Handler,
 		},
+		{
+			MethodName: "UnlinkOidc",
+			Handler:    _Nakama_UnlinkOidc_Handler,
+		},
 		{
 			MethodName: "UnlinkSteam",
 			Handler:    _Nakama_UnlinkSteam_Handler,
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: apigrpc_realtime.proto
+
+package apigrpc
+
+import (
+	context "context"
+	rtapi "github.com/heroiclabs/nakama-common/rtapi"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NakamaRealtimeClient is the client API for NakamaRealtime service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NakamaRealtimeClient interface {
+	// Open a real-time session, sending and receiving the same envelopes as the WebSocket socket. Streams have no
+	// transport pings, so the server sends ping envelopes which the client must answer with pong envelopes.
+	Stream(ctx context.Context, opts ...grpc.CallOption) (NakamaRealtime_StreamClient, error)
+}
+
+type nakamaRealtimeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNakamaRealtimeClient(cc grpc.ClientConnInterface) NakamaRealtimeClient {
+	return &nakamaRealtimeClient{cc}
+}
+
+func (c *nakamaRealtimeClient) Stream(ctx context.Context, opts ...grpc.CallOption) (NakamaRealtime_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NakamaRealtime_ServiceDesc.Streams[0], "/nakama.api.NakamaRealtime/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nakamaRealtimeStreamClient{stream}
+	return x, nil
+}
+
+type NakamaRealtime_StreamClient interface {
+	Send(*rtapi.Envelope) error
+	Recv() (*rtapi.Envelope, error)
+	grpc.ClientStream
+}
+
+type nakamaRealtimeStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *nakamaRealtimeStreamClient) Send(m *rtapi.Envelope) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *nakamaRealtimeStreamClient) Recv() (*rtapi.Envelope, error) {
+	m := new(rtapi.Envelope)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NakamaRealtimeServer is the server API for NakamaRealtime service.
+// All implementations must embed UnimplementedNakamaRealtimeServer
+// for forward compatibility
+type NakamaRealtimeServer interface {
+	// Open a real-time session, sending and receiving the same envelopes as the WebSocket socket. Streams have no
+	// transport pings, so the server sends ping envelopes which the client must answer with pong envelopes.
+	Stream(NakamaRealtime_StreamServer) error
+	mustEmbedUnimplementedNakamaRealtimeServer()
+}
+
+// UnimplementedNakamaRealtimeServer must be embedded to have forward compatible implementations.
+type UnimplementedNakamaRealtimeServer struct {
+}
+
+func (UnimplementedNakamaRealtimeServer) Stream(NakamaRealtime_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedNakamaRealtimeServer) mustEmbedUnimplementedNakamaRealtimeServer() {}
+
+// UnsafeNakamaRealtimeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NakamaRealtimeServer will
+// result in compilation errors.
+type UnsafeNakamaRealtimeServer interface {
+	mustEmbedUnimplementedNakamaRealtimeServer()
+}
+
+func RegisterNakamaRealtimeServer(s grpc.ServiceRegistrar, srv NakamaRealtimeServer) {
+	s.RegisterService(&NakamaRealtime_ServiceDesc, srv)
+}
+
+func _NakamaRealtime_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NakamaRealtimeServer).Stream(&nakamaRealtimeStreamServer{stream})
+}
+
+type NakamaRealtime_StreamServer interface {
+	Send(*rtapi.Envelope) error
+	Recv() (*rtapi.Envelope, error)
+	grpc.ServerStream
+}
+
+type nakamaRealtimeStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *nakamaRealtimeStreamServer) Send(m *rtapi.Envelope) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *nakamaRealtimeStreamServer) Recv() (*rtapi.Envelope, error) {
+	m := new(rtapi.Envelope)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NakamaRealtime_ServiceDesc is the grpc.ServiceDesc for NakamaRealtime service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NakamaRealtime_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nakama.api.NakamaRealtime",
+	HandlerType: (*NakamaRealtimeServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _NakamaRealtime_Stream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "apigrpc_realtime.proto",
+}
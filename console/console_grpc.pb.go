@@ -88,12 +88,26 @@ type ConsoleClient interface {
 	GetMatchState(ctx context.Context, in *MatchStateRequest, opts ...grpc.CallOption) (*MatchState, error)
 	// Send signal to specific match id
 	SendMatchSignal(ctx context.Context, in *MatchSignalRequest, opts ...grpc.CallOption) (*MatchSignalResponse, error)
+	// Kick presences from a running authoritative match.
+	KickMatchPresences(ctx context.Context, in *MatchKickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Broadcast a server message to all presences in a running authoritative match.
+	SendMatchBroadcast(ctx context.Context, in *MatchBroadcastRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Terminate a running authoritative match after a grace period.
+	TerminateMatch(ctx context.Context, in *MatchTerminateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get runtime info
 	GetRuntime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RuntimeInfo, error)
 	// Perform runtime module hotfix
 	HotfixModule(ctx context.Context, in *HotfixModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get current status data for all nodes.
 	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatusList, error)
+	// List named server keys.
+	ListServerKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerKeyList, error)
+	// Create or update a named server key.
+	WriteServerKey(ctx context.Context, in *ServerKey, opts ...grpc.CallOption) (*ServerKey, error)
+	// Replace a named server key with a newly generated key.
+	RotateServerKey(ctx context.Context, in *ServerKeyRequest, opts ...grpc.CallOption) (*ServerKey, error)
+	// Delete a named server key.
+	DeleteServerKey(ctx context.Context, in *ServerKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Get a storage object.
 	GetStorage(ctx context.Context, in *api.ReadStorageObjectId, opts ...grpc.CallOption) (*api.StorageObject, error)
 	// Get a list of the user's wallet transactions.
@@ -448,6 +462,33 @@ func (c *consoleClient) SendMatchSignal(ctx context.Context, in *MatchSignalRequ
 	return out, nil
 }
 
+func (c *consoleClient) KickMatchPresences(ctx context.Context, in *MatchKickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/KickMatchPresences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) SendMatchBroadcast(ctx context.Context, in *MatchBroadcastRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/SendMatchBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) TerminateMatch(ctx context.Context, in *MatchTerminateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/TerminateMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) GetRuntime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RuntimeInfo, error) {
 	out := new(RuntimeInfo)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/GetRuntime", in, out, opts...)
@@ -475,6 +516,42 @@ func (c *consoleClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
+func (c *consoleClient) ListServerKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerKeyList, error) {
+	out := new(ServerKeyList)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/ListServerKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) WriteServerKey(ctx context.Context, in *ServerKey, opts ...grpc.CallOption) (*ServerKey, error) {
+	out := new(ServerKey)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/WriteServerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) RotateServerKey(ctx context.Context, in *ServerKeyRequest, opts ...grpc.CallOption) (*ServerKey, error) {
+	out := new(ServerKey)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/RotateServerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consoleClient) DeleteServerKey(ctx context.Context, in *ServerKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/nakama.console.Console/DeleteServerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *consoleClient) GetStorage(ctx context.Context, in *api.ReadStorageObjectId, opts ...grpc.CallOption) (*api.StorageObject, error) {
 	out := new(api.StorageObject)
 	err := c.cc.Invoke(ctx, "/nakama.console.Console/GetStorage", in, out, opts...)
@@ -795,12 +872,26 @@ type ConsoleServer interface {
 	GetMatchState(context.Context, *MatchStateRequest) (*MatchState, error)
 	// Send signal to specific match id
 	SendMatchSignal(context.Context, *MatchSignalRequest) (*MatchSignalResponse, error)
+	// Kick presences from a running authoritative match.
+	KickMatchPresences(context.Context, *MatchKickRequest) (*emptypb.Empty, error)
+	// Broadcast a server message to all presences in a running authoritative match.
+	SendMatchBroadcast(context.Context, *MatchBroadcastRequest) (*emptypb.Empty, error)
+	// Terminate a running authoritative match after a grace period.
+	TerminateMatch(context.Context, *MatchTerminateRequest) (*emptypb.Empty, error)
 	// Get runtime info
 	GetRuntime(context.Context, *emptypb.Empty) (*RuntimeInfo, error)
 	// Perform runtime module hotfix
 	HotfixModule(context.Context, *HotfixModuleRequest) (*emptypb.Empty, error)
 	// Get current status data for all nodes.
 	GetStatus(context.Context, *emptypb.Empty) (*StatusList, error)
+	// List named server keys.
+	ListServerKeys(context.Context, *emptypb.Empty) (*ServerKeyList, error)
+	// Create or update a named server key.
+	WriteServerKey(context.Context, *ServerKey) (*ServerKey, error)
+	// Replace a named server key with a newly generated key.
+	RotateServerKey(context.Context, *ServerKeyRequest) (*ServerKey, error)
+	// Delete a named server key.
+	DeleteServerKey(context.Context, *ServerKeyRequest) (*emptypb.Empty, error)
 	// Get a storage object.
 	GetStorage(context.Context, *api.ReadStorageObjectId) (*api.StorageObject, error)
 	// Get a list of the user's wallet transactions.
@@ -960,6 +1051,15 @@ func (UnimplementedConsoleServer) GetMatchState(context.Context, *MatchStateRequ
 func (UnimplementedConsoleServer) SendMatchSignal(context.Context, *MatchSignalRequest) (*MatchSignalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMatchSignal not implemented")
 }
+func (UnimplementedConsoleServer) KickMatchPresences(context.Context, *MatchKickRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickMatchPresences not implemented")
+}
+func (UnimplementedConsoleServer) SendMatchBroadcast(context.Context, *MatchBroadcastRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMatchBroadcast not implemented")
+}
+func (UnimplementedConsoleServer) TerminateMatch(context.Context, *MatchTerminateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateMatch not implemented")
+}
 func (UnimplementedConsoleServer) GetRuntime(context.Context, *emptypb.Empty) (*RuntimeInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRuntime not implemented")
 }
@@ -969,6 +1069,18 @@ func (UnimplementedConsoleServer) HotfixModule(context.Context, *HotfixModuleReq
 func (UnimplementedConsoleServer) GetStatus(context.Context, *emptypb.Empty) (*StatusList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
+func (UnimplementedConsoleServer) ListServerKeys(context.Context, *emptypb.Empty) (*ServerKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServerKeys not implemented")
+}
+func (UnimplementedConsoleServer) WriteServerKey(context.Context, *ServerKey) (*ServerKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteServerKey not implemented")
+}
+func (UnimplementedConsoleServer) RotateServerKey(context.Context, *ServerKeyRequest) (*ServerKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateServerKey not implemented")
+}
+func (UnimplementedConsoleServer) DeleteServerKey(context.Context, *ServerKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServerKey not implemented")
+}
 func (UnimplementedConsoleServer) GetStorage(context.Context, *api.ReadStorageObjectId) (*api.StorageObject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStorage not implemented")
 }
@@ -1642,6 +1754,60 @@ func _Console_SendMatchSignal_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_KickMatchPresences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchKickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).KickMatchPresences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/KickMatchPresences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).KickMatchPresences(ctx, req.(*MatchKickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_SendMatchBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).SendMatchBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/SendMatchBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).SendMatchBroadcast(ctx, req.(*MatchBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_TerminateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTerminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).TerminateMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/TerminateMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).TerminateMatch(ctx, req.(*MatchTerminateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_GetRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1696,6 +1862,78 @@ func _Console_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Console_ListServerKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).ListServerKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/ListServerKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).ListServerKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_WriteServerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).WriteServerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/WriteServerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).WriteServerKey(ctx, req.(*ServerKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_RotateServerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).RotateServerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/RotateServerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).RotateServerKey(ctx, req.(*ServerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Console_DeleteServerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsoleServer).DeleteServerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nakama.console.Console/DeleteServerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsoleServer).DeleteServerKey(ctx, req.(*ServerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Console_GetStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api.ReadStorageObjectId)
 	if err := dec(in); err != nil {
@@ -2335,6 +2573,18 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMatchSignal",
 			Handler:    _Console_SendMatchSignal_Handler,
 		},
+		{
+			MethodName: "KickMatchPresences",
+			Handler:    _Console_KickMatchPresences_Handler,
+		},
+		{
+			MethodName: "SendMatchBroadcast",
+			Handler:    _Console_SendMatchBroadcast_Handler,
+		},
+		{
+			MethodName: "TerminateMatch",
+			Handler:    _Console_TerminateMatch_Handler,
+		},
 		{
 			MethodName: "GetRuntime",
 			Handler:    _Console_GetRuntime_Handler,
@@ -2347,6 +2597,22 @@ var Console_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _Console_GetStatus_Handler,
 		},
+		{
+			MethodName: "ListServerKeys",
+			Handler:    _Console_ListServerKeys_Handler,
+		},
+		{
+			MethodName: "WriteServerKey",
+			Handler:    _Console_WriteServerKey_Handler,
+		},
+		{
+			MethodName: "RotateServerKey",
+			Handler:    _Console_RotateServerKey_Handler,
+		},
+		{
+			MethodName: "DeleteServerKey",
+			Handler:    _Console_DeleteServerKey_Handler,
+		},
 		{
 			MethodName: "GetStorage",
 			Handler:    _Console_GetStorage_Handler,
@@ -20,6 +20,7 @@ import {BaseComponent, PageviewGuard} from './base/base.component';
 import {GraphInitNodesResolver, StatusComponent} from './status/status.component';
 import {ConfigComponent, ConfigResolver} from './config/config.component';
 import {UsersComponent, UsersResolver} from './users/users.component';
+import {ServerKeysComponent, ServerKeysResolver} from './serverkeys/serverkeys.component';
 import {RuntimeComponent, RuntimeResolver} from './runtime/runtime.component';
 import {StorageCollectionResolver, StorageListComponent, StorageSearchResolver} from './storage/storage.component';
 import {StorageObjectComponent, StorageObjectResolver} from './storage-object/storage-object.component';
@@ -55,6 +56,7 @@ const routes: Routes = [
       {path: 'status', component: StatusComponent, resolve: [GraphInitNodesResolver]},
       {path: 'config', component: ConfigComponent, resolve: [ConfigResolver]},
       {path: 'users', component: UsersComponent, resolve: [UsersResolver]},
+      {path: 'serverkeys', component: ServerKeysComponent, resolve: [ServerKeysResolver]},
       {path: 'modules', component: RuntimeComponent, resolve: [RuntimeResolver]},
       {path: 'storage', component: StorageListComponent, resolve: [StorageCollectionResolver, StorageSearchResolver], pathMatch: 'full'},
       {path: 'storage/:collection/:key/:user_id', component: StorageObjectComponent, resolve: [StorageObjectResolver], pathMatch: 'full'},
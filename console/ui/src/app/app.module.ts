@@ -36,6 +36,7 @@ import {SortNumbersPipe, StatusComponent} from './status/status.component';
 import {ConfigComponent} from './config/config.component';
 import {ConfigParams} from './console.service';
 import {UsersComponent} from './users/users.component';
+import {ServerKeysComponent} from './serverkeys/serverkeys.component';
 import {NgxFileDropModule} from 'ngx-file-drop';
 import {RuntimeComponent} from './runtime/runtime.component';
 import {StorageListComponent} from './storage/storage.component';
@@ -70,6 +71,7 @@ import {SubscriptionsComponent} from './account/subscriptions/subscriptions.comp
     StatusComponent,
     ConfigComponent,
     UsersComponent,
+    ServerKeysComponent,
     RuntimeComponent,
     StorageListComponent,
     StorageObjectComponent,
@@ -161,6 +161,11 @@ export interface HotfixModuleRequest {
 	module?:string
 }
 
+export interface KickMatchPresencesRequest {
+  // Session IDs of the presences to kick.
+	session_ids?:Array<string>
+}
+
 /** A leaderboard. */
 export interface Leaderboard {
   // Authoritative.
@@ -221,6 +226,8 @@ export enum ListChannelMessagesRequestType {
 /** A list of realtime matches, with their node names. */
 export interface MatchList {
 	matches?:Array<MatchListMatch>
+  // Cursor to fetch the next page of matches, if any.
+	next_cursor?:string
 }
 
 export interface MatchListMatch {
@@ -236,12 +243,28 @@ export interface MatchSignalResponse {
 }
 
 export interface MatchState {
+  // Average tick execution time since the match started, in milliseconds.
+	avg_tick_ms?:number
+  // Number of consecutive ticks, up to the most recent one, that exceeded the tick budget.
+	consecutive_overruns?:string
+  // Deferred message queue depth at the end of the most recent tick.
+	deferred_queue_depth?:number
+  // Input queue depth at the start of the most recent tick.
+	input_queue_depth?:number
+  // Execution time of the most recent tick, in milliseconds.
+	last_tick_ms?:number
+  // Highest tick execution time since the match started, in milliseconds.
+	max_tick_ms?:number
+  // Number of ticks that exceeded the tick budget since the match started.
+	overrun_count?:string
   // Presence list.
 	presences?:Array<RealtimeUserPresence>
   // State.
 	state?:string
   // Current tick number.
 	tick?:string
+  // Tick time budget implied by the match tick rate, in milliseconds.
+	tick_budget_ms?:number
 }
 
 export interface RuntimeInfo {
@@ -266,6 +289,39 @@ export interface RuntimeInfoModuleInfo {
 	path?:string
 }
 
+export interface SendMatchBroadcastRequest {
+  // Message data.
+	data?:string
+  // Op code of the message.
+	op_code?:string
+}
+
+/** A named server key, scoped to the authenticate methods and RPCs it may be used for. */
+export interface ServerKey {
+  // Authenticate methods the key may be used for, such as "AuthenticateDevice" or "SessionRefresh", or "*" for all.
+	authenticate_methods?:Array<string>
+  // When the key was created.
+	create_time?:string
+  // Whether the key is accepted.
+	enabled?:boolean
+  // When the key stops being accepted, unset if it never expires.
+	expire_time?:string
+  // The key clients send, only returned when written or rotated. Generated when empty on create, and kept when empty on update.
+	key?:string
+  // Unique name of the key.
+	name?:string
+  // RPC IDs the key may call as an HTTP key, or "*" for all.
+	rpc_ids?:Array<string>
+  // When the key was last updated.
+	update_time?:string
+}
+
+/** A list of named server keys. */
+export interface ServerKeyList {
+  // Server keys.
+	keys?:Array<ServerKey>
+}
+
 export enum StatusHealth {
   STATUS_HEALTH_OK = 0,
   STATUS_HEALTH_ERROR = 1,
@@ -426,6 +482,23 @@ export interface WalletLedgerList {
 	prev_cursor?:string
 }
 
+export interface WriteServerKeyRequest {
+  // Authenticate methods the key may be used for, such as "AuthenticateDevice" or "SessionRefresh", or "*" for all.
+	authenticate_methods?:Array<string>
+  // When the key was created.
+	create_time?:string
+  // Whether the key is accepted.
+	enabled?:boolean
+  // When the key stops being accepted, unset if it never expires.
+	expire_time?:string
+  // The key clients send, only returned when written or rotated. Generated when empty on create, and kept when empty on update.
+	key?:string
+  // RPC IDs the key may call as an HTTP key, or "*" for all.
+	rpc_ids?:Array<string>
+  // When the key was last updated.
+	update_time?:string
+}
+
 export interface WriteStorageObjectRequest {
   // Read permission value.
 	permission_read?:number
@@ -1249,7 +1322,7 @@ export class ConsoleService {
   }
 
   /** List ongoing matches */
-  listMatches(auth_token: string, limit?: number, authoritative?: boolean, label?: string, min_size?: number, max_size?: number, match_id?: string, query?: string, node?: string): Observable<MatchList> {
+  listMatches(auth_token: string, limit?: number, authoritative?: boolean, label?: string, min_size?: number, max_size?: number, match_id?: string, query?: string, node?: string, sort?: Array<string>, cursor?: string): Observable<MatchList> {
 		const urlPath = `/v2/console/match`;
     let params = new HttpParams();
     if (limit) {
@@ -1276,9 +1349,31 @@ export class ConsoleService {
     if (node) {
       params = params.set('node', node);
     }
+    if (sort) {
+      sort.forEach(e => params = params.append('sort', String(e)))
+    }
+    if (cursor) {
+      params = params.set('cursor', cursor);
+    }
     return this.httpClient.get<MatchList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** Broadcast a server message to all presences in a running authoritative match. */
+  sendMatchBroadcast(auth_token: string, id: string, body: SendMatchBroadcastRequest): Observable<any> {
+		id = encodeURIComponent(String(id))
+		const urlPath = `/v2/console/match/${id}/broadcast`;
+    let params = new HttpParams();
+    return this.httpClient.post(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Kick presences from a running authoritative match. */
+  kickMatchPresences(auth_token: string, id: string, body: KickMatchPresencesRequest): Observable<any> {
+		id = encodeURIComponent(String(id))
+		const urlPath = `/v2/console/match/${id}/kick`;
+    let params = new HttpParams();
+    return this.httpClient.post(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Send signal to specific match id */
   sendMatchSignal(auth_token: string, id: string, signal: string): Observable<MatchSignalResponse> {
 		id = encodeURIComponent(String(id))
@@ -1296,6 +1391,17 @@ export class ConsoleService {
     return this.httpClient.get<MatchState>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** Terminate a running authoritative match after a grace period. */
+  terminateMatch(auth_token: string, id: string, grace_seconds?: number): Observable<any> {
+		id = encodeURIComponent(String(id))
+		const urlPath = `/v2/console/match/${id}/terminate`;
+    let params = new HttpParams();
+    if (grace_seconds) {
+      params = params.set('grace_seconds', String(grace_seconds));
+    }
+    return this.httpClient.post(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Delete messages. */
   deleteChannelMessages(auth_token: string, before?: string, ids?: Array<string>): Observable<DeleteChannelMessagesResponse> {
 		const urlPath = `/v2/console/message`;
@@ -1339,6 +1445,37 @@ export class ConsoleService {
     return this.httpClient.post(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
   }
 
+  /** List named server keys. */
+  listServerKeys(auth_token: string): Observable<ServerKeyList> {
+		const urlPath = `/v2/console/server_key`;
+    let params = new HttpParams();
+    return this.httpClient.get<ServerKeyList>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Delete a named server key. */
+  deleteServerKey(auth_token: string, name: string): Observable<any> {
+		name = encodeURIComponent(String(name))
+		const urlPath = `/v2/console/server_key/${name}`;
+    let params = new HttpParams();
+    return this.httpClient.delete(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Create or update a named server key. */
+  writeServerKey(auth_token: string, name: string, body: WriteServerKeyRequest): Observable<ServerKey> {
+		name = encodeURIComponent(String(name))
+		const urlPath = `/v2/console/server_key/${name}`;
+    let params = new HttpParams();
+    return this.httpClient.post<ServerKey>(this.config.host + urlPath, body, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
+  /** Replace a named server key with a newly generated key. */
+  rotateServerKey(auth_token: string, name: string): Observable<ServerKey> {
+		name = encodeURIComponent(String(name))
+		const urlPath = `/v2/console/server_key/${name}/rotate`;
+    let params = new HttpParams();
+    return this.httpClient.post<ServerKey>(this.config.host + urlPath, { params: params, headers: this.getTokenAuthHeaders(auth_token) })
+  }
+
   /** Get current status data for all nodes. */
   getStatus(auth_token: string): Observable<StatusList> {
 		const urlPath = `/v2/console/status`;
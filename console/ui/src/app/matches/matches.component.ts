@@ -36,8 +36,12 @@ export class MatchesComponent implements OnInit {
   public matchStatesOpen: Array<boolean> = [];
   public matchSignalResult: Array<string> = [];
   public matchSignalError: Array<string> = [];
+  public matchActionResult: Array<string> = [];
+  public matchActionError: Array<string> = [];
   public updated = false;
   public signalForm: UntypedFormGroup;
+  public broadcastForm: UntypedFormGroup;
+  public terminateForm: UntypedFormGroup;
   public searchForm1: UntypedFormGroup;
   public searchForm2: UntypedFormGroup;
   public searchForm3: UntypedFormGroup; //Authoritative
@@ -56,6 +60,12 @@ export class MatchesComponent implements OnInit {
     this.signalForm = this.formBuilder.group({
       signal: '', result: '',
     })
+    this.broadcastForm = this.formBuilder.group({
+      op_code: 0, data: '',
+    });
+    this.terminateForm = this.formBuilder.group({
+      grace_seconds: 0,
+    });
     this.searchForm1 = this.formBuilder.group({
       match_id: '',
     });
@@ -201,6 +211,40 @@ export class MatchesComponent implements OnInit {
       });
   }
 
+  kickMatchPresence(i: number, match: MatchListMatch, presence: RealtimeUserPresence): void {
+    this.consoleService.kickMatchPresences('', match.api_match.match_id, {session_ids: [presence.session_id]}).subscribe(() => {
+      this.matchActionResult[i] = `Kicked ${presence.username}.`;
+      this.matchActionError[i] = null;
+      // Reload the match state to show the remaining presences.
+      this.matchStatesOpen[i] = false;
+      this.getMatchState(i, match);
+    }, err => {
+      this.matchActionResult[i] = null;
+      this.matchActionError[i] = err;
+    });
+  }
+
+  sendMatchBroadcast(i: number, match: MatchListMatch): void {
+    const body = {op_code: String(this.broadcastForm.controls.op_code.value || 0), data: this.broadcastForm.controls.data.value};
+    this.consoleService.sendMatchBroadcast('', match.api_match.match_id, body).subscribe(() => {
+      this.matchActionResult[i] = 'Broadcast sent.';
+      this.matchActionError[i] = null;
+    }, err => {
+      this.matchActionResult[i] = null;
+      this.matchActionError[i] = err;
+    });
+  }
+
+  terminateMatch(i: number, match: MatchListMatch): void {
+    this.consoleService.terminateMatch('', match.api_match.match_id, this.terminateForm.controls.grace_seconds.value).subscribe(() => {
+      this.matchActionResult[i] = 'Match terminating.';
+      this.matchActionError[i] = null;
+    }, err => {
+      this.matchActionResult[i] = null;
+      this.matchActionError[i] = err;
+    });
+  }
+
   getMatchPresencesString(ps: Array<RealtimeUserPresence>): string {
     return JSON.stringify(ps);
   }
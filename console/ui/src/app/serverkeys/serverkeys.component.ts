@@ -0,0 +1,134 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import {Component, Injectable, OnInit} from '@angular/core';
+import {ActivatedRoute, ActivatedRouteSnapshot, Resolve, RouterStateSnapshot} from '@angular/router';
+import {ConsoleService, ServerKey, ServerKeyList, WriteServerKeyRequest} from '../console.service';
+import {UntypedFormBuilder, UntypedFormGroup, Validators} from '@angular/forms';
+import {mergeMap, tap} from 'rxjs/operators';
+import {Observable} from 'rxjs';
+
+@Component({
+  selector: 'app-server-keys',
+  templateUrl: './serverkeys.component.html',
+  styleUrls: ['./serverkeys.component.scss']
+})
+export class ServerKeysComponent implements OnInit {
+  public error = '';
+  public keyCreateError = '';
+  public createdKey: ServerKey | null = null;
+  public keys: Array<ServerKey> = [];
+  public createKeyForm: UntypedFormGroup;
+
+  constructor(
+    private readonly route: ActivatedRoute,
+    private readonly consoleService: ConsoleService,
+    private readonly formBuilder: UntypedFormBuilder,
+  ) {}
+
+  ngOnInit(): void {
+    this.createKeyForm = this.formBuilder.group({
+      name: ['', Validators.required],
+      key: [''],
+      authenticate_methods: ['*'],
+      rpc_ids: [''],
+      expire_time: [''],
+    });
+
+    this.route.data.subscribe(data => {
+      const keys = data[0] as ServerKeyList;
+      this.keys.length = 0;
+      this.keys.push(...(keys.keys || []));
+    }, err => {
+      this.error = err;
+    });
+  }
+
+  public setEnabled(key: ServerKey, enabled: boolean): void {
+    // The existing key is kept when none is sent.
+    const req: WriteServerKeyRequest = {
+      enabled,
+      authenticate_methods: key.authenticate_methods,
+      rpc_ids: key.rpc_ids,
+      expire_time: key.expire_time,
+    };
+    this.updateKeys(this.consoleService.writeServerKey('', key.name, req));
+  }
+
+  public rotateKey(name: string): void {
+    this.updateKeys(this.consoleService.rotateServerKey('', name).pipe(tap(key => this.createdKey = key)));
+  }
+
+  public deleteKey(name: string): void {
+    this.updateKeys(this.consoleService.deleteServerKey('', name));
+  }
+
+  public addKey(): void {
+    this.keyCreateError = '';
+    this.createKeyForm.disable();
+
+    const req: WriteServerKeyRequest = {
+      key: this.f.key.value,
+      enabled: true,
+      authenticate_methods: this.splitList(this.f.authenticate_methods.value),
+      rpc_ids: this.splitList(this.f.rpc_ids.value),
+      expire_time: this.f.expire_time.value ? new Date(this.f.expire_time.value).toISOString() : undefined,
+    };
+
+    this.consoleService.writeServerKey('', this.f.name.value, req).pipe(mergeMap(key => {
+      this.createdKey = key;
+      return this.consoleService.listServerKeys('');
+    })).subscribe(keyList => {
+      this.keyCreateError = '';
+      this.createKeyForm.reset({authenticate_methods: '*'});
+      this.createKeyForm.enable();
+      this.keys.length = 0;
+      this.keys.push(...(keyList.keys || []));
+    }, error => {
+      this.keyCreateError = error;
+      this.createKeyForm.enable();
+    });
+  }
+
+  private updateKeys(action: Observable<any>): void {
+    this.error = '';
+
+    action.pipe(mergeMap(() => {
+      return this.consoleService.listServerKeys('');
+    })).subscribe(keyList => {
+      this.error = '';
+      this.keys.length = 0;
+      this.keys.push(...(keyList.keys || []));
+    }, error => {
+      this.error = error;
+    });
+  }
+
+  private splitList(value: string): Array<string> {
+    return (value || '').split(',').map(v => v.trim()).filter(v => v !== '');
+  }
+
+  get f(): any {
+    return this.createKeyForm.controls;
+  }
+}
+
+@Injectable({providedIn: 'root'})
+export class ServerKeysResolver implements Resolve<ServerKeyList> {
+  constructor(private readonly consoleService: ConsoleService) {}
+
+  resolve(route: ActivatedRouteSnapshot, state: RouterStateSnapshot): Observable<ServerKeyList> {
+    return this.consoleService.listServerKeys('');
+  }
+}
@@ -70,6 +70,8 @@ Dispatcher exposes useful functions to the match. Format:
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+  broadcast_spectator_message = function(op_code, data, presences, sender),
+    -- same as broadcast_message, but only spectators receive it, for example to send them a delayed view of the match
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -130,6 +132,8 @@ Dispatcher exposes useful functions to the match. Format:
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+  broadcast_spectator_message = function(op_code, data, presences, sender),
+    -- same as broadcast_message, but only spectators receive it, for example to send them a delayed view of the match
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -182,6 +186,8 @@ Dispatcher exposes useful functions to the match. Format:
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+  broadcast_spectator_message = function(op_code, data, presences, sender),
+    -- same as broadcast_message, but only spectators receive it, for example to send them a delayed view of the match
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -234,6 +240,8 @@ Dispatcher exposes useful functions to the match. Format:
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+  broadcast_spectator_message = function(op_code, data, presences, sender),
+    -- same as broadcast_message, but only spectators receive it, for example to send them a delayed view of the match
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -293,6 +301,8 @@ Dispatcher exposes useful functions to the match. Format:
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+  broadcast_spectator_message = function(op_code, data, presences, sender),
+    -- same as broadcast_message, but only spectators receive it, for example to send them a delayed view of the match
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
@@ -337,6 +347,8 @@ Dispatcher exposes useful functions to the match. Format:
     -- a data payload string, or nil
     -- list of presences (a subset of match participants) to use as message targets, or nil to send to the whole match
     -- a presence to tag on the message as the 'sender', or nil
+  broadcast_spectator_message = function(op_code, data, presences, sender),
+    -- same as broadcast_message, but only spectators receive it, for example to send them a delayed view of the match
   match_kick = function(presences)
     -- a list of presences to remove from the match
   match_label_update = function(label)
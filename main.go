@@ -15,6 +15,7 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	cryptoRand "crypto/rand"
 	"encoding/binary"
@@ -93,6 +94,29 @@ func main() {
 				os.Exit(1)
 			}
 			return
+		case "replay":
+			// Replay a match recording against the configured runtime modules, without starting the server.
+			// All arguments other than the recording path are used as regular server configuration.
+			args := []string{os.Args[0]}
+			var recordingPath string
+			for i := 2; i < len(os.Args); i++ {
+				if os.Args[i] == "--recording" && i+1 < len(os.Args) {
+					recordingPath = os.Args[i+1]
+					i++
+					continue
+				}
+				args = append(args, os.Args[i])
+			}
+			if recordingPath == "" {
+				tmpLogger.Fatal("A match recording must be set with --recording.")
+			}
+
+			config := server.ParseArgs(tmpLogger, args)
+			logger, _ := server.SetupLogging(tmpLogger, config)
+			if !replayMatch(logger, config, recordingPath) {
+				os.Exit(1)
+			}
+			return
 		}
 	}
 
@@ -134,6 +158,16 @@ func main() {
 
 	// Access to social provider integrations.
 	socialClient := social.NewClient(logger, 5*time.Second)
+	for name, provider := range config.GetSocial().Oidc {
+		socialClient.SetOidcProvider(name, &social.OidcProviderConfig{
+			Issuer:           provider.Issuer,
+			Audiences:        provider.Audiences,
+			JwksUrl:          provider.JwksUrl,
+			DiscoveryUrl:     provider.DiscoveryUrl,
+			UsernameClaim:    provider.UsernameClaim,
+			DisplayNameClaim: provider.DisplayNameClaim,
+		})
+	}
 
 	// Start up server components.
 	cookie := newOrLoadCookie(config)
@@ -145,30 +179,45 @@ func main() {
 	statusRegistry := server.NewStatusRegistry(logger, config, sessionRegistry, jsonpbMarshaler)
 	tracker := server.StartLocalTracker(logger, config, sessionRegistry, statusRegistry, metrics, jsonpbMarshaler)
 	router := server.NewLocalMessageRouter(sessionRegistry, tracker, jsonpbMarshaler)
+	var matchRecorder server.MatchRecorder
+	if config.GetMatch().RecordDir != "" {
+		matchRecorder = server.NewLocalMatchRecorder(logger, startupLogger, router, config.GetMatch().RecordDir)
+	}
 	leaderboardCache := server.NewLocalLeaderboardCache(logger, startupLogger, db)
 	leaderboardRankCache := server.NewLocalLeaderboardRankCache(ctx, startupLogger, db, config.GetLeaderboard(), leaderboardCache)
 	leaderboardScheduler := server.NewLocalLeaderboardScheduler(logger, db, config, leaderboardCache, leaderboardRankCache)
 	googleRefundScheduler := server.NewGoogleRefundScheduler(logger, db, config)
-	matchRegistry := server.NewLocalMatchRegistry(logger, startupLogger, config, sessionRegistry, tracker, router, metrics, config.GetName())
+	matchRegistry := server.NewLocalMatchRegistry(logger, startupLogger, config, sessionRegistry, tracker, router, matchRecorder, metrics, config.GetName())
 	tracker.SetMatchJoinListener(matchRegistry.Join)
 	tracker.SetMatchLeaveListener(matchRegistry.Leave)
+	var matchHostRegistry server.MatchHostRegistry
+	if config.GetMatch().RelayedHostElection {
+		matchHostRegistry = server.NewLocalMatchHostRegistry(logger, router)
+		tracker.SetMatchRelayedJoinListener(matchHostRegistry.Join)
+		tracker.SetMatchRelayedLeaveListener(matchHostRegistry.Leave)
+	}
 	streamManager := server.NewLocalStreamManager(config, sessionRegistry, tracker)
-	runtime, runtimeInfo, err := server.NewRuntime(ctx, logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router)
+	apiLimiter := server.NewApiRateLimiter(config)
+	serverKeys := server.NewLocalServerKeyRegistry(logger, startupLogger, db, config)
+	runtime, runtimeInfo, err := server.NewRuntime(ctx, logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter)
 	if err != nil {
 		startupLogger.Fatal("Failed initializing runtime modules", zap.Error(err))
 	}
-	matchmaker := server.NewLocalMatchmaker(logger, startupLogger, config, router, metrics, runtime)
-	partyRegistry := server.NewLocalPartyRegistry(logger, matchmaker, tracker, streamManager, router, config.GetName())
+	blockGraph := server.NewLocalBlockGraph(logger, db, config)
+	matchmaker := server.NewLocalMatchmaker(logger, startupLogger, config, router, metrics, runtime, blockGraph)
+	matchRegistry.SetMatchmaker(matchmaker)
+	matchRegistry.RestoreMatches(ctx, db, runtime.MatchCreateFunction())
+	partyRegistry := server.NewLocalPartyRegistry(logger, matchmaker, tracker, streamManager, router, blockGraph, config.GetName())
 	tracker.SetPartyJoinListener(partyRegistry.Join)
 	tracker.SetPartyLeaveListener(partyRegistry.Leave)
 
 	leaderboardScheduler.Start(runtime)
 	googleRefundScheduler.Start(runtime)
 
-	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, sessionRegistry, statusRegistry, matchRegistry, partyRegistry, matchmaker, tracker, router, runtime)
+	pipeline := server.NewPipeline(logger, config, db, jsonpbMarshaler, jsonpbUnmarshaler, sessionRegistry, statusRegistry, matchRegistry, matchHostRegistry, partyRegistry, matchmaker, tracker, router, metrics, runtime)
 	statusHandler := server.NewLocalStatusHandler(logger, sessionRegistry, matchRegistry, tracker, metrics, config.GetName())
 
-	apiServer := server.StartApiServer(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, sessionRegistry, sessionCache, statusRegistry, matchRegistry, matchmaker, tracker, router, streamManager, metrics, pipeline, runtime)
+	apiServer := server.StartApiServer(logger, startupLogger, db, jsonpbMarshaler, jsonpbUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, sessionRegistry, sessionCache, statusRegistry, matchRegistry, matchHostRegistry, matchmaker, tracker, router, streamManager, metrics, pipeline, apiLimiter, serverKeys, runtime)
 	consoleServer := server.StartConsoleServer(logger, startupLogger, db, config, tracker, router, streamManager, metrics, sessionCache, consoleSessionCache, loginAttemptCache, statusRegistry, statusHandler, runtimeInfo, matchRegistry, configWarnings, semver, leaderboardCache, leaderboardRankCache, leaderboardScheduler, apiServer, runtime, cookie)
 	consulService := server.StartConsulAgent(logger, startupLogger, db, config, semver)
 
@@ -232,6 +281,9 @@ func main() {
 	apiServer.Stop()
 	consoleServer.Stop()
 	matchmaker.Stop()
+	if blockGraph != nil {
+		blockGraph.Stop()
+	}
 	leaderboardScheduler.Stop()
 	tracker.Stop()
 	statusRegistry.Stop()
@@ -239,6 +291,8 @@ func main() {
 	sessionRegistry.Stop()
 	metrics.Stop(logger)
 	loginAttemptCache.Stop()
+	apiLimiter.Stop()
+	serverKeys.Stop()
 
 	if gaenabled {
 		_ = ga.SendSessionStop(telemetryClient, gacode, cookie)
@@ -281,3 +335,28 @@ func newOrLoadCookie(config server.Config) string {
 	}
 	return cookie.String()
 }
+
+// Help reproduce desyncs and cheating reports offline by replaying a match recording, and report any divergence from it.
+func replayMatch(logger *zap.Logger, config server.Config, path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		logger.Error("Could not open match recording", zap.String("path", path), zap.Error(err))
+		return false
+	}
+	defer file.Close()
+
+	diffs, err := server.ReplayMatchRecording(context.Background(), logger, jsonpbMarshaler, jsonpbUnmarshaler, config, version, bufio.NewReader(file))
+	for _, diff := range diffs {
+		fmt.Println(diff.String())
+	}
+	if err != nil {
+		logger.Error("Match replay failed", zap.String("path", path), zap.Error(err))
+		return false
+	}
+	if len(diffs) != 0 {
+		logger.Warn("Match replay diverged from recording", zap.String("path", path), zap.Int("diffs", len(diffs)))
+		return false
+	}
+	logger.Info("Match replay matched recording", zap.String("path", path))
+	return true
+}
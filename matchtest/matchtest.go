@@ -0,0 +1,594 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package matchtest runs authoritative match handlers in-process for tests, without a server. Matches are driven by
+// the same match handler used in production, but only tick when told to, and every broadcast, kick and label update is
+// captured for assertions.
+package matchtest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/server"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Node is the name of the node every test match and presence runs on.
+const Node = "matchtest"
+
+var (
+	ErrMatchStopped       = errors.New("match stopped")
+	ErrMatchNotFound      = errors.New("match handler not found")
+	ErrUnsupportedMatchOp = errors.New("not supported by matchtest")
+)
+
+// CoreFactory creates the match core under test. The match registry and message router must be passed to the core, so
+// its label updates, kicks and broadcasts are captured.
+type CoreFactory func(logger *zap.Logger, config server.Config, matchRegistry server.MatchRegistry, router server.MessageRouter, id uuid.UUID, node string, stopped *atomic.Bool) (server.RuntimeMatchCore, error)
+
+// GoMatch returns a factory for a Go match core running the given match. The database and Nakama module are passed to
+// the match as-is, and may be nil if the match does not use them.
+func GoMatch(name string, match runtime.Match, db *sql.DB, nk runtime.NakamaModule) CoreFactory {
+	return func(logger *zap.Logger, config server.Config, matchRegistry server.MatchRegistry, router server.MessageRouter, id uuid.UUID, node string, stopped *atomic.Bool) (server.RuntimeMatchCore, error) {
+		return server.NewRuntimeGoMatchCore(logger, name, matchRegistry, router, id, node, "", stopped, db, map[string]string{}, nk, match)
+	}
+}
+
+// LuaMatch returns a factory for a Lua match core running the named module, loaded from the runtime modules in path.
+// The runtime has no database, so the module may only use Nakama functions that do not need one.
+func LuaMatch(path, module string) CoreFactory {
+	return runtimeMatch(path, "", module)
+}
+
+// JSMatch returns a factory for a JavaScript match core running the match registered under name by the entrypoint in
+// path. An empty entrypoint uses the runtime default. As with Lua, the runtime has no database.
+func JSMatch(path, entrypoint, name string) CoreFactory {
+	return runtimeMatch(path, entrypoint, name)
+}
+
+func runtimeMatch(path, entrypoint, name string) CoreFactory {
+	return func(logger *zap.Logger, config server.Config, matchRegistry server.MatchRegistry, router server.MessageRouter, id uuid.UUID, node string, stopped *atomic.Bool) (server.RuntimeMatchCore, error) {
+		config, err := config.Clone()
+		if err != nil {
+			return nil, err
+		}
+		config.GetRuntime().Path = path
+		config.GetRuntime().JsEntrypoint = entrypoint
+		config.GetMetrics().PrometheusPort = 0
+		metrics := server.NewLocalMetrics(logger, logger, nil, config)
+
+		marshaler := &protojson.MarshalOptions{UseEnumNumbers: true, UseProtoNames: true}
+		unmarshaler := &protojson.UnmarshalOptions{}
+		rt, _, err := server.NewRuntime(context.Background(), logger, logger, nil, marshaler, unmarshaler, config, "", nil, nil, nil, nil, nil, nil, nil, matchRegistry, nil, metrics, nil, router, nil)
+		if err != nil {
+			return nil, err
+		}
+		core, err := rt.MatchCreateFunction()(context.Background(), logger, id, node, stopped, router, name)
+		if err != nil {
+			return nil, err
+		}
+		if core == nil {
+			return nil, fmt.Errorf("%w: %v", ErrMatchNotFound, name)
+		}
+		return core, nil
+	}
+}
+
+// Broadcast is a message sent by the match.
+type Broadcast struct {
+	// Presences the message was sent to, nil if it was sent to every presence in the match.
+	Recipients []*server.PresenceID
+	Envelope   *rtapi.Envelope
+	Reliable   bool
+	// Set if the message was deferred to the end of the tick.
+	Deferred bool
+}
+
+// Harness drives a single match. It is safe to use from one test goroutine at a time.
+type Harness struct {
+	handler *server.MatchHandler
+	router  *router
+	reg     *registry
+	session *sessionRegistry
+	stopped *atomic.Bool
+	ctx     context.Context
+}
+
+// New initialises a match with the given params. The match does not tick until Tick is called.
+func New(logger *zap.Logger, config server.Config, factory CoreFactory, params map[string]interface{}) (*Harness, error) {
+	if config == nil {
+		config = server.NewConfig(logger)
+	}
+
+	id := uuid.Must(uuid.NewV4())
+	stopped := atomic.NewBool(false)
+	h := &Harness{
+		router:  &router{},
+		reg:     &registry{},
+		session: &sessionRegistry{},
+		stopped: stopped,
+		ctx:     context.Background(),
+	}
+
+	core, err := factory(logger, config, h.reg, h.router, id, Node, stopped)
+	if err != nil {
+		return nil, err
+	}
+	handler, err := server.NewSteppedMatchHandler(logger, config, h.session, h.reg, h.router, nil, nil, core, id, Node, stopped, params)
+	if err != nil {
+		return nil, err
+	}
+	h.handler = handler
+	if err := h.unsupported(); err != nil {
+		handler.Stop()
+		return nil, err
+	}
+	return h, nil
+}
+
+// NewPresence creates a presence for a new user and session on the test node.
+func NewPresence(username string) *server.MatchPresence {
+	return &server.MatchPresence{
+		Node:      Node,
+		UserID:    uuid.Must(uuid.NewV4()),
+		SessionID: uuid.Must(uuid.NewV4()),
+		Username:  username,
+	}
+}
+
+// ID returns the full match ID.
+func (h *Harness) ID() string {
+	return h.handler.IDStr
+}
+
+// Handler returns the underlying match handler.
+func (h *Harness) Handler() *server.MatchHandler {
+	return h.handler
+}
+
+// Tick runs the match loop once, with any data sent since the previous tick.
+func (h *Harness) Tick() error {
+	if !h.handler.QueueLoop() {
+		return ErrMatchStopped
+	}
+	return h.sync()
+}
+
+// Advance runs the given number of ticks, stopping early if the match stops.
+func (h *Harness) Advance(ticks int) error {
+	for i := 0; i < ticks; i++ {
+		if err := h.Tick(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JoinAttempt asks the match whether the presence may join with the given metadata. Allowed presences join the match
+// immediately, as they do once tracked in production.
+func (h *Harness) JoinAttempt(presence *server.MatchPresence, metadata map[string]string) (bool, string, error) {
+	resultCh := make(chan *server.MatchJoinAttemptResult, 1)
+	if !h.handler.QueueJoinAttempt(h.ctx, resultCh, presence.UserID, presence.SessionID, presence.Username, time.Now().Add(time.Hour).Unix(), map[string]string{}, "", "", presence.Node, metadata) {
+		return false, "", ErrMatchStopped
+	}
+	result, ok := wait(h, resultCh)
+	if !ok {
+		return false, "", ErrMatchStopped
+	}
+	if result.Allow && !h.handler.QueueJoin([]*server.MatchPresence{presence}, true) {
+		return false, "", ErrMatchStopped
+	}
+	return result.Allow, result.Reason, h.sync()
+}
+
+// Join attempts to join every presence, and fails if any are rejected.
+func (h *Harness) Join(presences ...*server.MatchPresence) error {
+	for _, presence := range presences {
+		allow, reason, err := h.JoinAttempt(presence, nil)
+		if err != nil {
+			return err
+		}
+		if !allow {
+			return errors.New("join rejected for " + presence.Username + ": " + reason)
+		}
+	}
+	return nil
+}
+
+// Leave removes presences from the match.
+func (h *Harness) Leave(presences ...*server.MatchPresence) error {
+	if !h.handler.QueueLeave(presences) {
+		return ErrMatchStopped
+	}
+	return h.sync()
+}
+
+// SendData queues a match data message from the presence for the next tick, subject to any data rate limits. Reports
+// whether the message was queued.
+func (h *Harness) SendData(presence *server.MatchPresence, opCode int64, data []byte, reliable bool) bool {
+	if h.stopped.Load() || !h.handler.AllowData(presence.UserID, presence.SessionID, presence.Node, opCode) {
+		return false
+	}
+	h.handler.QueueData(&server.MatchDataMessage{
+		UserID:      presence.UserID,
+		SessionID:   presence.SessionID,
+		Username:    presence.Username,
+		Node:        presence.Node,
+		OpCode:      opCode,
+		Data:        data,
+		Reliable:    reliable,
+		ReceiveTime: time.Now().UTC().UnixNano() / int64(time.Millisecond),
+	})
+	return true
+}
+
+// Signal sends a signal to the match and returns its result.
+func (h *Harness) Signal(data string) (string, error) {
+	resultCh := make(chan *server.MatchSignalResult, 1)
+	if !h.handler.QueueSignal(h.ctx, resultCh, data) {
+		return "", ErrMatchStopped
+	}
+	result, ok := wait(h, resultCh)
+	if !ok || !result.Success {
+		return "", ErrMatchStopped
+	}
+	return result.Result, h.sync()
+}
+
+// Terminate asks the match to terminate with the given grace period. The match keeps running until it stops itself.
+func (h *Harness) Terminate(graceSeconds int) error {
+	if !h.handler.QueueTerminate(graceSeconds) {
+		return ErrMatchStopped
+	}
+	return h.sync()
+}
+
+// State returns the current tick, presences and state snapshot of the match.
+func (h *Harness) State() (int64, []*server.MatchPresence, string, error) {
+	resultCh := make(chan *server.MatchGetStateResult, 1)
+	if !h.handler.QueueGetState(h.ctx, resultCh) {
+		return 0, nil, "", ErrMatchStopped
+	}
+	result, ok := wait(h, resultCh)
+	if !ok {
+		return 0, nil, "", ErrMatchStopped
+	}
+	return result.Tick, result.Presences, result.State, result.Error
+}
+
+// Label returns the current match label.
+func (h *Harness) Label() string {
+	return h.handler.Label()
+}
+
+// Labels returns every label set by the match, in order.
+func (h *Harness) Labels() []string {
+	h.reg.Lock()
+	defer h.reg.Unlock()
+	return append([]string(nil), h.reg.labels...)
+}
+
+// Broadcasts returns and clears the messages sent by the match since the last call.
+func (h *Harness) Broadcasts() []*Broadcast {
+	h.router.Lock()
+	defer h.router.Unlock()
+	broadcasts := h.router.broadcasts
+	h.router.broadcasts = nil
+	return broadcasts
+}
+
+// Kicks returns every presence kicked by the match, in order.
+func (h *Harness) Kicks() []*server.MatchPresence {
+	h.reg.Lock()
+	defer h.reg.Unlock()
+	return append([]*server.MatchPresence(nil), h.reg.kicks...)
+}
+
+// Disconnects returns the sessions the match handler disconnected after an error in the match.
+func (h *Harness) Disconnects() []uuid.UUID {
+	h.session.Lock()
+	defer h.session.Unlock()
+	return append([]uuid.UUID(nil), h.session.disconnects...)
+}
+
+// Stopped reports whether the match has stopped.
+func (h *Harness) Stopped() bool {
+	return h.stopped.Load()
+}
+
+// Stop stops the match without calling its terminate handler.
+func (h *Harness) Stop() {
+	h.handler.Stop()
+}
+
+// Wait for every call queued so far to complete, then report the first server call the match made that matchtest
+// does not support.
+func (h *Harness) sync() error {
+	h.settle()
+	return h.unsupported()
+}
+
+// Wait for every call queued so far to complete. Presences kicked along the way leave the match, as they do once
+// untracked in production, and the wait continues until there are none left.
+func (h *Harness) settle() {
+	for {
+		if h.stopped.Load() {
+			return
+		}
+		// Calls are processed in order, so once the state is returned every earlier call has completed.
+		resultCh := make(chan *server.MatchGetStateResult, 1)
+		if !h.handler.QueueGetState(h.ctx, resultCh) {
+			return
+		}
+		if _, ok := wait(h, resultCh); !ok {
+			return
+		}
+
+		h.reg.Lock()
+		leaves := h.reg.pending
+		h.reg.pending = nil
+		h.reg.Unlock()
+		if len(leaves) == 0 || !h.handler.QueueLeave(leaves) {
+			return
+		}
+	}
+}
+
+// The first unsupported match registry or session registry call, if any.
+func (h *Harness) unsupported() error {
+	if err := h.reg.failure(); err != nil {
+		return err
+	}
+	return h.session.failure()
+}
+
+// Wait for the result of a queued call, unless the match stops before processing it.
+func wait[T any](h *Harness, resultCh <-chan T) (T, bool) {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case result := <-resultCh:
+			return result, true
+		case <-ticker.C:
+			if h.stopped.Load() {
+				// The result may have been sent just before the match stopped.
+				select {
+				case result := <-resultCh:
+					return result, true
+				default:
+					var zero T
+					return zero, false
+				}
+			}
+		}
+	}
+}
+
+// unsupportedCalls records calls matchtest cannot fake, so the harness can fail with the call's name rather than panic
+// or silently return nothing.
+type unsupportedCalls struct {
+	sync.Mutex
+	err error
+}
+
+func (u *unsupportedCalls) unsupported(call string) error {
+	err := fmt.Errorf("%w: %v", ErrUnsupportedMatchOp, call)
+	u.Lock()
+	if u.err == nil {
+		u.err = err
+	}
+	u.Unlock()
+	return err
+}
+
+func (u *unsupportedCalls) failure() error {
+	u.Lock()
+	defer u.Unlock()
+	return u.err
+}
+
+// registry captures the match registry calls made by a match handler and its core. Any other call returns an error,
+// which the harness also returns from its next call.
+type registry struct {
+	unsupportedCalls
+	labels  []string
+	kicks   []*server.MatchPresence
+	pending []*server.MatchPresence
+}
+
+var _ server.MatchRegistry = &registry{}
+
+func (r *registry) UpdateMatchLabel(id uuid.UUID, tickRate int, handlerName, label string, createTime int64) error {
+	r.Lock()
+	r.labels = append(r.labels, label)
+	r.Unlock()
+	return nil
+}
+
+func (r *registry) RemoveMatch(id uuid.UUID, stream server.PresenceStream) {}
+
+func (r *registry) Kick(stream server.PresenceStream, presences []*server.MatchPresence) {
+	r.Lock()
+	r.kicks = append(r.kicks, presences...)
+	r.pending = append(r.pending, presences...)
+	r.Unlock()
+}
+
+func (r *registry) CreateMatch(ctx context.Context, createFn server.RuntimeMatchCreateFunction, module string, params map[string]interface{}) (string, error) {
+	return "", r.unsupported("MatchRegistry.CreateMatch")
+}
+
+func (r *registry) NewMatch(logger *zap.Logger, id uuid.UUID, core server.RuntimeMatchCore, stopped *atomic.Bool, params map[string]interface{}) (*server.MatchHandler, error) {
+	return nil, r.unsupported("MatchRegistry.NewMatch")
+}
+
+func (r *registry) GetMatch(ctx context.Context, id string) (*api.Match, string, error) {
+	return nil, "", r.unsupported("MatchRegistry.GetMatch")
+}
+
+func (r *registry) ListMatches(ctx context.Context, limit int, authoritative *wrapperspb.BoolValue, label *wrapperspb.StringValue, minSize *wrapperspb.Int32Value, maxSize *wrapperspb.Int32Value, query *wrapperspb.StringValue, node *wrapperspb.StringValue, sort []string, cursor string) ([]*api.Match, []string, string, error) {
+	return nil, nil, "", r.unsupported("MatchRegistry.ListMatches")
+}
+
+func (r *registry) Stop(graceSeconds int) chan struct{} {
+	_ = r.unsupported("MatchRegistry.Stop")
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
+
+func (r *registry) RestoreMatches(ctx context.Context, db *sql.DB, createFn server.RuntimeMatchCreateFunction) int {
+	_ = r.unsupported("MatchRegistry.RestoreMatches")
+	return 0
+}
+
+func (r *registry) Count() int {
+	_ = r.unsupported("MatchRegistry.Count")
+	return 0
+}
+
+func (r *registry) JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username string, sessionExpiry int64, vars map[string]string, clientIP, clientPort, fromNode string, metadata map[string]string) (bool, bool, bool, string, string, []*server.MatchPresence) {
+	_ = r.unsupported("MatchRegistry.JoinAttempt")
+	return false, false, false, "", "", nil
+}
+
+func (r *registry) Reconnect(ctx context.Context, id uuid.UUID, node string, token string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, bool, string, string, []*server.MatchPresence) {
+	_ = r.unsupported("MatchRegistry.Reconnect")
+	return false, false, false, "", "", nil
+}
+
+func (r *registry) Join(id uuid.UUID, presences []*server.MatchPresence) {
+	_ = r.unsupported("MatchRegistry.Join")
+}
+
+func (r *registry) Leave(id uuid.UUID, presences []*server.MatchPresence) {
+	_ = r.unsupported("MatchRegistry.Leave")
+}
+
+func (r *registry) SendData(id uuid.UUID, node string, userID, sessionID uuid.UUID, username, fromNode string, opCode int64, data []byte, reliable bool, receiveTime int64) {
+	_ = r.unsupported("MatchRegistry.SendData")
+}
+
+func (r *registry) Signal(ctx context.Context, id, data string) (string, error) {
+	return "", r.unsupported("MatchRegistry.Signal")
+}
+
+func (r *registry) GetState(ctx context.Context, id uuid.UUID, node string) ([]*rtapi.UserPresence, int64, string, error) {
+	return nil, 0, "", r.unsupported("MatchRegistry.GetState")
+}
+
+func (r *registry) GetProfile(id uuid.UUID, node string) (*server.MatchProfile, error) {
+	return nil, r.unsupported("MatchRegistry.GetProfile")
+}
+
+func (r *registry) Terminate(id uuid.UUID, node string, graceSeconds int) error {
+	return r.unsupported("MatchRegistry.Terminate")
+}
+
+func (r *registry) SetMatchmaker(matchmaker server.Matchmaker) {
+	_ = r.unsupported("MatchRegistry.SetMatchmaker")
+}
+
+func (r *registry) SetPeer(peer server.MatchRegistryPeer) {
+	_ = r.unsupported("MatchRegistry.SetPeer")
+}
+
+func (r *registry) MatchmakerBackfillAdd(ctx context.Context, id, queue, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, error) {
+	return "", r.unsupported("MatchRegistry.MatchmakerBackfillAdd")
+}
+
+func (r *registry) MatchmakerBackfillRemove(ctx context.Context, id, ticket string) error {
+	return r.unsupported("MatchRegistry.MatchmakerBackfillRemove")
+}
+
+// router captures messages sent by a match handler and its core.
+type router struct {
+	sync.Mutex
+	broadcasts []*Broadcast
+}
+
+func (r *router) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*server.PresenceID, envelope *rtapi.Envelope, reliable bool) {
+	r.Lock()
+	r.broadcasts = append(r.broadcasts, &Broadcast{Recipients: presenceIDs, Envelope: envelope, Reliable: reliable})
+	r.Unlock()
+}
+
+func (r *router) SendToStream(logger *zap.Logger, stream server.PresenceStream, envelope *rtapi.Envelope, reliable bool) {
+	r.Lock()
+	r.broadcasts = append(r.broadcasts, &Broadcast{Envelope: envelope, Reliable: reliable})
+	r.Unlock()
+}
+
+func (r *router) SendDeferred(logger *zap.Logger, messages []*server.DeferredMessage) {
+	r.Lock()
+	for _, message := range messages {
+		r.broadcasts = append(r.broadcasts, &Broadcast{Recipients: message.PresenceIDs, Envelope: message.Envelope, Reliable: message.Reliable, Deferred: true})
+	}
+	r.Unlock()
+}
+
+// sessionRegistry captures disconnects, the only session registry call made by match handlers. Any other call is
+// reported by the harness as unsupported.
+type sessionRegistry struct {
+	unsupportedCalls
+	disconnects []uuid.UUID
+}
+
+var _ server.SessionRegistry = &sessionRegistry{}
+
+func (r *sessionRegistry) Disconnect(ctx context.Context, sessionID uuid.UUID, reason ...runtime.PresenceReason) error {
+	r.Lock()
+	r.disconnects = append(r.disconnects, sessionID)
+	r.Unlock()
+	return nil
+}
+
+func (r *sessionRegistry) Stop() {
+	_ = r.unsupported("SessionRegistry.Stop")
+}
+
+func (r *sessionRegistry) Count() int {
+	_ = r.unsupported("SessionRegistry.Count")
+	return 0
+}
+
+func (r *sessionRegistry) Get(sessionID uuid.UUID) server.Session {
+	_ = r.unsupported("SessionRegistry.Get")
+	return nil
+}
+
+func (r *sessionRegistry) Add(session server.Session) {
+	_ = r.unsupported("SessionRegistry.Add")
+}
+
+func (r *sessionRegistry) Remove(sessionID uuid.UUID) {
+	_ = r.unsupported("SessionRegistry.Remove")
+}
+
+func (r *sessionRegistry) SingleSession(ctx context.Context, tracker server.Tracker, userID, sessionID uuid.UUID) {
+	_ = r.unsupported("SessionRegistry.SingleSession")
+}
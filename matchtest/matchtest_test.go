@@ -0,0 +1,264 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matchtest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/zap"
+)
+
+const (
+	opCodeEcho int64 = 1
+	opCodeKick int64 = 2
+	opCodeList int64 = 3
+)
+
+type echoState struct {
+	joined int
+	ticks  int64
+}
+
+// echoMatch rejects the username "banned", echoes data to everyone, kicks on request and counts ticks.
+type echoMatch struct{}
+
+func (m *echoMatch) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
+	return &echoState{}, 10, "open"
+}
+
+func (m *echoMatch) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
+	if presence.GetUsername() == "banned" {
+		return state, false, "banned"
+	}
+	return state, true, ""
+}
+
+func (m *echoMatch) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	s := state.(*echoState)
+	s.joined += len(presences)
+	if s.joined == 2 {
+		_ = dispatcher.MatchLabelUpdate("full")
+	}
+	return s
+}
+
+func (m *echoMatch) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	s := state.(*echoState)
+	s.joined -= len(presences)
+	return s
+}
+
+func (m *echoMatch) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	s := state.(*echoState)
+	s.ticks++
+	for _, message := range messages {
+		switch message.GetOpCode() {
+		case opCodeEcho:
+			_ = dispatcher.BroadcastMessage(opCodeEcho, message.GetData(), nil, message, true)
+		case opCodeKick:
+			_ = dispatcher.MatchKick([]runtime.Presence{message})
+		}
+	}
+	return s
+}
+
+func (m *echoMatch) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	return nil
+}
+
+func (m *echoMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	return state, strconv.FormatInt(state.(*echoState).ticks, 10)
+}
+
+func (m *echoMatch) MatchDataRateLimits() map[string]interface{} {
+	return map[string]interface{}{"op_codes": map[int64]interface{}{opCodeEcho: map[string]interface{}{"rate": 0.001, "burst": 1}}}
+}
+
+// should drive a match deterministically, capturing broadcasts, kicks and label updates
+func TestHarness(t *testing.T) {
+	h, err := New(zap.NewNop(), nil, GoMatch("echo", &echoMatch{}, nil, nil), nil)
+	if err != nil {
+		t.Fatalf("error creating harness: %v", err)
+	}
+	defer h.Stop()
+
+	alice := NewPresence("alice")
+	bob := NewPresence("bob")
+	if allow, reason, err := h.JoinAttempt(NewPresence("banned"), nil); allow || reason != "banned" || err != nil {
+		t.Fatalf("expected banned join to be rejected, got %v %q %v", allow, reason, err)
+	}
+	if err := h.Join(alice, bob); err != nil {
+		t.Fatalf("error joining: %v", err)
+	}
+	if labels := h.Labels(); len(labels) != 2 || labels[0] != "open" || labels[1] != "full" || h.Label() != "full" {
+		t.Fatalf("unexpected labels: %v", labels)
+	}
+
+	// Data is only processed on the next tick, and rate limits from the match apply.
+	if !h.SendData(alice, opCodeEcho, []byte("hello"), true) {
+		t.Fatalf("expected first echo to be queued")
+	}
+	if h.SendData(alice, opCodeEcho, []byte("again"), true) {
+		t.Fatalf("expected second echo to be rate limited")
+	}
+	if broadcasts := h.Broadcasts(); len(broadcasts) != 0 {
+		t.Fatalf("expected no broadcasts before a tick, got %v", broadcasts)
+	}
+	if err := h.Tick(); err != nil {
+		t.Fatalf("error ticking: %v", err)
+	}
+	broadcasts := h.Broadcasts()
+	if len(broadcasts) != 1 {
+		t.Fatalf("expected 1 broadcast, got %v", broadcasts)
+	}
+	if data := broadcasts[0].Envelope.GetMatchData(); string(data.Data) != "hello" || data.Presence.GetUsername() != "alice" || len(broadcasts[0].Recipients) != 2 {
+		t.Fatalf("unexpected broadcast: %v", broadcasts[0])
+	}
+
+	// Kicked presences leave before the tick completes.
+	h.SendData(bob, opCodeKick, nil, true)
+	if err := h.Advance(2); err != nil {
+		t.Fatalf("error ticking: %v", err)
+	}
+	if kicks := h.Kicks(); len(kicks) != 1 || kicks[0].SessionID != bob.SessionID {
+		t.Fatalf("expected bob to be kicked, got %v", kicks)
+	}
+	tick, presences, _, err := h.State()
+	if err != nil {
+		t.Fatalf("error getting state: %v", err)
+	}
+	if tick != 3 || len(presences) != 1 || presences[0].SessionID != alice.SessionID {
+		t.Fatalf("unexpected tick %v or presences %v", tick, presences)
+	}
+
+	if result, err := h.Signal("ticks"); result != "3" || err != nil {
+		t.Fatalf("unexpected signal result %q, %v", result, err)
+	}
+
+	if err := h.Terminate(0); err != nil {
+		t.Fatalf("error terminating: %v", err)
+	}
+	if !h.Stopped() {
+		t.Fatalf("expected match to stop after terminate")
+	}
+	if err := h.Tick(); err != ErrMatchStopped {
+		t.Fatalf("expected stopped error, got %v", err)
+	}
+}
+
+const echoLuaModule = `
+local nk = require("nakama")
+local M = {}
+function M.match_init(context, params) return {}, 10, "open" end
+function M.match_join_attempt(context, dispatcher, tick, state, presence, metadata) return state, true end
+function M.match_join(context, dispatcher, tick, state, presences) return state end
+function M.match_leave(context, dispatcher, tick, state, presences) return state end
+function M.match_loop(context, dispatcher, tick, state, messages)
+	for _, message in ipairs(messages) do
+		if message.op_code == 1 then
+			dispatcher.broadcast_message(1, message.data, nil, message.sender, true)
+		elseif message.op_code == 3 then
+			pcall(nk.match_list)
+		end
+	end
+	return state
+end
+function M.match_terminate(context, dispatcher, tick, state, grace_seconds) return state end
+function M.match_signal(context, dispatcher, tick, state, data) return state, data end
+return M`
+
+const echoJSModule = `
+function InitModule(ctx, logger, nk, initializer) {
+	initializer.registerMatch("echo", {
+		matchInit: matchInit,
+		matchJoinAttempt: matchJoinAttempt,
+		matchJoin: matchJoin,
+		matchLeave: matchLeave,
+		matchLoop: matchLoop,
+		matchTerminate: matchTerminate,
+		matchSignal: matchSignal
+	});
+}
+function matchInit(ctx, logger, nk, params) { return { state: {}, tickRate: 10, label: "open" }; }
+function matchJoinAttempt(ctx, logger, nk, dispatcher, tick, state, presence, metadata) { return { state: state, accept: true }; }
+function matchJoin(ctx, logger, nk, dispatcher, tick, state, presences) { return { state: state }; }
+function matchLeave(ctx, logger, nk, dispatcher, tick, state, presences) { return { state: state }; }
+function matchLoop(ctx, logger, nk, dispatcher, tick, state, messages) {
+	messages.forEach(function (message) {
+		if (message.opCode === 1) {
+			dispatcher.broadcastMessage(1, message.data, null, message.sender, true);
+		} else if (message.opCode === 3) {
+			try { nk.matchList(10); } catch (e) {}
+		}
+	});
+	return { state: state };
+}
+function matchTerminate(ctx, logger, nk, dispatcher, tick, state, graceSeconds) { return { state: state }; }
+function matchSignal(ctx, logger, nk, dispatcher, tick, state, data) { return { state: state, data: data }; }`
+
+// should run Lua and JavaScript matches from a module path, and report server calls it cannot fake
+func TestHarnessRuntime(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "echo.lua"), []byte(echoLuaModule), 0644); err != nil {
+		t.Fatalf("error writing module: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "echo.js"), []byte(echoJSModule), 0644); err != nil {
+		t.Fatalf("error writing module: %v", err)
+	}
+
+	if _, err := New(zap.NewNop(), nil, LuaMatch(dir, "missing"), nil); !errors.Is(err, ErrMatchNotFound) {
+		t.Fatalf("expected missing match error, got %v", err)
+	}
+
+	for name, factory := range map[string]CoreFactory{
+		"lua": LuaMatch(dir, "echo"),
+		"js":  JSMatch(dir, "echo.js", "echo"),
+	} {
+		h, err := New(zap.NewNop(), nil, factory, nil)
+		if err != nil {
+			t.Fatalf("error creating %v harness: %v", name, err)
+		}
+		if h.Label() != "open" {
+			t.Fatalf("unexpected %v label: %q", name, h.Label())
+		}
+
+		alice := NewPresence("alice")
+		if err := h.Join(alice); err != nil {
+			t.Fatalf("error joining %v match: %v", name, err)
+		}
+		h.SendData(alice, opCodeEcho, []byte("hello"), true)
+		if err := h.Tick(); err != nil {
+			t.Fatalf("error ticking %v match: %v", name, err)
+		}
+		broadcasts := h.Broadcasts()
+		if len(broadcasts) != 1 || string(broadcasts[0].Envelope.GetMatchData().Data) != "hello" {
+			t.Fatalf("unexpected %v broadcasts: %v", name, broadcasts)
+		}
+
+		h.SendData(alice, opCodeList, nil, true)
+		if err := h.Tick(); !errors.Is(err, ErrUnsupportedMatchOp) || !strings.Contains(err.Error(), "MatchRegistry.ListMatches") {
+			t.Fatalf("expected unsupported %v match list, got %v", name, err)
+		}
+		h.Stop()
+	}
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2023 The Nakama Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS server_key (
+    PRIMARY KEY (name),
+
+    authenticate_methods JSONB        NOT NULL DEFAULT '[]',
+    create_time          TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    enabled              BOOLEAN      NOT NULL DEFAULT TRUE,
+    expire_time          TIMESTAMPTZ  NOT NULL DEFAULT '1970-01-01 00:00:00 UTC',
+    -- Hex SHA-256 hash of the key, the key itself is not stored.
+    key_hash             CHAR(64)     NOT NULL CONSTRAINT server_key_key_hash_uniq UNIQUE,
+    name                 VARCHAR(128) NOT NULL CHECK (length(name) > 0),
+    rpc_ids              JSONB        NOT NULL DEFAULT '[]',
+    update_time          TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS server_key;
@@ -64,6 +64,7 @@ type ctxFullMethodKey struct{}
 
 type ApiServer struct {
 	apigrpc.UnimplementedNakamaServer
+	apigrpc.UnimplementedNakamaRealtimeServer
 	logger               *zap.Logger
 	db                   *sql.DB
 	config               Config
@@ -75,16 +76,27 @@ type ApiServer struct {
 	sessionRegistry      SessionRegistry
 	statusRegistry       *StatusRegistry
 	matchRegistry        MatchRegistry
+	matchHostRegistry    MatchHostRegistry
+	matchmaker           Matchmaker
 	tracker              Tracker
 	router               MessageRouter
 	streamManager        StreamManager
 	metrics              Metrics
+	pipeline             *Pipeline
+	apiLimiter           *ApiRateLimiter
+	serverKeys           ServerKeyRegistry
 	runtime              *Runtime
+	sessionIdGen         *uuid.Gen
 	grpcServer           *grpc.Server
 	grpcGatewayServer    *http.Server
+	udpAcceptor          *SocketUdpAcceptor
+
+	// Cancelled when the server stops, to close real-time stream sessions.
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
 }
 
-func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, streamManager StreamManager, metrics Metrics, pipeline *Pipeline, runtime *Runtime) *ApiServer {
+func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, matchHostRegistry MatchHostRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, streamManager StreamManager, metrics Metrics, pipeline *Pipeline, apiLimiter *ApiRateLimiter, serverKeys ServerKeyRegistry, runtime *Runtime) *ApiServer {
 	var gatewayContextTimeoutMs string
 	if config.GetSocket().IdleTimeoutMs > 500 {
 		// Ensure the GRPC Gateway timeout is just under the idle timeout (if possible) to ensure it has priority.
@@ -98,19 +110,38 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 	serverOpts := []grpc.ServerOption{
 		grpc.StatsHandler(&MetricsGrpcHandler{MetricsFn: metrics.Api}),
 		grpc.MaxRecvMsgSize(int(config.GetSocket().MaxRequestSizeBytes)),
+		// Real-time stream sessions send payloads already encoded for other sessions as they are.
+		grpc.ForceServerCodec(grpcRawCodec{}),
 		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			ctx, err := securityInterceptorFunc(logger, config, sessionCache, ctx, req, info)
+			ctx, err := securityInterceptorFunc(logger, config, sessionCache, serverKeys, ctx, req, info)
 			if err != nil {
 				return nil, err
 			}
+			if err := rateLimitInterceptorFunc(logger, metrics, apiLimiter, ctx, req, info); err != nil {
+				return nil, err
+			}
 			return handler(ctx, req)
 		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ss, err := streamSecurityInterceptorFunc(logger, config, sessionCache, serverKeys, srv, ss, info)
+			if err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
 	}
 	if config.GetSocket().TLSCert != nil {
 		serverOpts = append(serverOpts, grpc.Creds(credentials.NewServerTLSFromCert(&config.GetSocket().TLSCert[0])))
 	}
 	grpcServer := grpc.NewServer(serverOpts...)
 
+	sessionIdGen := uuid.NewGenWithHWAF(func() (net.HardwareAddr, error) {
+		hash := NodeToHash(config.GetName())
+		return hash[:], nil
+	})
+
+	streamCtx, streamCtxCancelFn := context.WithCancel(context.Background())
+
 	s := &ApiServer{
 		logger:               logger,
 		db:                   db,
@@ -123,16 +154,26 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 		sessionRegistry:      sessionRegistry,
 		statusRegistry:       statusRegistry,
 		matchRegistry:        matchRegistry,
+		matchHostRegistry:    matchHostRegistry,
+		matchmaker:           matchmaker,
 		tracker:              tracker,
 		router:               router,
 		streamManager:        streamManager,
 		metrics:              metrics,
+		pipeline:             pipeline,
+		apiLimiter:           apiLimiter,
+		serverKeys:           serverKeys,
 		runtime:              runtime,
+		sessionIdGen:         sessionIdGen,
 		grpcServer:           grpcServer,
+
+		ctx:         streamCtx,
+		ctxCancelFn: streamCtxCancelFn,
 	}
 
 	// Register and start GRPC server.
 	apigrpc.RegisterNakamaServer(grpcServer, s)
+	apigrpc.RegisterNakamaRealtimeServer(grpcServer, s)
 	startupLogger.Info("Starting API server for gRPC requests", zap.Int("port", config.GetSocket().Port-1))
 	go func() {
 		listener, err := net.Listen("tcp", fmt.Sprintf("%v:%d", config.GetSocket().Address, config.GetSocket().Port-1))
@@ -150,6 +191,19 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 	ctx := context.Background()
 	grpcGateway := grpcgw.NewServeMux(
 		grpcgw.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+			// Match listings take their sort order and cursor from query parameters not in the request message.
+			if r.Method == "GET" && r.URL.Path == "/v2/match" {
+				q := r.URL.Query()
+				p := make(map[string][]string, 2)
+				if sort, ok := q["sort"]; ok {
+					p["sort"] = sort
+				}
+				if cursor, ok := q["cursor"]; ok {
+					p["cursor"] = cursor
+				}
+				return metadata.MD(p)
+			}
+
 			// For RPC GET operations pass through any custom query parameters.
 			if r.Method != "GET" || !strings.HasPrefix(r.URL.Path, "/v2/rpc/") {
 				return metadata.MD{}
@@ -166,6 +220,13 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 			}
 			return metadata.MD(p)
 		}),
+		grpcgw.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			// Rate limited requests tell clients when to retry with a standard header.
+			if key == "retry-after" {
+				return "Retry-After", true
+			}
+			return grpcgw.MetadataHeaderPrefix + key, true
+		}),
 		grpcgw.WithMarshalerOption(grpcgw.MIMEWildcard, &grpcgw.HTTPBodyMarshaler{
 			Marshaler: &grpcgw.JSONPb{
 				MarshalOptions: protojson.MarshalOptions{
@@ -211,6 +272,7 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 	// Special case routes. Do NOT enable compression on WebSocket route, it results in "http: response.Write on hijacked connection" errors.
 	grpcGatewayRouter.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(200) }).Methods("GET")
 	grpcGatewayRouter.HandleFunc("/ws", NewSocketWsAcceptor(logger, config, sessionRegistry, sessionCache, statusRegistry, matchmaker, tracker, metrics, runtime, protojsonMarshaler, protojsonUnmarshaler, pipeline)).Methods("GET")
+	grpcGatewayRouter.HandleFunc("/sse", NewSocketSseAcceptor(logger, config, sessionRegistry, sessionCache, statusRegistry, matchmaker, tracker, metrics, runtime, protojsonMarshaler, protojsonUnmarshaler)).Methods("GET")
 
 	// Another nested router to hijack RPC requests bound for GRPC Gateway.
 	grpcGatewayMux := mux.NewRouter()
@@ -219,7 +281,7 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 
 	// Enable stats recording on all request paths except:
 	// "/" is not tracked at all.
-	// "/ws" implements its own separate tracking.
+	// "/ws" and "/sse" implement their own separate tracking.
 	//handlerWithStats := &ochttp.Handler{
 	//	Handler:          grpcGatewayMux,
 	//	IsPublicEndpoint: true,
@@ -302,6 +364,22 @@ func StartApiServer(logger *zap.Logger, startupLogger *zap.Logger, db *sql.DB, p
 		}
 	}()
 
+	// Set up and start the UDP real-time transport, if enabled.
+	if config.GetSocket().UdpPort > 0 {
+		s.udpAcceptor = NewSocketUdpAcceptor(logger, config, sessionRegistry, sessionCache, statusRegistry, matchmaker, tracker, metrics, runtime, protojsonMarshaler, protojsonUnmarshaler, pipeline)
+
+		startupLogger.Info("Starting API server for UDP real-time connections", zap.Int("port", config.GetSocket().UdpPort))
+		conn, err := net.ListenPacket("udp", fmt.Sprintf("%v:%d", config.GetSocket().Address, config.GetSocket().UdpPort))
+		if err != nil {
+			startupLogger.Fatal("API server UDP listener failed to start", zap.Error(err))
+		}
+		go func() {
+			if err := s.udpAcceptor.Serve(conn); err != nil {
+				startupLogger.Fatal("API server UDP listener failed", zap.Error(err))
+			}
+		}()
+	}
+
 	return s
 }
 
@@ -310,15 +388,21 @@ func (s *ApiServer) Stop() {
 	if err := s.grpcGatewayServer.Shutdown(context.Background()); err != nil {
 		s.logger.Error("API server gateway listener shutdown failed", zap.Error(err))
 	}
-	// 2. Stop GRPC server. This also closes the underlying listener.
+	// 2. Close real-time stream sessions, a graceful stop waits for all streams to end.
+	s.ctxCancelFn()
+	// 3. Stop GRPC server. This also closes the underlying listener.
 	s.grpcServer.GracefulStop()
+	// 4. Stop the UDP transport, if enabled. This closes the underlying socket.
+	if s.udpAcceptor != nil {
+		s.udpAcceptor.Stop()
+	}
 }
 
 func (s *ApiServer) Healthcheck(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, nil
 }
 
-func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache SessionCache, ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (context.Context, error) {
+func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache SessionCache, serverKeys ServerKeyRegistry, ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (context.Context, error) {
 	switch info.FullMethod {
 	case "/nakama.api.Nakama/Healthcheck":
 		// Healthcheck has no security.
@@ -341,6 +425,8 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 		fallthrough
 	case "/nakama.api.Nakama/AuthenticateGoogle":
 		fallthrough
+	case "/nakama.api.Nakama/AuthenticateOidc":
+		fallthrough
 	case "/nakama.api.Nakama/AuthenticateSteam":
 		// Session refresh and authentication functions only require server key.
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -365,10 +451,16 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 			// Value of "authorization" or "grpc-authorization" was malformed.
 			return nil, status.Error(codes.Unauthenticated, "Server key invalid")
 		}
-		if username != config.GetSocket().ServerKey {
-			// Value of "authorization" or "grpc-authorization" username component did not match server key.
+		name, err := serverKeys.ValidateServerKey(username, info.FullMethod[strings.LastIndexByte(info.FullMethod, '/')+1:], time.Now())
+		if err == ErrServerKeyNotAllowed {
+			// Server key is valid but not allowed to be used with this method.
+			return nil, status.Error(codes.PermissionDenied, "Server key not allowed")
+		}
+		if err != nil {
+			// Value of "authorization" or "grpc-authorization" username component did not match an enabled server key.
 			return nil, status.Error(codes.Unauthenticated, "Server key invalid")
 		}
+		setServerKeySessionVar(req, name)
 	case "/nakama.api.Nakama/RpcFunc":
 		// RPC allows full user authentication or HTTP key authentication.
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -391,7 +483,10 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 				// HTTP key not present.
 				return nil, status.Error(codes.Unauthenticated, "Auth token or HTTP key required")
 			}
-			if in.HttpKey != config.GetRuntime().HTTPKey {
+			if _, err := serverKeys.ValidateHttpKey(in.HttpKey, in.Id, time.Now()); err == ErrServerKeyNotAllowed {
+				// HTTP key is valid but not allowed to call this RPC function.
+				return nil, status.Error(codes.PermissionDenied, "HTTP key not allowed")
+			} else if err != nil {
 				// Value of HTTP key username component did not match.
 				return nil, status.Error(codes.Unauthenticated, "HTTP key invalid")
 			}
@@ -442,6 +537,68 @@ func securityInterceptorFunc(logger *zap.Logger, config Config, sessionCache Ses
 	return context.WithValue(ctx, ctxFullMethodKey{}, info.FullMethod), nil
 }
 
+func rateLimitInterceptorFunc(logger *zap.Logger, metrics Metrics, apiLimiter *ApiRateLimiter, ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) error {
+	var rpcID, httpKey string
+	if in, ok := req.(*api.Rpc); ok {
+		rpcID, httpKey = in.Id, in.HttpKey
+	}
+	names := apiLimiter.Limits(info.FullMethod[strings.LastIndexByte(info.FullMethod, '/')+1:], rpcID)
+	if len(names) == 0 {
+		return nil
+	}
+
+	// Identify the caller by anything a limit may be keyed by.
+	caller := &apiRateLimitCaller{serverKey: httpKey}
+	if userID := ctx.Value(ctxUserIDKey{}); userID != nil {
+		caller.userID = userID.(uuid.UUID)
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		auth, ok := md["authorization"]
+		if !ok {
+			auth = md["grpcgateway-authorization"]
+		}
+		if len(auth) == 1 {
+			if username, _, ok := parseBasicAuth(auth[0]); ok {
+				caller.serverKey = username
+			}
+		}
+	}
+	caller.clientIP, _ = extractClientAddressFromContext(logger, ctx)
+
+	name, retryAfter, err := apiLimiter.Allow(names, caller, time.Now())
+	if err == nil {
+		return nil
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs("retry-after", apiRateLimitRetryAfter(retryAfter))); err != nil {
+		logger.Debug("Could not set retry-after header", zap.Error(err))
+	}
+	if err == ErrApiQuotaExceeded {
+		metrics.ApiRateLimited(name, "quota")
+		return status.Error(codes.ResourceExhausted, "Daily quota exceeded")
+	}
+	metrics.ApiRateLimited(name, "rate")
+	return status.Error(codes.ResourceExhausted, "Rate limit exceeded")
+}
+
+// grpcServerStream replaces the context of a stream with one holding the caller's authentication.
+type grpcServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *grpcServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func streamSecurityInterceptorFunc(logger *zap.Logger, config Config, sessionCache SessionCache, serverKeys ServerKeyRegistry, srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo) (grpc.ServerStream, error) {
+	// Streams always require full user authentication, the same as unary handlers without their own case above.
+	ctx, err := securityInterceptorFunc(logger, config, sessionCache, serverKeys, ss.Context(), nil, &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod})
+	if err != nil {
+		return nil, err
+	}
+	return &grpcServerStream{ServerStream: ss, ctx: ctx}, nil
+}
+
 func parseBasicAuth(auth string) (username, password string, ok bool) {
 	if auth == "" {
 		return
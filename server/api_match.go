@@ -16,12 +16,18 @@ package server
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gofrs/uuid"
 	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (s *ApiServer) ListMatches(ctx context.Context, in *api.ListMatchesRequest) (*api.MatchList, error) {
@@ -73,12 +79,36 @@ func (s *ApiServer) ListMatches(ctx context.Context, in *api.ListMatchesRequest)
 		return nil, status.Error(codes.InvalidArgument, "Maximum size must be greater than or equal to minimum size when both are specified.")
 	}
 
-	results, _, err := s.matchRegistry.ListMatches(ctx, limit, in.Authoritative, in.Label, in.MinSize, in.MaxSize, in.Query, nil)
+	// The sort order and cursor are not part of the request message, so they are passed as request metadata. When
+	// called through the HTTP gateway they are the "sort" and "cursor" query parameters.
+	var sort []string
+	var cursor string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		sort = md.Get("sort")
+		if cursors := md.Get("cursor"); len(cursors) != 0 {
+			cursor = cursors[0]
+		}
+	}
+	if (len(sort) != 0 || cursor != "") && (in.Authoritative != nil && !in.Authoritative.Value) {
+		return nil, status.Error(codes.InvalidArgument, "Sorting and cursors are not supported for non-authoritative matches.")
+	}
+
+	results, _, nextCursor, err := s.matchRegistry.ListMatches(ctx, limit, in.Authoritative, in.Label, in.MinSize, in.MaxSize, in.Query, nil, sort, cursor)
 	if err != nil {
+		if err == ErrMatchListSortInvalid || err == ErrMatchListCursorInvalid {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("Error listing matches", zap.Error(err))
 		return nil, status.Error(codes.Internal, "Error listing matches.")
 	}
 
+	// The next cursor is returned as response metadata, a "Grpc-Metadata-Next-Cursor" header through the HTTP gateway.
+	if nextCursor != "" {
+		if err := grpc.SetHeader(ctx, metadata.Pairs("next-cursor", nextCursor)); err != nil {
+			s.logger.Warn("Error setting match list next cursor header", zap.Error(err))
+		}
+	}
+
 	list := &api.MatchList{Matches: results}
 
 	// After hook.
@@ -93,3 +123,73 @@ func (s *ApiServer) ListMatches(ctx context.Context, in *api.ListMatchesRequest)
 
 	return list, nil
 }
+
+func (s *ApiServer) TransferMatchHost(ctx context.Context, in *apigrpc.TransferMatchHostRequest) (*emptypb.Empty, error) {
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+
+	// Before hook.
+	if fn := s.runtime.BeforeTransferMatchHost(); fn != nil {
+		beforeFn := func(clientIP, clientPort string) error {
+			result, err, code := fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxVarsKey{}).(map[string]string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+			if err != nil {
+				return status.Error(code, err.Error())
+			}
+			if result == nil {
+				// If result is nil, requested resource is disabled.
+				s.logger.Warn("Intercepted a disabled resource.", zap.Any("resource", ctx.Value(ctxFullMethodKey{}).(string)), zap.String("uid", userID.String()))
+				return status.Error(codes.NotFound, "Requested resource was not found.")
+			}
+			in = result
+			return nil
+		}
+
+		// Execute the before function lambda wrapped in a trace for stats measurement.
+		err := traceApiBefore(ctx, s.logger, s.metrics, ctx.Value(ctxFullMethodKey{}).(string), beforeFn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.matchHostRegistry == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Relayed match host election is not enabled.")
+	}
+
+	// Only relayed matches have hosts, and their IDs have no node.
+	matchIDComponents := strings.SplitN(in.GetMatchId(), ".", 2)
+	if len(matchIDComponents) != 2 || matchIDComponents[1] != "" {
+		return nil, status.Error(codes.InvalidArgument, "Match ID must be a valid relayed match ID.")
+	}
+	matchID, err := uuid.FromString(matchIDComponents[0])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Match ID must be a valid relayed match ID.")
+	}
+	hostSessionID, err := uuid.FromString(in.GetSessionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Session ID must be a valid ID.")
+	}
+
+	if err := s.matchHostRegistry.Transfer(matchID, userID, hostSessionID); err != nil {
+		switch err {
+		case runtime.ErrMatchNotFound:
+			return nil, status.Error(codes.NotFound, "Match not found.")
+		case ErrMatchHostNotHost:
+			return nil, status.Error(codes.PermissionDenied, "Only the match host can transfer the host role.")
+		case ErrMatchHostNotParticipant:
+			return nil, status.Error(codes.InvalidArgument, "New match host must be a match participant.")
+		default:
+			return nil, status.Error(codes.Internal, "Error transferring match host.")
+		}
+	}
+
+	// After hook.
+	if fn := s.runtime.AfterTransferMatchHost(); fn != nil {
+		afterFn := func(clientIP, clientPort string) error {
+			return fn(ctx, s.logger, userID.String(), ctx.Value(ctxUsernameKey{}).(string), ctx.Value(ctxVarsKey{}).(map[string]string), ctx.Value(ctxExpiryKey{}).(int64), clientIP, clientPort, in)
+		}
+
+		// Execute the after function lambda wrapped in a trace for stats measurement.
+		traceApiAfter(ctx, s.logger, s.metrics, ctx.Value(ctxFullMethodKey{}).(string), afterFn)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+)
+
+const (
+	// Rate limit each authenticated user separately.
+	ApiRateLimitKeyUserID = "user_id"
+	// Rate limit each client address separately.
+	ApiRateLimitKeyClientIP = "client_ip"
+	// Rate limit each server key or runtime HTTP key separately.
+	ApiRateLimitKeyServerKey = "server_key"
+
+	// Rules for runtime RPC functions are named with this prefix followed by the RPC ID.
+	apiRateLimitRpcPrefix = "rpc:"
+
+	apiRateLimitSweepInterval = time.Minute
+)
+
+var (
+	ErrApiRateLimitExceeded = errors.New("api rate limit exceeded")
+	ErrApiQuotaExceeded     = errors.New("api daily quota exceeded")
+)
+
+// Reports whether the name is an API method or a runtime RPC a rate limit may be configured for.
+func isApiRateLimitName(name string) bool {
+	if strings.HasPrefix(name, apiRateLimitRpcPrefix) {
+		return len(name) > len(apiRateLimitRpcPrefix)
+	}
+	for _, method := range apigrpc.Nakama_ServiceDesc.Methods {
+		if method.MethodName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// apiRateLimitCaller identifies who made an API request, any of which rate limits may be keyed by.
+type apiRateLimitCaller struct {
+	userID    uuid.UUID
+	clientIP  string
+	serverKey string
+}
+
+// Key the caller for a rule. Requests without the user or key the rule asks for fall back to their client address.
+func (c *apiRateLimitCaller) key(keyType string) string {
+	switch {
+	case keyType == ApiRateLimitKeyServerKey && c.serverKey != "":
+		return "key:" + c.serverKey
+	case keyType != ApiRateLimitKeyServerKey && keyType != ApiRateLimitKeyClientIP && c.userID != uuid.Nil:
+		return "user:" + c.userID.String()
+	default:
+		return "ip:" + c.clientIP
+	}
+}
+
+// ApiRateLimiter applies rate limits and daily quotas to gRPC and HTTP API requests, by API method name or runtime
+// RPC ID. Quota counts are kept per user for the current UTC day, and reset when the day changes.
+type ApiRateLimiter struct {
+	sync.Mutex
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	limits  map[string]*ApiRateLimitConfig
+	buckets map[string]*TokenBucket
+	day     int64
+	quotas  map[uuid.UUID]map[string]int64
+}
+
+func NewApiRateLimiter(config Config) *ApiRateLimiter {
+	limits := make(map[string]*ApiRateLimitConfig, len(config.GetSocket().ApiRateLimits))
+	for name, limit := range config.GetSocket().ApiRateLimits {
+		if limit == nil || (limit.Rate <= 0 && limit.DailyQuota <= 0) {
+			continue
+		}
+		if strings.HasPrefix(name, apiRateLimitRpcPrefix) {
+			// RPC IDs are not case sensitive.
+			name = strings.ToLower(name)
+		}
+		limits[name] = limit
+	}
+
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+	l := &ApiRateLimiter{
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		limits:  limits,
+		buckets: make(map[string]*TokenBucket),
+		quotas:  make(map[uuid.UUID]map[string]int64),
+	}
+
+	if len(limits) != 0 {
+		go func() {
+			ticker := time.NewTicker(apiRateLimitSweepInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-l.ctx.Done():
+					return
+				case now := <-ticker.C:
+					l.sweep(now)
+				}
+			}
+		}()
+	}
+
+	return l
+}
+
+func (l *ApiRateLimiter) Stop() {
+	l.ctxCancelFn()
+}
+
+// Limits returns the names of the limits on an API method, and on the RPC ID if the request is a runtime RPC call.
+func (l *ApiRateLimiter) Limits(method, rpcID string) []string {
+	if len(l.limits) == 0 {
+		return nil
+	}
+
+	var names []string
+	if _, found := l.limits[method]; found {
+		names = append(names, method)
+	}
+	if rpcID != "" {
+		if name := apiRateLimitRpcPrefix + strings.ToLower(rpcID); l.limits[name] != nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Allow applies the named limits to a request from the caller. If the request is over a limit it returns an error,
+// the name of the limit, and how long until the caller may retry.
+func (l *ApiRateLimiter) Allow(names []string, caller *apiRateLimitCaller, now time.Time) (string, time.Duration, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	// Check every limit before counting the request against any of them.
+	buckets := make([]*TokenBucket, 0, len(names))
+	for _, name := range names {
+		limit := l.limits[name]
+		if limit.Rate <= 0 {
+			continue
+		}
+		key := name + "|" + caller.key(limit.Key)
+		bucket, found := l.buckets[key]
+		if !found {
+			bucket = NewTokenBucket(limit.Rate, limit.Burst, now)
+			l.buckets[key] = bucket
+		}
+		if delay := bucket.Delay(now); delay > 0 {
+			return name, delay, ErrApiRateLimitExceeded
+		}
+		buckets = append(buckets, bucket)
+	}
+	var quotas map[string]int64
+	if caller.userID != uuid.Nil {
+		// Only requests from users count towards quotas.
+		for _, name := range names {
+			limit := l.limits[name]
+			if limit.DailyQuota <= 0 {
+				continue
+			}
+			if quotas == nil {
+				quotas = l.userQuotas(caller.userID, now)
+			}
+			if quotas[name] >= limit.DailyQuota {
+				return name, l.nextDay(now).Sub(now), ErrApiQuotaExceeded
+			}
+		}
+	}
+
+	for _, bucket := range buckets {
+		bucket.Take()
+	}
+	if quotas != nil {
+		for _, name := range names {
+			if l.limits[name].DailyQuota > 0 {
+				quotas[name]++
+			}
+		}
+	}
+	return "", 0, nil
+}
+
+// Quotas returns the number of requests the user made today to each API method or RPC with a daily quota, keyed by
+// method name or "rpc:" followed by the RPC ID.
+func (l *ApiRateLimiter) Quotas(userID uuid.UUID) map[string]int64 {
+	l.Lock()
+	defer l.Unlock()
+
+	l.rollDay(time.Now())
+	counts := make(map[string]int64, len(l.quotas[userID]))
+	for name, count := range l.quotas[userID] {
+		counts[name] = count
+	}
+	return counts
+}
+
+// Reset all quota counts if the day has changed, must be called while holding the lock.
+func (l *ApiRateLimiter) rollDay(now time.Time) {
+	if day := now.UTC().Unix() / 86400; day != l.day {
+		l.day = day
+		l.quotas = make(map[uuid.UUID]map[string]int64)
+	}
+}
+
+// Get the user's quota counts for today, must be called while holding the lock.
+func (l *ApiRateLimiter) userQuotas(userID uuid.UUID, now time.Time) map[string]int64 {
+	l.rollDay(now)
+	quotas, found := l.quotas[userID]
+	if !found {
+		quotas = make(map[string]int64, 1)
+		l.quotas[userID] = quotas
+	}
+	return quotas
+}
+
+// Whole seconds a caller should wait before retrying, as sent in Retry-After headers.
+func apiRateLimitRetryAfter(retryAfter time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10)
+}
+
+func (l *ApiRateLimiter) nextDay(now time.Time) time.Time {
+	return time.Unix((now.UTC().Unix()/86400+1)*86400, 0)
+}
+
+// Discard buckets that have refilled, they hold nothing a new bucket would not.
+func (l *ApiRateLimiter) sweep(now time.Time) {
+	l.Lock()
+	for key, bucket := range l.buckets {
+		if bucket.Full(now) {
+			delete(l.buckets, key)
+		}
+	}
+	l.Unlock()
+}
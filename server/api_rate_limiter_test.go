@@ -0,0 +1,141 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newApiRateLimiterTest(t *testing.T, limits map[string]*ApiRateLimitConfig) *ApiRateLimiter {
+	config := NewConfig(logger)
+	config.GetSocket().ApiRateLimits = limits
+	limiter := NewApiRateLimiter(config)
+	t.Cleanup(limiter.Stop)
+	return limiter
+}
+
+// should limit each caller separately by the configured key, and refill over time
+func TestApiRateLimiterRate(t *testing.T) {
+	limiter := newApiRateLimiterTest(t, map[string]*ApiRateLimitConfig{
+		"AuthenticateDevice": {Rate: 1, Burst: 2, Key: ApiRateLimitKeyClientIP},
+		"ListFriends":        {Rate: 1, Burst: 1, Key: ApiRateLimitKeyUserID},
+	})
+	now := time.Now()
+
+	names := limiter.Limits("AuthenticateDevice", "")
+	if len(names) != 1 || names[0] != "AuthenticateDevice" {
+		t.Fatalf("expected method limit, got %v", names)
+	}
+	if names := limiter.Limits("AuthenticateEmail", ""); len(names) != 0 {
+		t.Fatalf("expected no limits, got %v", names)
+	}
+
+	first := &apiRateLimitCaller{clientIP: "10.0.0.1"}
+	for i := 0; i < 2; i++ {
+		if _, _, err := limiter.Allow(names, first, now); err != nil {
+			t.Fatalf("expected request %v within burst to be allowed, got %v", i, err)
+		}
+	}
+	name, retryAfter, err := limiter.Allow(names, first, now)
+	if err != ErrApiRateLimitExceeded || name != "AuthenticateDevice" || retryAfter <= 0 || retryAfter > time.Second {
+		t.Fatalf("expected rate limit exceeded, got %v %v %v", name, retryAfter, err)
+	}
+	if _, _, err := limiter.Allow(names, &apiRateLimitCaller{clientIP: "10.0.0.2"}, now); err != nil {
+		t.Fatalf("expected other address to be allowed, got %v", err)
+	}
+	if _, _, err := limiter.Allow(names, first, now.Add(time.Second)); err != nil {
+		t.Fatalf("expected request to be allowed after refill, got %v", err)
+	}
+
+	// User keyed limits share a bucket across addresses.
+	userID := uuid.Must(uuid.NewV4())
+	names = limiter.Limits("ListFriends", "")
+	if _, _, err := limiter.Allow(names, &apiRateLimitCaller{userID: userID, clientIP: "10.0.0.1"}, now); err != nil {
+		t.Fatalf("expected request to be allowed, got %v", err)
+	}
+	if _, _, err := limiter.Allow(names, &apiRateLimitCaller{userID: userID, clientIP: "10.0.0.2"}, now); err != ErrApiRateLimitExceeded {
+		t.Fatalf("expected rate limit exceeded, got %v", err)
+	}
+}
+
+// should count user requests against daily quotas, reject them once used up, and reset the next day
+func TestApiRateLimiterQuota(t *testing.T) {
+	limiter := newApiRateLimiterTest(t, map[string]*ApiRateLimitConfig{
+		"rpc:Reward": {DailyQuota: 2},
+		"RpcFunc":    {Rate: 1000, Burst: 1000, Key: ApiRateLimitKeyServerKey},
+	})
+	userID := uuid.Must(uuid.NewV4())
+	caller := &apiRateLimitCaller{userID: userID, clientIP: "10.0.0.1"}
+	now := time.Now()
+
+	names := limiter.Limits("RpcFunc", "reward")
+	if len(names) != 2 || names[1] != "rpc:reward" {
+		t.Fatalf("expected method and rpc limits, got %v", names)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := limiter.Allow(names, caller, now); err != nil {
+			t.Fatalf("expected request %v within quota to be allowed, got %v", i, err)
+		}
+	}
+	name, retryAfter, err := limiter.Allow(names, caller, now)
+	if err != ErrApiQuotaExceeded || name != "rpc:reward" || retryAfter <= 0 || retryAfter > 24*time.Hour {
+		t.Fatalf("expected quota exceeded, got %v %v %v", name, retryAfter, err)
+	}
+	if quotas := limiter.Quotas(userID); len(quotas) != 1 || quotas["rpc:reward"] != 2 {
+		t.Fatalf("expected quota count of 2, got %v", quotas)
+	}
+
+	// Requests without a user are not counted.
+	if _, _, err := limiter.Allow(names, &apiRateLimitCaller{clientIP: "10.0.0.1"}, now); err != nil {
+		t.Fatalf("expected request without user to be allowed, got %v", err)
+	}
+
+	if _, _, err := limiter.Allow(names, caller, now.Add(24*time.Hour)); err != nil {
+		t.Fatalf("expected request to be allowed the next day, got %v", err)
+	}
+}
+
+// should reject requests over the limit with a resource exhausted status
+func TestApiRateLimitInterceptor(t *testing.T) {
+	limiter := newApiRateLimiterTest(t, map[string]*ApiRateLimitConfig{
+		"rpc:reward": {Rate: 0.001, Burst: 1, Key: ApiRateLimitKeyServerKey},
+	})
+	metrics := &testMetrics{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/nakama.api.Nakama/RpcFunc"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "10.0.0.1"))
+
+	if err := rateLimitInterceptorFunc(logger, metrics, limiter, ctx, &api.Rpc{Id: "Reward", HttpKey: "key"}, info); err != nil {
+		t.Fatalf("expected request to be allowed, got %v", err)
+	}
+	if err := rateLimitInterceptorFunc(logger, metrics, limiter, ctx, &api.Rpc{Id: "Other", HttpKey: "key"}, info); err != nil {
+		t.Fatalf("expected other rpc to be allowed, got %v", err)
+	}
+	if err := rateLimitInterceptorFunc(logger, metrics, limiter, ctx, &api.Rpc{Id: "reward", HttpKey: "other"}, info); err != nil {
+		t.Fatalf("expected other key to be allowed, got %v", err)
+	}
+	err := rateLimitInterceptorFunc(logger, metrics, limiter, ctx, &api.Rpc{Id: "reward", HttpKey: "key"}, info)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("expected resource exhausted, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func (s *ApiServer) Stream(stream apigrpc.NakamaRealtime_StreamServer) error {
+	ctx := stream.Context()
+	userID := ctx.Value(ctxUserIDKey{}).(uuid.UUID)
+	username := ctx.Value(ctxUsernameKey{}).(string)
+	vars := ctx.Value(ctxVarsKey{}).(map[string]string)
+	expiry := ctx.Value(ctxExpiryKey{}).(int64)
+
+	// Options the WebSocket socket takes as query parameters are sent as request metadata.
+	md, _ := metadata.FromIncomingContext(ctx)
+	lang := "en"
+	if langParam := md.Get("lang"); len(langParam) > 0 && langParam[0] != "" {
+		lang = langParam[0]
+	}
+	var status bool
+	if statusParam := md.Get("status"); len(statusParam) > 0 {
+		status, _ = strconv.ParseBool(statusParam[0])
+	}
+
+	clientIP, clientPort := extractClientAddressFromContext(s.logger, ctx)
+	sessionID := uuid.Must(s.sessionIdGen.NewV1())
+
+	// Mark the online status of the user.
+	s.metrics.GaugeOnlineStatus(userID, true)
+
+	// Wrap the stream for application handling.
+	session := NewSessionGRPC(s.logger, s.config, sessionID, userID, username, vars, expiry, clientIP, clientPort, lang, stream, s.sessionRegistry, s.statusRegistry, s.matchmaker, s.tracker, s.metrics, s.pipeline, s.runtime)
+
+	// Add to the session registry.
+	s.sessionRegistry.Add(session)
+
+	// Register initial status tracking and presence(s) for this session.
+	s.statusRegistry.Follow(sessionID, map[uuid.UUID]struct{}{userID: {}})
+	if status {
+		// Both notification and status presence.
+		s.tracker.TrackMulti(session.Context(), sessionID, []*TrackerOp{
+			{
+				Stream: PresenceStream{Mode: StreamModeNotifications, Subject: userID},
+				Meta:   PresenceMeta{Format: SessionFormatProtobuf, Username: username, Hidden: true},
+			},
+			{
+				Stream: PresenceStream{Mode: StreamModeStatus, Subject: userID},
+				Meta:   PresenceMeta{Format: SessionFormatProtobuf, Username: username, Status: ""},
+			},
+		}, userID, true)
+	} else {
+		// Only notification presence.
+		s.tracker.Track(session.Context(), sessionID, PresenceStream{Mode: StreamModeNotifications, Subject: userID}, userID, PresenceMeta{Format: SessionFormatProtobuf, Username: username, Hidden: true}, true)
+	}
+
+	if s.config.GetSession().SingleSocket {
+		// Kick any other sockets for this user.
+		go s.sessionRegistry.SingleSession(session.Context(), s.tracker, userID, sessionID)
+	}
+
+	// Close the session if the server stops first.
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			session.Close("server shutting down", runtime.PresenceReasonDisconnect)
+		case <-session.Context().Done():
+		}
+	}()
+
+	// Allow the server to begin processing incoming messages from this session.
+	session.Consume()
+
+	// Mark the online status of the user. Considers of single user having multiple sessions.
+	s.metrics.GaugeOnlineStatus(userID,
+		len(s.tracker.ListLocalSessionIDByStream(PresenceStream{
+			Mode: StreamModeNotifications, Subject: userID,
+		})) > 0,
+	)
+
+	return nil
+}
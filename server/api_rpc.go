@@ -35,12 +35,15 @@ import (
 var (
 	authTokenInvalidBytes    = []byte(`{"error":"Auth token invalid","message":"Auth token invalid","code":16}`)
 	httpKeyInvalidBytes      = []byte(`{"error":"HTTP key invalid","message":"HTTP key invalid","code":16}`)
+	httpKeyNotAllowedBytes   = []byte(`{"error":"HTTP key not allowed","message":"HTTP key not allowed","code":7}`)
 	noAuthBytes              = []byte(`{"error":"Auth token or HTTP key required","message":"Auth token or HTTP key required","code":16}`)
 	rpcIDMustBeSetBytes      = []byte(`{"error":"RPC ID must be set","message":"RPC ID must be set","code":3}`)
 	rpcFunctionNotFoundBytes = []byte(`{"error":"RPC function not found","message":"RPC function not found","code":5}`)
 	internalServerErrorBytes = []byte(`{"error":"Internal Server Error","message":"Internal Server Error","code":13}`)
 	badJSONBytes             = []byte(`{"error":"json: cannot unmarshal object into Go value of type string","message":"json: cannot unmarshal object into Go value of type string","code":3}`)
 	requestBodyTooLargeBytes = []byte(`{"code":3, "message":"http: request body too large"}`)
+	rateLimitExceededBytes   = []byte(`{"error":"Rate limit exceeded","message":"Rate limit exceeded","code":8}`)
+	quotaExceededBytes       = []byte(`{"error":"Daily quota exceeded","message":"Daily quota exceeded","code":8}`)
 )
 
 func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
@@ -52,7 +55,16 @@ func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
 	var vars map[string]string
 	var expiry int64
 	if httpKey := queryParams.Get("http_key"); httpKey != "" {
-		if httpKey != s.config.GetRuntime().HTTPKey {
+		if _, err := s.serverKeys.ValidateHttpKey(httpKey, mux.Vars(r)["id"], time.Now()); err == ErrServerKeyNotAllowed {
+			// HTTP key is not allowed to call this RPC function.
+			w.Header().Set("content-type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, err := w.Write(httpKeyNotAllowedBytes)
+			if err != nil {
+				s.logger.Debug("Error writing response to client", zap.Error(err))
+			}
+			return
+		} else if err != nil {
 			// HTTP key did not match.
 			w.Header().Set("content-type", "application/json")
 			w.WriteHeader(http.StatusUnauthorized)
@@ -111,6 +123,29 @@ func (s *ApiServer) RpcFuncHttp(w http.ResponseWriter, r *http.Request) {
 	}
 	id = strings.ToLower(maybeID)
 
+	// Apply any rate limits on RPC calls, keyed the same way as calls through the gRPC API.
+	if names := s.apiLimiter.Limits("RpcFunc", id); len(names) != 0 {
+		caller := &apiRateLimitCaller{userID: userID, serverKey: queryParams.Get("http_key")}
+		caller.clientIP, _ = extractClientAddressFromRequest(s.logger, r)
+		if name, retryAfter, limitErr := s.apiLimiter.Allow(names, caller, time.Now()); limitErr != nil {
+			limitedBytes := rateLimitExceededBytes
+			reason := "rate"
+			if limitErr == ErrApiQuotaExceeded {
+				limitedBytes = quotaExceededBytes
+				reason = "quota"
+			}
+			s.metrics.ApiRateLimited(name, reason)
+			w.Header().Set("content-type", "application/json")
+			w.Header().Set("retry-after", apiRateLimitRetryAfter(retryAfter))
+			w.WriteHeader(http.StatusTooManyRequests)
+			sentBytes, err = w.Write(limitedBytes)
+			if err != nil {
+				s.logger.Debug("Error writing response to client", zap.Error(err))
+			}
+			return
+		}
+	}
+
 	// Find the correct RPC function.
 	fn := s.runtime.Rpc(id)
 	if fn == nil {
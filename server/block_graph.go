@@ -0,0 +1,193 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Users loaded with each block graph query, keeping well below the database bind parameter limit.
+const blockGraphLoadBatchSize = 1000
+
+type BlockGraph interface {
+	Stop()
+	// Blocked returns all users who have blocked, or have been blocked by, any of the given users.
+	Blocked(ctx context.Context, userIDs []string) (map[string]struct{}, error)
+}
+
+type blockGraphEntry struct {
+	blocked  map[string]struct{}
+	loadedAt time.Time
+}
+
+type LocalBlockGraph struct {
+	sync.RWMutex
+	logger *zap.Logger
+	db     *sql.DB
+	ttl    time.Duration
+	// Users loaded with each query.
+	batchSize int
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	// Block relationships in either direction, for each user.
+	users map[string]*blockGraphEntry
+}
+
+// NewLocalBlockGraph returns nil if block-aware matchmaking and parties are disabled.
+func NewLocalBlockGraph(logger *zap.Logger, db *sql.DB, config Config) BlockGraph {
+	if !config.GetMatchmaker().BlockAware {
+		return nil
+	}
+
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	g := &LocalBlockGraph{
+		logger: logger,
+		db:     db,
+		ttl:    time.Duration(config.GetMatchmaker().BlockCacheTtlSec) * time.Second,
+
+		batchSize: blockGraphLoadBatchSize,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		users: make(map[string]*blockGraphEntry),
+	}
+
+	go func() {
+		ticker := time.NewTicker(g.ttl)
+		for {
+			select {
+			case <-g.ctx.Done():
+				ticker.Stop()
+				return
+			case t := <-ticker.C:
+				g.Lock()
+				for userID, entry := range g.users {
+					if t.Sub(entry.loadedAt) >= g.ttl {
+						delete(g.users, userID)
+					}
+				}
+				g.Unlock()
+			}
+		}
+	}()
+
+	return g
+}
+
+func (g *LocalBlockGraph) Stop() {
+	g.ctxCancelFn()
+}
+
+func (g *LocalBlockGraph) Blocked(ctx context.Context, userIDs []string) (map[string]struct{}, error) {
+	now := time.Now()
+	blocked := make(map[string]struct{})
+	load := make([]string, 0, len(userIDs))
+	seen := make(map[string]struct{}, len(userIDs))
+
+	g.RLock()
+	for _, userID := range userIDs {
+		if _, found := seen[userID]; found {
+			continue
+		}
+		seen[userID] = struct{}{}
+		entry, found := g.users[userID]
+		if !found || now.Sub(entry.loadedAt) >= g.ttl {
+			load = append(load, userID)
+			continue
+		}
+		for blockedUserID := range entry.blocked {
+			blocked[blockedUserID] = struct{}{}
+		}
+	}
+	g.RUnlock()
+
+	if len(load) == 0 {
+		return blocked, nil
+	}
+
+	loaded := make(map[string]*blockGraphEntry, len(load))
+	for _, userID := range load {
+		loaded[userID] = &blockGraphEntry{blocked: make(map[string]struct{}), loadedAt: now}
+	}
+
+	for start := 0; start < len(load); start += g.batchSize {
+		end := start + g.batchSize
+		if end > len(load) {
+			end = len(load)
+		}
+		if err := g.load(ctx, load[start:end], loaded); err != nil {
+			return nil, err
+		}
+	}
+
+	g.Lock()
+	for userID, entry := range loaded {
+		g.users[userID] = entry
+		for blockedUserID := range entry.blocked {
+			blocked[blockedUserID] = struct{}{}
+		}
+	}
+	g.Unlock()
+
+	return blocked, nil
+}
+
+// Load the block relationships of a batch of users into their entries.
+func (g *LocalBlockGraph) load(ctx context.Context, userIDs []string, loaded map[string]*blockGraphEntry) error {
+	statements := make([]string, 0, len(userIDs))
+	params := make([]interface{}, 0, len(userIDs))
+	for i, userID := range userIDs {
+		statements = append(statements, "$"+strconv.Itoa(i+1))
+		params = append(params, userID)
+	}
+	in := strings.Join(statements, ", ")
+
+	query := "SELECT source_id, destination_id FROM user_edge WHERE state = 3 AND (source_id IN (" + in + ") OR destination_id IN (" + in + "))"
+	rows, err := g.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		g.logger.Error("Error loading user block graph.", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sourceID, destinationID string
+		if err := rows.Scan(&sourceID, &destinationID); err != nil {
+			g.logger.Error("Error scanning user block graph.", zap.Error(err))
+			return err
+		}
+		if entry, found := loaded[sourceID]; found {
+			entry.blocked[destinationID] = struct{}{}
+		}
+		if entry, found := loaded[destinationID]; found {
+			entry.blocked[sourceID] = struct{}{}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		g.logger.Error("Error reading user block graph.", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+// should load block relationships for more users than fit in one query, ignoring repeated users
+func TestLocalBlockGraphBatches(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+
+	config := NewConfig(logger)
+	config.GetMatchmaker().BlockAware = true
+	g := NewLocalBlockGraph(logger, db, config).(*LocalBlockGraph)
+	defer g.Stop()
+	g.batchSize = 2
+
+	userIDs := make([]uuid.UUID, 5)
+	for i := range userIDs {
+		userIDs[i] = uuid.Must(uuid.NewV4())
+		InsertUser(t, db, userIDs[i])
+	}
+	blocker := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, blocker)
+	// The blocker blocks the first and last users, which land in different batches.
+	for i, blockedUserID := range []uuid.UUID{userIDs[0], userIDs[4]} {
+		if _, err := db.Exec("INSERT INTO user_edge (source_id, destination_id, state, position) VALUES ($1, $2, 3, $3)", blocker, blockedUserID, i); err != nil {
+			t.Fatalf("error inserting block: %v", err)
+		}
+	}
+
+	query := make([]string, 0, 2*len(userIDs))
+	for _, userID := range userIDs {
+		query = append(query, userID.String(), userID.String())
+	}
+	blocked, err := g.Blocked(context.Background(), query)
+	if err != nil {
+		t.Fatalf("error loading blocked users: %v", err)
+	}
+	if _, found := blocked[blocker.String()]; !found || len(blocked) != 1 {
+		t.Fatalf("expected only %v to be blocked, got %v", blocker, blocked)
+	}
+	if len(g.users) != len(userIDs) {
+		t.Fatalf("expected %v users cached, got %v", len(userIDs), len(g.users))
+	}
+	for _, i := range []int{0, 4} {
+		if _, found := g.users[userIDs[i].String()].blocked[blocker.String()]; !found {
+			t.Fatalf("expected user %v to be blocked by %v", userIDs[i], blocker)
+		}
+	}
+}
@@ -140,6 +140,12 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetSession().SingleMatch && !config.GetSession().SingleSocket {
 		logger.Fatal("Single match cannot be enabled without single socket", zap.Strings("param", []string{"session.single_match", "session.single_socket"}))
 	}
+	if config.GetSession().ResumeGraceSec < 0 {
+		logger.Fatal("Session resume grace seconds must be >= 0", zap.Int("session.resume_grace_sec", config.GetSession().ResumeGraceSec))
+	}
+	if config.GetSession().ResumeBufferSize < 1 {
+		logger.Fatal("Session resume buffer size must be >= 1", zap.Int("session.resume_buffer_size", config.GetSession().ResumeBufferSize))
+	}
 	if config.GetRuntime().HTTPKey == "" {
 		logger.Fatal("Runtime HTTP key must be set", zap.String("param", "runtime.http_key"))
 	}
@@ -191,6 +197,53 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetSocket().PingPeriodMs >= config.GetSocket().PongWaitMs {
 		logger.Fatal("Ping period value must be less than pong wait value", zap.Int("socket.ping_period_ms", config.GetSocket().PingPeriodMs), zap.Int("socket.pong_wait_ms", config.GetSocket().PongWaitMs))
 	}
+	if config.GetSocket().CompressionMinBytes < 0 {
+		logger.Fatal("Socket compression min bytes must be >= 0", zap.Int("socket.compression_min_bytes", config.GetSocket().CompressionMinBytes))
+	}
+	if l := config.GetSocket().CompressionLevel; l < 1 || l > 9 {
+		logger.Fatal("Socket compression level must be between 1 and 9", zap.Int("socket.compression_level", l))
+	}
+	if config.GetSocket().UdpPort < 0 {
+		logger.Fatal("Socket UDP port must be >= 0", zap.Int("socket.udp_port", config.GetSocket().UdpPort))
+	}
+	if config.GetSocket().UdpPacketSizeBytes < 64 {
+		logger.Fatal("Socket UDP packet size bytes must be >= 64", zap.Int("socket.udp_packet_size_bytes", config.GetSocket().UdpPacketSizeBytes))
+	}
+	for name, limit := range config.GetSocket().MessageRateLimits {
+		if !isEnvelopeMessageName(name) {
+			logger.Fatal("Socket message rate limits must be keyed by real-time message type", zap.String("message", name))
+		}
+		if limit == nil || limit.Rate < 0 || (limit.Rate > 0 && limit.Burst < 1) {
+			logger.Fatal("Socket message rate limits must have rate >= 0 and burst >= 1", zap.String("message", name))
+		}
+	}
+	if _, err := ParseMessageRateLimitAction(config.GetSocket().MessageLimitAction); err != nil {
+		logger.Fatal("Socket message limit action must be error or throttle", zap.String("socket.message_limit_action", config.GetSocket().MessageLimitAction))
+	}
+	if config.GetSocket().MessageMaxViolations < 0 {
+		logger.Fatal("Socket message max violations must be >= 0", zap.Int("socket.message_max_violations", config.GetSocket().MessageMaxViolations))
+	}
+	for name, limit := range config.GetSocket().ApiRateLimits {
+		if !isApiRateLimitName(name) {
+			logger.Fatal("Socket API rate limits must be keyed by API method name or 'rpc:' followed by an RPC ID", zap.String("name", name))
+		}
+		if limit == nil || limit.Rate < 0 || (limit.Rate > 0 && limit.Burst < 1) || limit.DailyQuota < 0 {
+			logger.Fatal("Socket API rate limits must have rate >= 0, burst >= 1 and daily quota >= 0", zap.String("name", name))
+		}
+		switch limit.Key {
+		case "", ApiRateLimitKeyUserID, ApiRateLimitKeyClientIP, ApiRateLimitKeyServerKey:
+		default:
+			logger.Fatal("Socket API rate limit key must be user_id, client_ip or server_key", zap.String("name", name), zap.String("key", limit.Key))
+		}
+	}
+	for name, provider := range config.GetSocial().Oidc {
+		if name == "" || provider == nil || provider.Issuer == "" {
+			logger.Fatal("Social OIDC providers must have a name and an issuer", zap.String("name", name))
+		}
+		if len(provider.Audiences) < 1 {
+			logger.Fatal("Social OIDC providers must have at least one audience", zap.String("name", name))
+		}
+	}
 	if len(config.GetDatabase().Addresses) < 1 {
 		logger.Fatal("At least one database address must be specified", zap.Strings("database.address", config.GetDatabase().Addresses))
 	}
@@ -251,9 +304,32 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetMatch().JoinMarkerDeadlineMs < 1 {
 		logger.Fatal("Match join marker deadline must be >= 1", zap.Int("match.join_marker_deadline_ms", config.GetMatch().JoinMarkerDeadlineMs))
 	}
+	if config.GetMatch().ReconnectWindowSec < 0 {
+		logger.Fatal("Match reconnect window seconds must be >= 0", zap.Int("match.reconnect_window_sec", config.GetMatch().ReconnectWindowSec))
+	}
+	if config.GetMatch().OverrunLogTicks < 1 {
+		logger.Fatal("Match overrun log ticks must be >= 1", zap.Int("match.overrun_log_ticks", config.GetMatch().OverrunLogTicks))
+	}
+	if config.GetMatch().DataRateLimit.Rate < 0 {
+		logger.Fatal("Match data rate limit rate must be >= 0", zap.Float64("match.data_rate_limit.rate", config.GetMatch().DataRateLimit.Rate))
+	}
+	if config.GetMatch().DataRateLimit.Rate > 0 && config.GetMatch().DataRateLimit.Burst < 1 {
+		logger.Fatal("Match data rate limit burst must be >= 1", zap.Int("match.data_rate_limit.burst", config.GetMatch().DataRateLimit.Burst))
+	}
+	for opCode, limit := range config.GetMatch().DataOpCodeRateLimits {
+		if limit == nil || limit.Rate < 0 || (limit.Rate > 0 && limit.Burst < 1) {
+			logger.Fatal("Match data op code rate limits must have rate >= 0 and burst >= 1", zap.Int64("op_code", opCode))
+		}
+	}
+	if _, err := ParseMatchDataRateLimitAction(config.GetMatch().DataRateLimitAction); err != nil {
+		logger.Fatal("Match data rate limit action must be drop, notify or kick", zap.String("match.data_rate_limit_action", config.GetMatch().DataRateLimitAction))
+	}
 	if config.GetMatch().MaxEmptySec < 0 {
 		logger.Fatal("Match max idle seconds must be >= 0", zap.Int("match.max_empty_sec", config.GetMatch().MaxEmptySec))
 	}
+	if config.GetMatch().RecordStateIntervalMs < 1 {
+		logger.Fatal("Match record state interval milliseconds must be > 0", zap.Int("match.record_state_interval_ms", config.GetMatch().RecordStateIntervalMs))
+	}
 	if config.GetMatch().LabelUpdateIntervalMs < 1 {
 		logger.Fatal("Match label update interval milliseconds must be > 0", zap.Int("match.label_update_interval_ms", config.GetMatch().LabelUpdateIntervalMs))
 	}
@@ -281,6 +357,41 @@ func CheckConfig(logger *zap.Logger, config Config) map[string]string {
 	if config.GetMatchmaker().RevThreshold < 0 {
 		logger.Fatal("Matchmaker reverse matching threshold must be >= 0", zap.Int("matchmaker.rev_threshold", config.GetMatchmaker().RevThreshold))
 	}
+	if config.GetMatchmaker().MaxCandidates < 1 {
+		logger.Fatal("Matchmaker max candidates must be >= 1", zap.Int("matchmaker.max_candidates", config.GetMatchmaker().MaxCandidates))
+	}
+	if config.GetMatchmaker().RttMaxMs < 1 {
+		logger.Fatal("Matchmaker max round trip time must be >= 1", zap.Int("matchmaker.rtt_max_ms", config.GetMatchmaker().RttMaxMs))
+	}
+	if config.GetMatchmaker().RttWidenMs < 0 {
+		logger.Fatal("Matchmaker round trip time widening must be >= 0", zap.Int("matchmaker.rtt_widen_ms", config.GetMatchmaker().RttWidenMs))
+	}
+	if config.GetMatchmaker().RttLimitMs < config.GetMatchmaker().RttMaxMs {
+		logger.Fatal("Matchmaker round trip time limit must be >= max round trip time", zap.Int("matchmaker.rtt_limit_ms", config.GetMatchmaker().RttLimitMs))
+	}
+	if config.GetMatchmaker().BlockCacheTtlSec < 1 {
+		logger.Fatal("Matchmaker block cache ttl seconds must be >= 1", zap.Int("matchmaker.block_cache_ttl_sec", config.GetMatchmaker().BlockCacheTtlSec))
+	}
+	for name, queue := range config.GetMatchmaker().Queues {
+		if name == "" || name == MatchmakerDefaultQueue {
+			logger.Fatal("Matchmaker queue name must not be empty or reserved", zap.String("matchmaker.queues", name))
+		}
+		if queue == nil {
+			logger.Fatal("Matchmaker queue must have settings", zap.String("matchmaker.queues", name))
+		}
+		if queue.MaxTickets < 1 {
+			logger.Fatal("Matchmaker queue maximum ticket count must be >= 1", zap.String("queue", name), zap.Int("matchmaker.queues.max_tickets", queue.MaxTickets))
+		}
+		if queue.IntervalSec < 1 {
+			logger.Fatal("Matchmaker queue interval time seconds must be >= 1", zap.String("queue", name), zap.Int("matchmaker.queues.interval_sec", queue.IntervalSec))
+		}
+		if queue.MaxIntervals < 1 {
+			logger.Fatal("Matchmaker queue max intervals must be >= 1", zap.String("queue", name), zap.Int("matchmaker.queues.max_intervals", queue.MaxIntervals))
+		}
+		if queue.RevThreshold < 0 {
+			logger.Fatal("Matchmaker queue reverse matching threshold must be >= 0", zap.String("queue", name), zap.Int("matchmaker.queues.rev_threshold", queue.RevThreshold))
+		}
+	}
 
 	// If the runtime path is not overridden, set it to `datadir/modules`.
 	if config.GetRuntime().Path == "" {
@@ -526,6 +637,11 @@ func (c *config) Clone() (Config, error) {
 	}
 	nc.Leaderboard.BlacklistRankCache = make([]string, len(c.Leaderboard.BlacklistRankCache))
 	copy(nc.Leaderboard.BlacklistRankCache, c.Leaderboard.BlacklistRankCache)
+	nc.Matchmaker.Queues = make(map[string]*MatchmakerQueueConfig, len(c.Matchmaker.Queues))
+	for k, v := range c.Matchmaker.Queues {
+		queue := *v
+		nc.Matchmaker.Queues[k] = &queue
+	}
 
 	return nc, nil
 }
@@ -655,6 +771,8 @@ type SessionConfig struct {
 	RefreshTokenExpirySec int64  `yaml:"refresh_token_expiry_sec" json:"refresh_token_expiry_sec" usage:"Refresh token expiry in seconds."`
 	SingleSocket          bool   `yaml:"single_socket" json:"single_socket" usage:"Only allow one socket per user. Older sessions are disconnected. Default false."`
 	SingleMatch           bool   `yaml:"single_match" json:"single_match" usage:"Only allow one match per user. Older matches receive a leave. Requires single socket to enable. Default false."`
+	ResumeGraceSec        int    `yaml:"resume_grace_sec" json:"resume_grace_sec" usage:"Seconds a resumable real-time session is kept after its connection is lost, with its presences intact and outgoing messages buffered, waiting for the client to resume it. Set to 0 to disable session resumption. Default 0."`
+	ResumeBufferSize      int    `yaml:"resume_buffer_size" json:"resume_buffer_size" usage:"The maximum number of recent outgoing messages kept by a resumable session to replay to the client when it resumes. Default 128."`
 }
 
 func NewSessionConfig() *SessionConfig {
@@ -663,6 +781,8 @@ func NewSessionConfig() *SessionConfig {
 		TokenExpirySec:        60,
 		RefreshEncryptionKey:  "defaultrefreshencryptionkey",
 		RefreshTokenExpirySec: 3600,
+		ResumeGraceSec:        0,
+		ResumeBufferSize:      128,
 	}
 }
 
@@ -684,6 +804,12 @@ type SocketConfig struct {
 	PingPeriodMs         int               `yaml:"ping_period_ms" json:"ping_period_ms" usage:"Time in milliseconds to wait between sending ping messages to the client. This value must be less than the pong_wait_ms. Used for real-time connections."`
 	PingBackoffThreshold int               `yaml:"ping_backoff_threshold" json:"ping_backoff_threshold" usage:"Minimum number of messages received from the client during a single ping period that will delay the sending of a ping until the next ping period, to avoid sending unnecessary pings on regularly active connections. Default 20."`
 	OutgoingQueueSize    int               `yaml:"outgoing_queue_size" json:"outgoing_queue_size" usage:"The maximum number of messages waiting to be sent to the client. If this is exceeded the client is considered too slow and will disconnect. Used when processing real-time connections."`
+	CompressionJson      bool              `yaml:"compression_json" json:"compression_json" usage:"Compress outgoing WebSocket messages with permessage-deflate for sessions using the JSON format, if the client supports it. Default true."`
+	CompressionProtobuf  bool              `yaml:"compression_protobuf" json:"compression_protobuf" usage:"Compress outgoing WebSocket messages with permessage-deflate for sessions using the binary protobuf or msgpack formats, if the client supports it. Default false."`
+	CompressionMinBytes  int               `yaml:"compression_min_bytes" json:"compression_min_bytes" usage:"Minimum size in bytes of an outgoing WebSocket message for it to be compressed, smaller messages are always sent uncompressed. Default 256."`
+	CompressionLevel     int               `yaml:"compression_level" json:"compression_level" usage:"Compression level for outgoing WebSocket messages, from 1 for the fastest to 9 for the smallest output. Default 1."`
+	UdpPort              int               `yaml:"udp_port" json:"udp_port" usage:"The port for accepting real-time UDP connections from the client, on the same address as other client traffic. Set to 0 to disable the UDP transport. Default 0."`
+	UdpPacketSizeBytes   int               `yaml:"udp_packet_size_bytes" json:"udp_packet_size_bytes" usage:"Maximum size in bytes of a single UDP packet. Larger reliable messages are split across several packets, larger unreliable messages are sent reliably. Default 1200."`
 	SSLCertificate       string            `yaml:"ssl_certificate" json:"ssl_certificate" usage:"Path to certificate file if you want the server to use SSL directly. Must also supply ssl_private_key. NOT recommended for production use."`
 	SSLPrivateKey        string            `yaml:"ssl_private_key" json:"ssl_private_key" usage:"Path to private key file if you want the server to use SSL directly. Must also supply ssl_certificate. NOT recommended for production use."`
 	ResponseHeaders      []string          `yaml:"response_headers" json:"response_headers" usage:"Additional headers to send to clients with every response. Values here are only used if the response would not otherwise contain a value for the specified headers."`
@@ -691,6 +817,11 @@ type SocketConfig struct {
 	CertPEMBlock         []byte            `yaml:"-" json:"-"` // Created by fully reading the file contents of SSLCertificate, not set from input args directly.
 	KeyPEMBlock          []byte            `yaml:"-" json:"-"` // Created by fully reading the file contents of SSLPrivateKey, not set from input args directly.
 	TLSCert              []tls.Certificate `yaml:"-" json:"-"` // Created by processing CertPEMBlock and KeyPEMBlock, not set from input args directly.
+
+	MessageRateLimits    map[string]*MessageRateLimitConfig `yaml:"message_rate_limits" json:"message_rate_limits" usage:"Limits on the real-time messages each session may send, keyed by message type as named in the JSON envelope, for example 'channel_message_send', 'rpc', 'matchmaker_add' or 'status_update'."`
+	MessageLimitAction   string                             `yaml:"message_limit_action" json:"message_limit_action" usage:"What happens when a session exceeds a real-time message rate limit. 'error' rejects the message and sends the session an error, 'throttle' processes the message once the limit allows it, holding up the session's later messages so they stay in order. Messages that would wait more than 5 seconds are rejected with an error. Default 'error'."`
+	MessageMaxViolations int                                `yaml:"message_max_violations" json:"message_max_violations" usage:"Number of times a session may exceed its real-time message rate limits before it is closed. 0 never closes sessions for exceeding limits. Default 0."`
+	ApiRateLimits        map[string]*ApiRateLimitConfig     `yaml:"api_rate_limits" json:"api_rate_limits" usage:"Rate limits and daily quotas on gRPC and HTTP API requests, keyed by API method name, for example 'AuthenticateDevice' or 'ListFriends', or by 'rpc:' followed by a runtime RPC ID. Calls to runtime RPC functions are subject to both the 'RpcFunc' and their own RPC ID limits."`
 }
 
 func NewSocketConfig() *SocketConfig {
@@ -711,11 +842,35 @@ func NewSocketConfig() *SocketConfig {
 		PingPeriodMs:         15000,
 		PingBackoffThreshold: 20,
 		OutgoingQueueSize:    64,
+		CompressionJson:      true,
+		CompressionProtobuf:  false,
+		CompressionMinBytes:  256,
+		CompressionLevel:     1,
+		UdpPort:              0,
+		UdpPacketSizeBytes:   1200,
+		MessageRateLimits:    make(map[string]*MessageRateLimitConfig),
+		MessageLimitAction:   "error",
+		MessageMaxViolations: 0,
+		ApiRateLimits:        make(map[string]*ApiRateLimitConfig),
 		SSLCertificate:       "",
 		SSLPrivateKey:        "",
 	}
 }
 
+// MessageRateLimitConfig is a token bucket limit on the real-time messages of one type a session may send.
+type MessageRateLimitConfig struct {
+	Rate  float64 `yaml:"rate" json:"rate" usage:"Sustained number of messages per second. 0 disables the limit."`
+	Burst int     `yaml:"burst" json:"burst" usage:"Number of messages allowed at once above the sustained rate."`
+}
+
+// ApiRateLimitConfig is a token bucket limit and a daily quota on the requests to one API method or runtime RPC.
+type ApiRateLimitConfig struct {
+	Rate       float64 `yaml:"rate" json:"rate" usage:"Sustained number of requests per second. 0 disables the rate limit."`
+	Burst      int     `yaml:"burst" json:"burst" usage:"Number of requests allowed at once above the sustained rate."`
+	Key        string  `yaml:"key" json:"key" usage:"Who the rate limit applies to separately. 'user_id' for each authenticated user, 'client_ip' for each client address, or 'server_key' for each server key or runtime HTTP key requests authenticate with. Requests without the user or key fall back to their client address. Default 'user_id'."`
+	DailyQuota int64   `yaml:"daily_quota" json:"daily_quota" usage:"Number of requests each authenticated user may make per UTC day. 0 disables the quota."`
+}
+
 // DatabaseConfig is configuration relevant to the Database storage.
 type DatabaseConfig struct {
 	Addresses          []string `yaml:"address" json:"address" usage:"List of database servers (username:password@address:port/dbname). Default 'root@localhost:26257'."`
@@ -741,6 +896,7 @@ type SocialConfig struct {
 	FacebookInstantGame  *SocialConfigFacebookInstantGame  `yaml:"facebook_instant_game" json:"facebook_instant_game" usage:"Facebook Instant Game configuration."`
 	FacebookLimitedLogin *SocialConfigFacebookLimitedLogin `yaml:"facebook_limited_login" json:"facebook_limited_login" usage:"Facebook Limited Login configuration."`
 	Apple                *SocialConfigApple                `yaml:"apple" json:"apple" usage:"Apple Sign In configuration."`
+	Oidc                 map[string]*SocialConfigOidc      `yaml:"oidc" json:"oidc" usage:"OpenID Connect providers accepted by the AuthenticateOidc and LinkOidc APIs, keyed by the provider name clients send with their ID tokens."`
 }
 
 // SocialConfigSteam is configuration relevant to Steam.
@@ -764,6 +920,16 @@ type SocialConfigApple struct {
 	BundleId string `yaml:"bundle_id" json:"bundle_id" usage:"Apple Sign In bundle ID."`
 }
 
+// SocialConfigOidc is configuration relevant to an OpenID Connect provider.
+type SocialConfigOidc struct {
+	Issuer           string   `yaml:"issuer" json:"issuer" usage:"Issuer URL expected in the 'iss' claim of ID tokens."`
+	Audiences        []string `yaml:"audiences" json:"audiences" usage:"Accepted values of the 'aud' claim of ID tokens, usually the client IDs registered with the provider."`
+	JwksUrl          string   `yaml:"jwks_url" json:"jwks_url" usage:"URL of the provider's JSON Web Key Set. Default read from the provider's discovery document."`
+	DiscoveryUrl     string   `yaml:"discovery_url" json:"discovery_url" usage:"URL of the provider's discovery document. Default the issuer URL followed by '/.well-known/openid-configuration'."`
+	UsernameClaim    string   `yaml:"username_claim" json:"username_claim" usage:"ID token claim used as the username of new accounts. Default 'preferred_username'."`
+	DisplayNameClaim string   `yaml:"display_name_claim" json:"display_name_claim" usage:"ID token claim used as the display name of new accounts. Default 'name'."`
+}
+
 func NewSocialConfig() *SocialConfig {
 	return &SocialConfig{
 		Steam: &SocialConfigSteam{
@@ -779,6 +945,7 @@ func NewSocialConfig() *SocialConfig {
 		Apple: &SocialConfigApple{
 			BundleId: "",
 		},
+		Oidc: make(map[string]*SocialConfigOidc),
 	}
 }
 
@@ -870,14 +1037,29 @@ func NewRuntimeConfig() *RuntimeConfig {
 
 // MatchConfig is configuration relevant to authoritative realtime multiplayer matches.
 type MatchConfig struct {
-	InputQueueSize        int `yaml:"input_queue_size" json:"input_queue_size" usage:"Size of the authoritative match buffer that stores client messages until they can be processed by the next tick. Default 128."`
-	CallQueueSize         int `yaml:"call_queue_size" json:"call_queue_size" usage:"Size of the authoritative match buffer that sequences calls to match handler callbacks to ensure no overlaps. Default 128."`
-	SignalQueueSize       int `yaml:"signal_queue_size" json:"signal_queue_size" usage:"Size of the authoritative match buffer that sequences signal operations to match handler callbacks to ensure no overlaps. Default 10."`
-	JoinAttemptQueueSize  int `yaml:"join_attempt_queue_size" json:"join_attempt_queue_size" usage:"Size of the authoritative match buffer that limits the number of in-progress join attempts. Default 128."`
-	DeferredQueueSize     int `yaml:"deferred_queue_size" json:"deferred_queue_size" usage:"Size of the authoritative match buffer that holds deferred message broadcasts until the end of each loop execution. Default 128."`
-	JoinMarkerDeadlineMs  int `yaml:"join_marker_deadline_ms" json:"join_marker_deadline_ms" usage:"Deadline in milliseconds that client authoritative match joins will wait for match handlers to acknowledge joins. Default 15000."`
-	MaxEmptySec           int `yaml:"max_empty_sec" json:"max_empty_sec" usage:"Maximum number of consecutive seconds that authoritative matches are allowed to be empty before they are stopped. 0 indicates no maximum. Default 0."`
-	LabelUpdateIntervalMs int `yaml:"label_update_interval_ms" json:"label_update_interval_ms" usage:"Time in milliseconds between match label update batch processes. Default 1000."`
+	InputQueueSize        int                                 `yaml:"input_queue_size" json:"input_queue_size" usage:"Size of the authoritative match buffer that stores client messages until they can be processed by the next tick. Default 128."`
+	CallQueueSize         int                                 `yaml:"call_queue_size" json:"call_queue_size" usage:"Size of the authoritative match buffer that sequences calls to match handler callbacks to ensure no overlaps. Default 128."`
+	SignalQueueSize       int                                 `yaml:"signal_queue_size" json:"signal_queue_size" usage:"Size of the authoritative match buffer that sequences signal operations to match handler callbacks to ensure no overlaps. Default 10."`
+	JoinAttemptQueueSize  int                                 `yaml:"join_attempt_queue_size" json:"join_attempt_queue_size" usage:"Size of the authoritative match buffer that limits the number of in-progress join attempts. Default 128."`
+	DeferredQueueSize     int                                 `yaml:"deferred_queue_size" json:"deferred_queue_size" usage:"Size of the authoritative match buffer that holds deferred message broadcasts until the end of each loop execution. Default 128."`
+	JoinMarkerDeadlineMs  int                                 `yaml:"join_marker_deadline_ms" json:"join_marker_deadline_ms" usage:"Deadline in milliseconds that client authoritative match joins will wait for match handlers to acknowledge joins. Default 15000."`
+	MaxEmptySec           int                                 `yaml:"max_empty_sec" json:"max_empty_sec" usage:"Maximum number of consecutive seconds that authoritative matches are allowed to be empty before they are stopped. 0 indicates no maximum. Default 0."`
+	LabelUpdateIntervalMs int                                 `yaml:"label_update_interval_ms" json:"label_update_interval_ms" usage:"Time in milliseconds between match label update batch processes. Default 1000."`
+	RecordDir             string                              `yaml:"record_dir" json:"record_dir" usage:"Directory to write authoritative match recordings to, one file per match, for offline replay. Only matches created with the 'record' parameter set to true are recorded. Empty disables recording. Default empty."`
+	RecordStateIntervalMs int                                 `yaml:"record_state_interval_ms" json:"record_state_interval_ms" usage:"Time in milliseconds between match state snapshots written to authoritative match recordings for match loop ticks. Other match handler calls are always recorded with a state snapshot. Default 1000."`
+	ReconnectWindowSec    int                                 `yaml:"reconnect_window_sec" json:"reconnect_window_sec" usage:"Number of seconds authoritative match participants who disconnect keep their slot for, and may rejoin it with their reconnect token without a new join attempt. Participants are sent a new reconnect token every half window. 0 disables reconnect reservations. Default 0."`
+	OverrunLogTicks       int                                 `yaml:"overrun_log_ticks" json:"overrun_log_ticks" usage:"Number of consecutive authoritative match ticks that must exceed the tick budget implied by the tick rate before a warning is logged. The warning repeats for every further run of the same length. Default 10."`
+	DataRateLimit         *MatchDataRateLimitConfig           `yaml:"data_rate_limit" json:"data_rate_limit" usage:"Limit on the match data messages each presence may send to an authoritative match, across all op codes. Match handlers may override it at init."`
+	DataOpCodeRateLimits  map[int64]*MatchDataRateLimitConfig `yaml:"data_op_code_rate_limits" json:"data_op_code_rate_limits" usage:"Limits on the match data messages each presence may send to an authoritative match with a given op code, keyed by op code. Applied in addition to the overall limit. Match handlers may override them at init."`
+	DataRateLimitAction   string                              `yaml:"data_rate_limit_action" json:"data_rate_limit_action" usage:"What happens when a presence exceeds a match data rate limit. The message is always dropped, 'notify' also sends the presence an error, and 'kick' removes it from the match. Default 'drop'."`
+	SnapshotDir           string                              `yaml:"snapshot_dir" json:"snapshot_dir" usage:"Directory to save the state of authoritative matches that support snapshots to on shutdown. Snapshots are taken in parallel within the shutdown grace period, and matches that save one stop without match terminate. Matches are restored with the same IDs on the next startup of the same node. Empty disables snapshots. Default empty."`
+	RelayedHostElection   bool                                `yaml:"relayed_host_election" json:"relayed_host_election" usage:"Elect a host presence for each relayed match, announced to participants with a match host notification. Participants may address match data to the host, and the host may hand the role to another participant with the TransferMatchHost API. Default false."`
+}
+
+// MatchDataRateLimitConfig is a token bucket limit on authoritative match data messages.
+type MatchDataRateLimitConfig struct {
+	Rate  float64 `yaml:"rate" json:"rate" usage:"Sustained number of messages per second. 0 disables the limit. Default 0."`
+	Burst int     `yaml:"burst" json:"burst" usage:"Number of messages allowed at once above the sustained rate. Default 20."`
 }
 
 func NewMatchConfig() *MatchConfig {
@@ -890,6 +1072,15 @@ func NewMatchConfig() *MatchConfig {
 		JoinMarkerDeadlineMs:  15000,
 		MaxEmptySec:           0,
 		LabelUpdateIntervalMs: 1000,
+		RecordDir:             "",
+		RecordStateIntervalMs: 1000,
+		ReconnectWindowSec:    0,
+		OverrunLogTicks:       10,
+		DataRateLimit:         &MatchDataRateLimitConfig{Rate: 0, Burst: 20},
+		DataOpCodeRateLimits:  make(map[int64]*MatchDataRateLimitConfig),
+		DataRateLimitAction:   "drop",
+		SnapshotDir:           "",
+		RelayedHostElection:   false,
 	}
 }
 
@@ -948,22 +1139,47 @@ func NewLeaderboardConfig() *LeaderboardConfig {
 }
 
 type MatchmakerConfig struct {
-	MaxTickets    int  `yaml:"max_tickets" json:"max_tickets" usage:"Maximum number of concurrent matchmaking tickets allowed per session or party. Default 3."`
-	IntervalSec   int  `yaml:"interval_sec" json:"interval_sec" usage:"How quickly the matchmaker attempts to form matches, in seconds. Default 15."`
-	MaxIntervals  int  `yaml:"max_intervals" json:"max_intervals" usage:"How many intervals the matchmaker attempts to find matches at the max player count, before allowing min count. Default 2."`
-	BatchPoolSize int  `yaml:"batch_pool_size" json:"batch_pool_size" usage:"Number of concurrent indexing batches that will be allocated."`
-	RevPrecision  bool `yaml:"rev_precision" json:"rev_precision" usage:"Reverse matching precision. Default true."`
-	RevThreshold  int  `yaml:"rev_threshold" json:"rev_threshold" usage:"Reverse matching threshold. Default 1."`
+	MaxTickets       int  `yaml:"max_tickets" json:"max_tickets" usage:"Maximum number of concurrent matchmaking tickets allowed per session or party. Default 3."`
+	IntervalSec      int  `yaml:"interval_sec" json:"interval_sec" usage:"How quickly the matchmaker attempts to form matches, in seconds. Default 15."`
+	MaxIntervals     int  `yaml:"max_intervals" json:"max_intervals" usage:"How many intervals the matchmaker attempts to find matches at the max player count, before allowing min count. Default 2."`
+	BatchPoolSize    int  `yaml:"batch_pool_size" json:"batch_pool_size" usage:"Number of concurrent indexing batches that will be allocated."`
+	RevPrecision     bool `yaml:"rev_precision" json:"rev_precision" usage:"Reverse matching precision. Default true."`
+	RevThreshold     int  `yaml:"rev_threshold" json:"rev_threshold" usage:"Reverse matching threshold. Default 1."`
+	MaxCandidates    int  `yaml:"max_candidates" json:"max_candidates" usage:"Maximum number of candidate groups collected per ticket for the matchmaker score hook to choose from. Default 5."`
+	RttMaxMs         int  `yaml:"rtt_max_ms" json:"rtt_max_ms" usage:"Maximum round trip time in milliseconds a ticket may have to the region chosen for its match, for tickets reporting 'rtt_<region>' numeric properties. Default 100."`
+	RttWidenMs       int  `yaml:"rtt_widen_ms" json:"rtt_widen_ms" usage:"How much the maximum round trip time grows in milliseconds for every second a ticket waits. Default 5."`
+	RttLimitMs       int  `yaml:"rtt_limit_ms" json:"rtt_limit_ms" usage:"Upper bound in milliseconds the maximum round trip time may grow to. Default 300."`
+	BlockAware       bool `yaml:"block_aware" json:"block_aware" usage:"Never match users who have blocked each other, and reject party join requests between them. Default false."`
+	BlockCacheTtlSec int  `yaml:"block_cache_ttl_sec" json:"block_cache_ttl_sec" usage:"How long user block relationships are cached for block-aware matchmaking and parties, in seconds. Default 30."`
+
+	Queues        map[string]*MatchmakerQueueConfig `yaml:"queues" json:"queues" usage:"Named matchmaker queues, each with its own settings, lock and ticket pool. Tickets and backfill requests that do not select a queue use the settings above."`
+	QueueProperty string                            `yaml:"queue_property" json:"queue_property" usage:"Name of the ticket string property clients use to select a named queue when adding matchmaker tickets over a socket. The property is removed from the ticket and is not searchable. Tickets without it use the default queue. Set empty to always use the default queue. Default \"matchmaker_queue\"."`
+}
+
+type MatchmakerQueueConfig struct {
+	MaxTickets   int  `yaml:"max_tickets" json:"max_tickets" usage:"Maximum number of concurrent matchmaking tickets allowed per session or party in this queue."`
+	IntervalSec  int  `yaml:"interval_sec" json:"interval_sec" usage:"How quickly this queue attempts to form matches, in seconds."`
+	MaxIntervals int  `yaml:"max_intervals" json:"max_intervals" usage:"How many intervals this queue attempts to find matches at the max player count, before allowing min count."`
+	RevPrecision bool `yaml:"rev_precision" json:"rev_precision" usage:"Reverse matching precision."`
+	RevThreshold int  `yaml:"rev_threshold" json:"rev_threshold" usage:"Reverse matching threshold."`
 }
 
 func NewMatchmakerConfig() *MatchmakerConfig {
 	return &MatchmakerConfig{
-		MaxTickets:    3,
-		IntervalSec:   15,
-		MaxIntervals:  2,
-		BatchPoolSize: 32,
-		RevPrecision:  false,
-		RevThreshold:  1,
+		MaxTickets:       3,
+		IntervalSec:      15,
+		MaxIntervals:     2,
+		BatchPoolSize:    32,
+		RevPrecision:     false,
+		RevThreshold:     1,
+		MaxCandidates:    5,
+		RttMaxMs:         100,
+		RttWidenMs:       5,
+		RttLimitMs:       300,
+		BlockAware:       false,
+		BlockCacheTtlSec: 30,
+		Queues:           make(map[string]*MatchmakerQueueConfig),
+		QueueProperty:    "matchmaker_queue",
 	}
 }
 
@@ -88,8 +88,11 @@ var restrictedMethods = map[string]console.UserRole{
 	"/nakama.console.Console/DeleteLeaderboardRecord": console.UserRole_USER_ROLE_MAINTAINER,
 
 	// Match
-	"/nakama.console.Console/ListMatches":   console.UserRole_USER_ROLE_READONLY,
-	"/nakama.console.Console/GetMatchState": console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/ListMatches":        console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/GetMatchState":      console.UserRole_USER_ROLE_READONLY,
+	"/nakama.console.Console/KickMatchPresences": console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/SendMatchBroadcast": console.UserRole_USER_ROLE_MAINTAINER,
+	"/nakama.console.Console/TerminateMatch":     console.UserRole_USER_ROLE_MAINTAINER,
 
 	// Channel messages
 	"/nakama.console.Console/ListChannelMessages":   console.UserRole_USER_ROLE_READONLY,
@@ -102,6 +105,12 @@ var restrictedMethods = map[string]console.UserRole{
 	"/nakama.console.Console/HotfixModule": console.UserRole_USER_ROLE_MAINTAINER,
 	"/nakama.console.Console/GetRuntime":   console.UserRole_USER_ROLE_DEVELOPER,
 
+	// Server keys
+	"/nakama.console.Console/ListServerKeys":  console.UserRole_USER_ROLE_DEVELOPER,
+	"/nakama.console.Console/WriteServerKey":  console.UserRole_USER_ROLE_DEVELOPER,
+	"/nakama.console.Console/RotateServerKey": console.UserRole_USER_ROLE_DEVELOPER,
+	"/nakama.console.Console/DeleteServerKey": console.UserRole_USER_ROLE_DEVELOPER,
+
 	// Status
 	"/nakama.console.Console/GetStatus": console.UserRole_USER_ROLE_READONLY,
 
@@ -18,12 +18,15 @@ import (
 	"context"
 	"github.com/heroiclabs/nakama-common/runtime"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama/v3/console"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (s *ConsoleServer) ListMatches(ctx context.Context, in *console.ListMatchesRequest) (*console.MatchList, error) {
@@ -74,9 +77,15 @@ func (s *ConsoleServer) ListMatches(ctx context.Context, in *console.ListMatches
 	if in.MinSize != nil && in.MaxSize != nil && in.MinSize.Value > in.MaxSize.Value {
 		return nil, status.Error(codes.InvalidArgument, "Maximum size must be greater than or equal to minimum size when both are specified.")
 	}
+	if (len(in.Sort) != 0 || in.Cursor != "") && (in.Authoritative != nil && !in.Authoritative.Value) {
+		return nil, status.Error(codes.InvalidArgument, "Sorting and cursors are not supported for non-authoritative matches.")
+	}
 
-	matches, nodes, err := s.matchRegistry.ListMatches(ctx, limit, in.Authoritative, in.Label, in.MinSize, in.MaxSize, in.Query, in.Node)
+	matches, nodes, nextCursor, err := s.matchRegistry.ListMatches(ctx, limit, in.Authoritative, in.Label, in.MinSize, in.MaxSize, in.Query, in.Node, in.Sort, in.Cursor)
 	if err != nil {
+		if err == ErrMatchListSortInvalid || err == ErrMatchListCursorInvalid {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("Error listing matches", zap.Error(err))
 		return nil, status.Error(codes.Internal, "Error listing matches.")
 	}
@@ -90,7 +99,7 @@ func (s *ConsoleServer) ListMatches(ctx context.Context, in *console.ListMatches
 		results = append(results, &console.MatchList_Match{ApiMatch: match, Node: node})
 	}
 
-	return &console.MatchList{Matches: results}, nil
+	return &console.MatchList{Matches: results, NextCursor: nextCursor}, nil
 }
 
 func (s *ConsoleServer) GetMatchState(ctx context.Context, in *console.MatchStateRequest) (*console.MatchState, error) {
@@ -120,7 +129,25 @@ func (s *ConsoleServer) GetMatchState(ctx context.Context, in *console.MatchStat
 		return nil, status.Error(codes.Internal, "Error listing matches.")
 	}
 
-	return &console.MatchState{Presences: presences, Tick: tick, State: state}, nil
+	matchState := &console.MatchState{Presences: presences, Tick: tick, State: state}
+
+	// The match may have stopped since its state was retrieved, in which case the profile is left out.
+	if profile, _ := s.matchRegistry.GetProfile(matchID, node); profile != nil {
+		matchState.TickBudgetMs = durationMs(profile.TickBudget)
+		matchState.LastTickMs = durationMs(profile.LastTick)
+		matchState.AvgTickMs = durationMs(profile.AvgTick)
+		matchState.MaxTickMs = durationMs(profile.MaxTick)
+		matchState.OverrunCount = profile.OverrunCount
+		matchState.ConsecutiveOverruns = profile.ConsecutiveOverruns
+		matchState.InputQueueDepth = int32(profile.InputQueueDepth)
+		matchState.DeferredQueueDepth = int32(profile.DeferredQueueDepth)
+	}
+
+	return matchState, nil
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
 }
 
 func (s *ConsoleServer) SendMatchSignal(ctx context.Context, in *console.MatchSignalRequest) (*console.MatchSignalResponse, error) {
@@ -130,3 +157,111 @@ func (s *ConsoleServer) SendMatchSignal(ctx context.Context, in *console.MatchSi
 	}
 	return &console.MatchSignalResponse{Result: result}, nil
 }
+
+func (s *ConsoleServer) KickMatchPresences(ctx context.Context, in *console.MatchKickRequest) (*emptypb.Empty, error) {
+	matchID, node, err := parseConsoleAuthoritativeMatchID(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.SessionIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least one session ID is required.")
+	}
+
+	presences, _, _, err := s.matchRegistry.GetState(ctx, matchID, node)
+	if err != nil {
+		if err == runtime.ErrMatchNotFound {
+			return nil, status.Error(codes.InvalidArgument, "Match not found, or match handler already stopped.")
+		}
+		if err != context.Canceled {
+			s.logger.Error("Error getting match state.", zap.Any("in", in), zap.Error(err))
+		}
+		return nil, status.Error(codes.Internal, "Error kicking match presences.")
+	}
+
+	presencesBySession := make(map[string]*rtapi.UserPresence, len(presences))
+	for _, presence := range presences {
+		presencesBySession[presence.SessionId] = presence
+	}
+	kicks := make([]*MatchPresence, 0, len(in.SessionIds))
+	for _, sessionID := range in.SessionIds {
+		presence, found := presencesBySession[sessionID]
+		if !found {
+			return nil, status.Error(codes.InvalidArgument, "Session ID is not a presence in the match.")
+		}
+		kicks = append(kicks, &MatchPresence{
+			Node:      node,
+			UserID:    uuid.FromStringOrNil(presence.UserId),
+			SessionID: uuid.FromStringOrNil(presence.SessionId),
+			Username:  presence.Username,
+		})
+	}
+
+	s.matchRegistry.Kick(PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: matchID, Label: node}, kicks)
+	s.logger.Info("Console user kicked match presences.", zap.String("username", consoleUsername(ctx)), zap.String("match_id", in.Id), zap.Strings("session_ids", in.SessionIds))
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleServer) SendMatchBroadcast(ctx context.Context, in *console.MatchBroadcastRequest) (*emptypb.Empty, error) {
+	matchID, node, err := parseConsoleAuthoritativeMatchID(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if in.OpCode < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Op code must be >= 0.")
+	}
+	if match, _, err := s.matchRegistry.GetMatch(ctx, in.Id); err != nil || match == nil {
+		return nil, status.Error(codes.InvalidArgument, "Match not found, or match handler already stopped.")
+	}
+
+	// Sent without a sender presence, like messages broadcast by the match handler itself.
+	envelope := &rtapi.Envelope{Message: &rtapi.Envelope_MatchData{MatchData: &rtapi.MatchData{
+		MatchId:  in.Id,
+		OpCode:   in.OpCode,
+		Data:     []byte(in.Data),
+		Reliable: true,
+	}}}
+	s.router.SendToStream(s.logger, PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: matchID, Label: node}, envelope, true)
+	s.logger.Info("Console user broadcast to match.", zap.String("username", consoleUsername(ctx)), zap.String("match_id", in.Id), zap.Int64("op_code", in.OpCode))
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConsoleServer) TerminateMatch(ctx context.Context, in *console.MatchTerminateRequest) (*emptypb.Empty, error) {
+	matchID, node, err := parseConsoleAuthoritativeMatchID(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if in.GraceSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Grace seconds must be >= 0.")
+	}
+
+	if err := s.matchRegistry.Terminate(matchID, node, int(in.GraceSeconds)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Match not found, or match handler already stopped.")
+	}
+	s.logger.Info("Console user terminated match.", zap.String("username", consoleUsername(ctx)), zap.String("match_id", in.Id), zap.Int32("grace_seconds", in.GraceSeconds))
+
+	return &emptypb.Empty{}, nil
+}
+
+// Split an authoritative match ID into its components, relayed matches have no match handler to act on.
+func parseConsoleAuthoritativeMatchID(id string) (uuid.UUID, string, error) {
+	matchIDComponents := strings.SplitN(id, ".", 2)
+	if len(matchIDComponents) != 2 {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, "Invalid match ID.")
+	}
+	matchID, err := uuid.FromString(matchIDComponents[0])
+	if err != nil {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, "Invalid match ID.")
+	}
+	if matchIDComponents[1] == "" {
+		return uuid.Nil, "", status.Error(codes.InvalidArgument, "Match is not authoritative.")
+	}
+	return matchID, matchIDComponents[1], nil
+}
+
+// The console user performing an action, for the server log.
+func consoleUsername(ctx context.Context) string {
+	username, _ := ctx.Value(ctxConsoleUsernameKey{}).(string)
+	return username
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/heroiclabs/nakama/v3/console"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ConsoleServer) ListServerKeys(ctx context.Context, in *emptypb.Empty) (*console.ServerKeyList, error) {
+	keys := s.api.serverKeys.List()
+	list := &console.ServerKeyList{Keys: make([]*console.ServerKey, 0, len(keys))}
+	for _, key := range keys {
+		list.Keys = append(list.Keys, consoleServerKey(key))
+	}
+	return list, nil
+}
+
+func (s *ConsoleServer) WriteServerKey(ctx context.Context, in *console.ServerKey) (*console.ServerKey, error) {
+	key := &ServerKey{
+		Name:                in.Name,
+		Key:                 in.Key,
+		Enabled:             in.Enabled,
+		AuthenticateMethods: in.AuthenticateMethods,
+		RpcIDs:              in.RpcIds,
+	}
+	if in.ExpireTime != nil {
+		key.ExpireTime = in.ExpireTime.AsTime()
+	}
+
+	key, err := s.api.serverKeys.Write(ctx, key)
+	if err != nil {
+		return nil, consoleServerKeyError(err)
+	}
+	s.logger.Info("Console user wrote server key.", zap.String("username", consoleUsername(ctx)), zap.String("name", key.Name), zap.Bool("enabled", key.Enabled))
+
+	return consoleServerKey(key), nil
+}
+
+func (s *ConsoleServer) RotateServerKey(ctx context.Context, in *console.ServerKeyRequest) (*console.ServerKey, error) {
+	key, err := s.api.serverKeys.Rotate(ctx, in.Name)
+	if err != nil {
+		return nil, consoleServerKeyError(err)
+	}
+	s.logger.Info("Console user rotated server key.", zap.String("username", consoleUsername(ctx)), zap.String("name", key.Name))
+
+	return consoleServerKey(key), nil
+}
+
+func (s *ConsoleServer) DeleteServerKey(ctx context.Context, in *console.ServerKeyRequest) (*emptypb.Empty, error) {
+	if err := s.api.serverKeys.Delete(ctx, in.Name); err != nil {
+		return nil, consoleServerKeyError(err)
+	}
+	s.logger.Info("Console user deleted server key.", zap.String("username", consoleUsername(ctx)), zap.String("name", in.Name))
+
+	return &emptypb.Empty{}, nil
+}
+
+func consoleServerKey(key *ServerKey) *console.ServerKey {
+	k := &console.ServerKey{
+		Name:                key.Name,
+		Key:                 key.Key,
+		Enabled:             key.Enabled,
+		AuthenticateMethods: key.AuthenticateMethods,
+		RpcIds:              key.RpcIDs,
+		CreateTime:          timestamppb.New(key.CreateTime),
+		UpdateTime:          timestamppb.New(key.UpdateTime),
+	}
+	if !key.ExpireTime.IsZero() {
+		k.ExpireTime = timestamppb.New(key.ExpireTime)
+	}
+	return k
+}
+
+func consoleServerKeyError(err error) error {
+	switch err {
+	case ErrServerKeyName:
+		return status.Error(codes.InvalidArgument, "Name must be 1-128 characters long.")
+	case ErrServerKeyReserved:
+		return status.Error(codes.InvalidArgument, "Name is reserved for the keys set in the server configuration.")
+	case ErrServerKeyInvalid:
+		return status.Error(codes.InvalidArgument, "Key must be at most 128 characters long.")
+	case ErrServerKeyMethod:
+		return status.Error(codes.InvalidArgument, "Authenticate methods must be authenticate or session refresh API methods, or \"*\".")
+	case ErrServerKeyInUse:
+		return status.Error(codes.FailedPrecondition, "Key is already in use.")
+	case ErrServerKeyNotFound:
+		return status.Error(codes.NotFound, "Server key not found.")
+	default:
+		return status.Error(codes.Internal, "Error writing server key.")
+	}
+}
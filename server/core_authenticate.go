@@ -746,6 +746,142 @@ func AuthenticateGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, cli
 	return userID, username, true, nil
 }
 
+func AuthenticateOidc(ctx context.Context, logger *zap.Logger, db *sql.DB, client *social.Client, provider, idToken, username string, create bool) (string, string, bool, error) {
+	oidcProfile, err := client.CheckOidcToken(ctx, provider, idToken)
+	if err != nil {
+		if err == social.ErrOidcProviderNotFound {
+			return "", "", false, status.Error(codes.InvalidArgument, "OIDC provider not found.")
+		}
+		logger.Info("Could not authenticate OIDC profile.", zap.Error(err), zap.String("provider", provider))
+		return "", "", false, status.Error(codes.Unauthenticated, "Could not authenticate OIDC profile.")
+	}
+	found := true
+
+	// Look for an existing account.
+	query := "SELECT u.id, u.username, u.disable_time FROM users u JOIN user_identity ui ON ui.user_id = u.id WHERE ui.provider = $1 AND ui.subject = $2"
+	var dbUserID string
+	var dbUsername string
+	var dbDisableTime pgtype.Timestamptz
+	err = db.QueryRowContext(ctx, query, provider, oidcProfile.Subject).Scan(&dbUserID, &dbUsername, &dbDisableTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			found = false
+		} else {
+			logger.Error("Error looking up user by OIDC identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", oidcProfile.Subject), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.Internal, "Error finding user account.")
+		}
+	}
+
+	// Existing account found.
+	if found {
+		// Check if it's disabled.
+		if dbDisableTime.Status == pgtype.Present && dbDisableTime.Time.Unix() != 0 {
+			logger.Info("User account is disabled.", zap.String("provider", provider), zap.String("subject", oidcProfile.Subject), zap.String("username", username), zap.Bool("create", create))
+			return "", "", false, status.Error(codes.PermissionDenied, "User account banned.")
+		}
+
+		return dbUserID, dbUsername, false, nil
+	}
+
+	if !create {
+		// No user account found, and creation is not allowed.
+		return "", "", false, status.Error(codes.NotFound, "User account not found.")
+	}
+
+	// Without a requested username use the one mapped from the provider's claims, if it's usable.
+	var mappedUsername bool
+	if username == "" {
+		if oidcProfile.Username != "" && len(oidcProfile.Username) <= 128 && !invalidUsernameRegex.MatchString(oidcProfile.Username) {
+			username = oidcProfile.Username
+			mappedUsername = true
+		} else {
+			username = generateUsername()
+		}
+	}
+
+	var displayName string
+	if len(oidcProfile.DisplayName) <= 255 {
+		displayName = oidcProfile.DisplayName
+	} else {
+		logger.Warn("Skipping updating display_name: value received from OIDC provider longer than max length of 255 chars.", zap.String("display_name", oidcProfile.DisplayName))
+	}
+
+	// Create a new account.
+	userID := uuid.Must(uuid.NewV4()).String()
+	err = authenticateOidcCreate(ctx, logger, db, provider, oidcProfile.Subject, userID, username, displayName)
+	if mappedUsername && status.Code(err) == codes.AlreadyExists {
+		// The mapped username belongs to another account, fall back to a generated one.
+		username = generateUsername()
+		err = authenticateOidcCreate(ctx, logger, db, provider, oidcProfile.Subject, userID, username, displayName)
+	}
+	if err != nil {
+		return "", "", false, err
+	}
+
+	return userID, username, true, nil
+}
+
+func authenticateOidcCreate(ctx context.Context, logger *zap.Logger, db *sql.DB, provider, subject, userID, username, displayName string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	err = ExecuteInTx(ctx, tx, func() error {
+		query := `
+INSERT INTO users (id, username, display_name, create_time, update_time)
+SELECT $1 AS id,
+		 $2 AS username,
+		 $3 AS display_name,
+		 now(),
+		 now()
+WHERE NOT EXISTS
+  (SELECT user_id
+   FROM user_identity
+   WHERE provider = $4::VARCHAR AND subject = $5::VARCHAR)`
+
+		result, err := tx.ExecContext(ctx, query, userID, username, displayName, provider, subject)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "users_username_key") {
+				return StatusError(codes.AlreadyExists, "Username is already in use.", err)
+			}
+			logger.Debug("Cannot find or create user with OIDC identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username))
+			return err
+		}
+
+		if rowsAffectedCount, _ := result.RowsAffected(); rowsAffectedCount != 1 {
+			// A concurrent write has inserted this identity.
+			logger.Info("Did not insert new user as OIDC identity already exists.", zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username))
+			return StatusError(codes.Internal, "Error finding or creating user account.", ErrRowsAffectedCount)
+		}
+
+		query = "INSERT INTO user_identity (provider, subject, user_id) VALUES ($1, $2, $3)"
+		if _, err = tx.ExecContext(ctx, query, provider, subject, userID); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation && strings.Contains(pgErr.Message, "user_identity_pkey") {
+				// A concurrent write has inserted this identity.
+				logger.Info("Did not insert new user as OIDC identity already exists.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username))
+				return StatusError(codes.Internal, "Error finding or creating user account.", err)
+			}
+			logger.Debug("Cannot add OIDC identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", subject), zap.String("username", username))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		if e, ok := err.(*statusError); ok {
+			return e.Status()
+		}
+		logger.Error("Error in database transaction.", zap.Error(err))
+		return status.Error(codes.Internal, "Error finding or creating user account.")
+	}
+
+	return nil
+}
+
 func AuthenticateSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, client *social.Client, appID int, publisherKey, token, username string, create bool) (string, string, string, bool, error) {
 	steamProfile, err := client.GetSteamProfile(ctx, publisherKey, appID, token)
 	if err != nil {
@@ -0,0 +1,139 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/heroiclabs/nakama/v3/social"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Social client trusting a local OIDC provider named "sso", and a function signing ID tokens from it.
+func newOidcClientTest(t *testing.T) (*social.Client, func(sub, preferredUsername string) string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA", "kid": "key", "use": "sig", "alg": "RS256",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	t.Cleanup(server.Close)
+
+	client := social.NewClient(zap.NewNop(), 5*time.Second)
+	client.SetOidcProvider("sso", &social.OidcProviderConfig{Issuer: server.URL, Audiences: []string{"game"}, JwksUrl: server.URL})
+
+	sign := func(sub, preferredUsername string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":                server.URL,
+			"aud":                "game",
+			"sub":                sub,
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"preferred_username": preferredUsername,
+		})
+		token.Header["kid"] = "key"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("error signing token: %v", err)
+		}
+		return signed
+	}
+	return client, sign
+}
+
+// should create accounts only when allowed, with the username mapped from the provider's claims if it's free
+func TestAuthenticateOidcCreate(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	client, sign := newOidcClientTest(t)
+	ctx := context.Background()
+	sub := GenerateString()
+	preferredUsername := GenerateString()
+
+	if _, _, _, err := AuthenticateOidc(ctx, logger, db, client, "sso", sign(sub, preferredUsername), "", false); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected missing account not to be created, got %v", err)
+	}
+
+	userID, username, created, err := AuthenticateOidc(ctx, logger, db, client, "sso", sign(sub, preferredUsername), "", true)
+	if err != nil || !created {
+		t.Fatalf("expected account to be created, got %v %v", created, err)
+	}
+	if username != preferredUsername {
+		t.Fatalf("expected username mapped from claims, got %v", username)
+	}
+
+	// A mapped username that is already taken falls back to a generated one.
+	_, otherUsername, created, err := AuthenticateOidc(ctx, logger, db, client, "sso", sign(GenerateString(), preferredUsername), "", true)
+	if err != nil || !created {
+		t.Fatalf("expected second account to be created, got %v %v", created, err)
+	}
+	if otherUsername == preferredUsername {
+		t.Fatal("expected taken username not to be reused")
+	}
+
+	// A requested username takes precedence over the claims.
+	requestedUsername := GenerateString()
+	_, otherUsername, _, err = AuthenticateOidc(ctx, logger, db, client, "sso", sign(GenerateString(), preferredUsername), requestedUsername, true)
+	if err != nil || otherUsername != requestedUsername {
+		t.Fatalf("expected requested username, got %v %v", otherUsername, err)
+	}
+
+	if _, _, _, err := AuthenticateOidc(ctx, logger, db, client, "unknown", sign(sub, preferredUsername), "", true); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected unknown provider to be refused, got %v", err)
+	}
+
+	// The same subject finds the same account, whatever its other claims now say.
+	existingUserID, _, created, err := AuthenticateOidc(ctx, logger, db, client, "sso", sign(sub, GenerateString()), "", false)
+	if err != nil || created || existingUserID != userID {
+		t.Fatalf("expected existing account, got %v %v %v", existingUserID, created, err)
+	}
+}
+
+// should find accounts linked to an identity by its subject
+func TestAuthenticateOidcLinked(t *testing.T) {
+	db := NewDB(t)
+	defer db.Close()
+	client, sign := newOidcClientTest(t)
+	ctx := context.Background()
+	sub := GenerateString()
+
+	userID := uuid.Must(uuid.NewV4())
+	InsertUser(t, db, userID)
+	if err := LinkOidc(ctx, logger, db, client, userID, "sso", sign(sub, "")); err != nil {
+		t.Fatalf("error linking identity: %v", err)
+	}
+
+	dbUserID, _, created, err := AuthenticateOidc(ctx, logger, db, client, "sso", sign(sub, ""), "", false)
+	if err != nil || created || dbUserID != userID.String() {
+		t.Fatalf("expected linked account, got %v %v %v", dbUserID, created, err)
+	}
+}
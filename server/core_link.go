@@ -390,6 +390,76 @@ AND (NOT EXISTS
 	return nil
 }
 
+func LinkOidc(ctx context.Context, logger *zap.Logger, db *sql.DB, socialClient *social.Client, userID uuid.UUID, provider, token string) error {
+	if provider == "" {
+		return status.Error(codes.InvalidArgument, "OIDC provider is required.")
+	} else if token == "" {
+		return status.Error(codes.InvalidArgument, "OIDC ID token is required.")
+	}
+
+	oidcProfile, err := socialClient.CheckOidcToken(ctx, provider, token)
+	if err != nil {
+		if err == social.ErrOidcProviderNotFound {
+			return status.Error(codes.InvalidArgument, "OIDC provider not found.")
+		}
+		logger.Info("Could not authenticate OIDC profile.", zap.Error(err), zap.String("provider", provider))
+		return status.Error(codes.Unauthenticated, "Could not authenticate OIDC profile.")
+	}
+
+	displayName := oidcProfile.DisplayName
+	if len(displayName) > 255 {
+		// Ignore the name in case it is longer than db can store
+		logger.Warn("Skipping updating display_name: value received from OIDC provider longer than max length of 255 chars.", zap.String("display_name", displayName))
+		displayName = ""
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("Could not begin database transaction.", zap.Error(err))
+		return status.Error(codes.Internal, "Error linking OIDC identity.")
+	}
+
+	err = ExecuteInTx(ctx, tx, func() error {
+		var dbIdentityLinkedUser int64
+		err := tx.QueryRowContext(ctx, "SELECT COUNT(user_id) FROM user_identity WHERE provider = $1 AND subject = $2 AND user_id = $3 LIMIT 1", provider, oidcProfile.Subject, userID).Scan(&dbIdentityLinkedUser)
+		if err != nil {
+			logger.Debug("Cannot link OIDC identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", oidcProfile.Subject))
+			return err
+		}
+
+		if dbIdentityLinkedUser == 0 {
+			_, err = tx.ExecContext(ctx, "INSERT INTO user_identity (provider, subject, user_id) VALUES ($1, $2, $3)", provider, oidcProfile.Subject, userID)
+			if err != nil {
+				var pgErr *pgconn.PgError
+				if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation {
+					if strings.Contains(pgErr.Message, "user_identity_pkey") {
+						return StatusError(codes.AlreadyExists, "OIDC identity already in use.", err)
+					}
+					return StatusError(codes.AlreadyExists, "User is already linked to an OIDC identity from this provider.", err)
+				}
+				logger.Debug("Cannot link OIDC identity.", zap.Error(err), zap.String("provider", provider), zap.String("subject", oidcProfile.Subject))
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE users SET display_name = COALESCE(NULLIF(display_name, ''), $2), update_time = now() WHERE id = $1", userID, displayName)
+		if err != nil {
+			logger.Debug("Cannot update users table while linking.", zap.Error(err), zap.String("provider", provider), zap.String("subject", oidcProfile.Subject))
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		if e, ok := err.(*statusError); ok {
+			return e.Status()
+		}
+		logger.Error("Error in database transaction.", zap.Error(err))
+		return status.Error(codes.Internal, "Error linking OIDC identity.")
+	}
+	return nil
+}
+
 func LinkSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, router MessageRouter, userID uuid.UUID, username, token string, sync bool) error {
 	if config.GetSocial().Steam.PublisherKey == "" || config.GetSocial().Steam.AppID == 0 {
 		return status.Error(codes.FailedPrecondition, "Steam authentication is not configured.")
@@ -41,6 +41,10 @@ const (
 	NotificationCodeGroupJoinRequest int32 = -5
 	NotificationCodeFriendJoinGame   int32 = -6
 	NotificationCodeSingleSocket     int32 = -7
+	NotificationCodeMatchRestored    int32 = -8
+	NotificationCodeSessionResume    int32 = -9
+	NotificationCodeMatchReconnect   int32 = -10
+	NotificationCodeMatchHost        int32 = -11
 	NotificationCodeWalletTransfer   int32 = -1000
 )
 
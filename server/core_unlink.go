@@ -50,7 +50,9 @@ func UnlinkApple(ctx context.Context, logger *zap.Logger, db *sql.DB, config Con
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -81,7 +83,9 @@ func UnlinkCustom(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.U
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -116,7 +120,8 @@ AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
      OR steam_id IS NOT NULL
      OR email IS NOT NULL
      OR custom_id IS NOT NULL))
-   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1))`, id, deviceID)
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 AND id <> $2 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`, id, deviceID)
 		if err != nil {
 			logger.Debug("Could not unlink device ID.", zap.Error(err), zap.Any("input", deviceID))
 			return err
@@ -166,7 +171,9 @@ func UnlinkEmail(ctx context.Context, logger *zap.Logger, db *sql.DB, id uuid.UU
       OR steam_id IS NOT NULL
       OR custom_id IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -205,7 +212,9 @@ func UnlinkFacebook(ctx context.Context, logger *zap.Logger, db *sql.DB, socialC
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -241,7 +250,9 @@ func UnlinkFacebookInstantGame(ctx context.Context, logger *zap.Logger, db *sql.
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -277,7 +288,9 @@ func UnlinkGameCenter(ctx context.Context, logger *zap.Logger, db *sql.DB, socia
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -313,7 +326,9 @@ func UnlinkGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, socialCli
       OR steam_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -326,6 +341,56 @@ func UnlinkGoogle(ctx context.Context, logger *zap.Logger, db *sql.DB, socialCli
 	return nil
 }
 
+func UnlinkOidc(ctx context.Context, logger *zap.Logger, db *sql.DB, socialClient *social.Client, id uuid.UUID, provider, token string) error {
+	if provider == "" {
+		return status.Error(codes.InvalidArgument, "OIDC provider is required.")
+	}
+
+	params := []any{id, provider}
+	query := `DELETE FROM user_identity WHERE user_id = $1 AND provider = $2`
+
+	if token != "" {
+		oidcProfile, err := socialClient.CheckOidcToken(ctx, provider, token)
+		if err != nil {
+			if err == social.ErrOidcProviderNotFound {
+				return status.Error(codes.InvalidArgument, "OIDC provider not found.")
+			}
+			logger.Info("Could not authenticate OIDC profile.", zap.Error(err), zap.String("provider", provider))
+			return status.Error(codes.Unauthenticated, "Could not authenticate OIDC profile.")
+		}
+		params = append(params, oidcProfile.Subject)
+		query = query + ` AND subject = $3`
+	}
+
+	query = query + `
+AND (EXISTS (SELECT id FROM users WHERE id = $1 AND
+    (apple_id IS NOT NULL
+     OR facebook_id IS NOT NULL
+     OR facebook_instant_game_id IS NOT NULL
+     OR google_id IS NOT NULL
+     OR gamecenter_id IS NOT NULL
+     OR steam_id IS NOT NULL
+     OR email IS NOT NULL
+     OR custom_id IS NOT NULL))
+   OR EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+   OR EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 AND provider <> $2 LIMIT 1))`
+
+	res, err := db.ExecContext(ctx, query, params...)
+
+	if err != nil {
+		logger.Error("Could not unlink OIDC identity.", zap.Error(err), zap.String("provider", provider), zap.Any("input", token))
+		return status.Error(codes.Internal, "Error while trying to unlink OIDC identity.")
+	} else if count, _ := res.RowsAffected(); count == 0 {
+		return status.Error(codes.PermissionDenied, "Cannot unlink last account identifier. Check profile exists and is not last link.")
+	}
+
+	if _, err = db.ExecContext(ctx, "UPDATE users SET update_time = now() WHERE id = $1", id); err != nil {
+		// Failure to update does not interrupt the execution. Just log the error and continue.
+		logger.Error("Could not update users table while unlinking.", zap.Error(err), zap.String("provider", provider))
+	}
+	return nil
+}
+
 func UnlinkSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, config Config, socialClient *social.Client, id uuid.UUID, token string) error {
 	params := []any{id}
 	query := `UPDATE users SET steam_id = NULL, update_time = now() WHERE id = $1`
@@ -349,7 +414,9 @@ func UnlinkSteam(ctx context.Context, logger *zap.Logger, db *sql.DB, config Con
       OR google_id IS NOT NULL
       OR email IS NOT NULL)
      OR
-     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1))`
+     EXISTS (SELECT id FROM user_device WHERE user_id = $1 LIMIT 1)
+     OR
+     EXISTS (SELECT user_id FROM user_identity WHERE user_id = $1 LIMIT 1))`
 
 	res, err := db.ExecContext(ctx, query, params...)
 
@@ -30,8 +30,9 @@ import (
 )
 
 type blugeMatch struct {
-	ID     string
-	Fields map[string]interface{}
+	ID        string
+	Fields    map[string]interface{}
+	SortValue [][]byte
 }
 
 type BlugeResult struct {
@@ -65,6 +66,10 @@ func IterateBlugeMatches(dmi search.DocumentMatchIterator, loadFields map[string
 		if err != nil {
 			return nil, fmt.Errorf("error visiting stored field: %v", err.Error())
 		}
+		bm.SortValue = make([][]byte, len(dm.SortValue))
+		for i, value := range dm.SortValue {
+			bm.SortValue[i] = append([]byte(nil), value...)
+		}
 		rv.Hits = append(rv.Hits, &bm)
 		dm, err = dmi.Next()
 	}
@@ -53,18 +53,18 @@ func createTestMatchRegistry(t fatalable, logger *zap.Logger) (*LocalMatchRegist
 	cfg.GetMatch().LabelUpdateIntervalMs = int(time.Hour / time.Millisecond)
 	messageRouter := &testMessageRouter{}
 	matchRegistry := NewLocalMatchRegistry(logger, logger, cfg, &testSessionRegistry{}, &testTracker{},
-		messageRouter, &testMetrics{}, "node")
+		messageRouter, nil, &testMetrics{}, "node")
 	mp := NewMatchProvider()
 
 	mp.RegisterCreateFn("go",
 		func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool,
-			name string) (RuntimeMatchCore, error) {
+			router MessageRouter, name string) (RuntimeMatchCore, error) {
 			match, err := newTestMatch(context.Background(), NewRuntimeGoLogger(logger), nil, nil)
 			if err != nil {
 				return nil, err
 			}
 
-			rmc, err := NewRuntimeGoMatchCore(logger, "module", matchRegistry, messageRouter, id, "node", "",
+			rmc, err := NewRuntimeGoMatchCore(logger, "module", matchRegistry, router, id, "node", "",
 				stopped, nil, map[string]string{}, nil, match)
 			if err != nil {
 				return nil, err
@@ -168,21 +168,27 @@ func (s *testMetrics) Api(name string, elapsed time.Duration, recvBytes, sentByt
 }
 func (s *testMetrics) ApiRpc(id string, elapsed time.Duration, recvBytes, sentBytes int64, isErr bool) {
 }
-func (s *testMetrics) ApiBefore(name string, elapsed time.Duration, isErr bool)             {}
-func (s *testMetrics) ApiAfter(name string, elapsed time.Duration, isErr bool)              {}
-func (s *testMetrics) Message(recvBytes int64, isErr bool)                                  {}
-func (s *testMetrics) MessageBytesSent(sentBytes int64)                                     {}
-func (s *testMetrics) GaugeRuntimes(value float64)                                          {}
-func (s *testMetrics) GaugeLuaRuntimes(value float64)                                       {}
-func (s *testMetrics) GaugeJsRuntimes(value float64)                                        {}
-func (s *testMetrics) GaugeAuthoritativeMatches(value float64)                              {}
-func (s *testMetrics) CountDroppedEvents(delta int64)                                       {}
-func (s *testMetrics) CountWebsocketOpened(delta int64)                                     {}
-func (s *testMetrics) CountWebsocketClosed(delta int64)                                     {}
-func (s *testMetrics) GaugeSessions(value float64)                                          {}
-func (s *testMetrics) GaugePresences(value float64)                                         {}
-func (s *testMetrics) GaugeOnlineStatus(userID uuid.UUID, online bool)                      {}
-func (s *testMetrics) Matchmaker(tickets, activeTickets float64, processTime time.Duration) {}
+func (s *testMetrics) ApiBefore(name string, elapsed time.Duration, isErr bool) {}
+func (s *testMetrics) ApiAfter(name string, elapsed time.Duration, isErr bool)  {}
+func (s *testMetrics) ApiRateLimited(name, reason string)                       {}
+func (s *testMetrics) Message(recvBytes int64, isErr bool)                      {}
+func (s *testMetrics) MessageBytesSent(sentBytes, compressedBytes int64)        {}
+func (s *testMetrics) MessageRateLimited(messageName, action string)            {}
+func (s *testMetrics) GaugeRuntimes(value float64)                              {}
+func (s *testMetrics) GaugeLuaRuntimes(value float64)                           {}
+func (s *testMetrics) GaugeJsRuntimes(value float64)                            {}
+func (s *testMetrics) GaugeAuthoritativeMatches(value float64)                  {}
+func (s *testMetrics) CountDroppedEvents(delta int64)                           {}
+func (s *testMetrics) CountWebsocketOpened(delta int64)                         {}
+func (s *testMetrics) CountWebsocketClosed(delta int64)                         {}
+func (s *testMetrics) GaugeSessions(value float64)                              {}
+func (s *testMetrics) GaugePresences(value float64)                             {}
+func (s *testMetrics) GaugeOnlineStatus(userID uuid.UUID, online bool)          {}
+func (s *testMetrics) Matchmaker(queue string, tickets, activeTickets float64, processTime time.Duration) {
+}
+func (s *testMetrics) MatchTick(handlerName string, elapsed time.Duration, inputQueueDepth, deferredQueueDepth int, overrun bool) {
+}
+func (s *testMetrics) MatchDataRateLimited(handlerName, action string)                      {}
 func (s *testMetrics) PresenceEvent(dequeueElapsed, processElapsed time.Duration)           {}
 func (s *testMetrics) StorageWriteRejectCount(tags map[string]string, delta int64)          {}
 func (s *testMetrics) CustomCounter(name string, tags map[string]string, delta int64)       {}
@@ -207,11 +213,13 @@ func (s *testMessageRouter) SendDeferred(*zap.Logger, []*DeferredMessage)
 // testTracker implements the Tracker interface and does nothing
 type testTracker struct{}
 
-func (s *testTracker) SetMatchJoinListener(func(id uuid.UUID, joins []*MatchPresence))   {}
-func (s *testTracker) SetMatchLeaveListener(func(id uuid.UUID, leaves []*MatchPresence)) {}
-func (s *testTracker) SetPartyJoinListener(func(id uuid.UUID, joins []*Presence))        {}
-func (s *testTracker) SetPartyLeaveListener(func(id uuid.UUID, leaves []*Presence))      {}
-func (s *testTracker) Stop()                                                             {}
+func (s *testTracker) SetMatchJoinListener(func(id uuid.UUID, joins []*MatchPresence))     {}
+func (s *testTracker) SetMatchLeaveListener(func(id uuid.UUID, leaves []*MatchPresence))   {}
+func (s *testTracker) SetPartyJoinListener(func(id uuid.UUID, joins []*Presence))          {}
+func (s *testTracker) SetPartyLeaveListener(func(id uuid.UUID, leaves []*Presence))        {}
+func (s *testTracker) SetMatchRelayedJoinListener(func(id uuid.UUID, joins []*Presence))   {}
+func (s *testTracker) SetMatchRelayedLeaveListener(func(id uuid.UUID, leaves []*Presence)) {}
+func (s *testTracker) Stop()                                                               {}
 
 // Track returns success true/false, and new presence true/false.
 func (s *testTracker) Track(ctx context.Context, sessionID uuid.UUID, stream PresenceStream, userID uuid.UUID,
@@ -320,3 +328,33 @@ func (s *testSessionRegistry) Disconnect(ctx context.Context, sessionID uuid.UUI
 
 func (s *testSessionRegistry) SingleSession(ctx context.Context, tracker Tracker, userID, sessionID uuid.UUID) {
 }
+
+// testBlockGraph implements the BlockGraph interface over a fixed set of block relationships
+type testBlockGraph struct {
+	blocked map[string]map[string]struct{}
+}
+
+// newTestBlockGraph takes pairs of user IDs, where the first user has blocked the second.
+func newTestBlockGraph(blocks ...[2]string) *testBlockGraph {
+	g := &testBlockGraph{blocked: make(map[string]map[string]struct{})}
+	for _, block := range blocks {
+		for i := 0; i < 2; i++ {
+			if _, found := g.blocked[block[i]]; !found {
+				g.blocked[block[i]] = make(map[string]struct{})
+			}
+			g.blocked[block[i]][block[1-i]] = struct{}{}
+		}
+	}
+	return g
+}
+
+func (g *testBlockGraph) Stop() {}
+func (g *testBlockGraph) Blocked(ctx context.Context, userIDs []string) (map[string]struct{}, error) {
+	blocked := make(map[string]struct{})
+	for _, userID := range userIDs {
+		for blockedUserID := range g.blocked[userID] {
+			blocked[blockedUserID] = struct{}{}
+		}
+	}
+	return blocked, nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// MatchDataRateLimitAction is what happens to a presence that sends match data faster than its limits allow. The
+// offending message is always dropped.
+type MatchDataRateLimitAction uint8
+
+const (
+	MatchDataRateLimitActionDrop MatchDataRateLimitAction = iota
+	// Send the presence an error the first time a limit is exceeded after an accepted message.
+	MatchDataRateLimitActionNotify
+	// Remove the presence from the match.
+	MatchDataRateLimitActionKick
+)
+
+func (a MatchDataRateLimitAction) String() string {
+	switch a {
+	case MatchDataRateLimitActionNotify:
+		return "notify"
+	case MatchDataRateLimitActionKick:
+		return "kick"
+	default:
+		return "drop"
+	}
+}
+
+func ParseMatchDataRateLimitAction(action string) (MatchDataRateLimitAction, error) {
+	switch action {
+	case "drop":
+		return MatchDataRateLimitActionDrop, nil
+	case "notify":
+		return MatchDataRateLimitActionNotify, nil
+	case "kick":
+		return MatchDataRateLimitActionKick, nil
+	default:
+		return MatchDataRateLimitActionDrop, fmt.Errorf("match data rate limit action must be drop, notify or kick, got %q", action)
+	}
+}
+
+// MatchDataRateLimit is a token bucket limit on the match data messages sent by a single presence.
+type MatchDataRateLimit struct {
+	// Sustained messages per second.
+	Rate float64
+	// Messages allowed at once above the sustained rate.
+	Burst int
+}
+
+// MatchDataRateLimits holds the limits that apply to every presence in a match.
+type MatchDataRateLimits struct {
+	// Limit across all op codes, nil if there is none.
+	Presence *MatchDataRateLimit
+	// Limits for individual op codes, applied in addition to the presence limit.
+	OpCodes map[int64]*MatchDataRateLimit
+	Action  MatchDataRateLimitAction
+}
+
+// NewMatchDataRateLimits starts from the configured limits, and applies any overrides returned by the match handler at
+// init. Overrides use the keys "rate", "burst", "action" and "op_codes", which holds limits keyed by op code. Returns nil
+// if no limits apply.
+func NewMatchDataRateLimits(config *MatchConfig, overrides map[string]interface{}) (*MatchDataRateLimits, error) {
+	limits := &MatchDataRateLimits{
+		OpCodes: make(map[int64]*MatchDataRateLimit, len(config.DataOpCodeRateLimits)),
+	}
+	if c := config.DataRateLimit; c != nil && c.Rate > 0 {
+		limits.Presence = &MatchDataRateLimit{Rate: c.Rate, Burst: c.Burst}
+	}
+	for opCode, c := range config.DataOpCodeRateLimits {
+		if c != nil && c.Rate > 0 {
+			limits.OpCodes[opCode] = &MatchDataRateLimit{Rate: c.Rate, Burst: c.Burst}
+		}
+	}
+	limits.Action, _ = ParseMatchDataRateLimitAction(config.DataRateLimitAction)
+
+	if overrides != nil {
+		if err := limits.override(overrides); err != nil {
+			return nil, err
+		}
+	}
+
+	if limits.Presence == nil && len(limits.OpCodes) == 0 {
+		return nil, nil
+	}
+	return limits, nil
+}
+
+func (l *MatchDataRateLimits) override(overrides map[string]interface{}) error {
+	if _, found := overrides["rate"]; found {
+		limit, err := parseMatchDataRateLimit(overrides)
+		if err != nil {
+			return err
+		}
+		l.Presence = limit
+	}
+
+	if action, found := overrides["action"]; found {
+		actionStr, ok := action.(string)
+		if !ok {
+			return errors.New("match data rate limit action must be a string")
+		}
+		parsed, err := ParseMatchDataRateLimitAction(actionStr)
+		if err != nil {
+			return err
+		}
+		l.Action = parsed
+	}
+
+	if opCodes, found := overrides["op_codes"]; found {
+		var opCodesMap map[int64]interface{}
+		switch v := opCodes.(type) {
+		case map[int64]interface{}:
+			opCodesMap = v
+		case map[string]interface{}:
+			opCodesMap = make(map[int64]interface{}, len(v))
+			for key, value := range v {
+				opCode, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return fmt.Errorf("match data rate limit op code must be a number, got %q", key)
+				}
+				opCodesMap[opCode] = value
+			}
+		default:
+			return errors.New("match data rate limit op_codes must be a map of limits by op code")
+		}
+		for opCode, value := range opCodesMap {
+			valueMap, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("match data rate limit for op code %v must be a map", opCode)
+			}
+			limit, err := parseMatchDataRateLimit(valueMap)
+			if err != nil {
+				return err
+			}
+			if limit == nil {
+				delete(l.OpCodes, opCode)
+			} else {
+				l.OpCodes[opCode] = limit
+			}
+		}
+	}
+
+	return nil
+}
+
+// Parse a rate and burst, a rate of 0 removes the limit.
+func parseMatchDataRateLimit(values map[string]interface{}) (*MatchDataRateLimit, error) {
+	rate, ok := matchDataRateLimitNumber(values["rate"])
+	if !ok || rate < 0 {
+		return nil, errors.New("match data rate limit rate must be a number >= 0")
+	}
+	if rate == 0 {
+		return nil, nil
+	}
+
+	burst := rate
+	if value, found := values["burst"]; found {
+		if burst, ok = matchDataRateLimitNumber(value); !ok || burst < 1 {
+			return nil, errors.New("match data rate limit burst must be a number >= 1")
+		}
+	} else if burst < 1 {
+		burst = 1
+	}
+
+	return &MatchDataRateLimit{Rate: rate, Burst: int(burst)}, nil
+}
+
+func matchDataRateLimitNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// matchDataLimiter tracks the token buckets of every presence sending data to a match. Data arrives from many
+// goroutines, so all access is synchronised.
+type matchDataLimiter struct {
+	sync.Mutex
+	limits    *MatchDataRateLimits
+	presences map[uuid.UUID]*matchDataBuckets
+}
+
+type matchDataBuckets struct {
+	presence *TokenBucket
+	opCodes  map[int64]*TokenBucket
+	// Set after a message is dropped, until a message is accepted again.
+	limited bool
+}
+
+func newMatchDataLimiter(limits *MatchDataRateLimits) *matchDataLimiter {
+	return &matchDataLimiter{
+		limits:    limits,
+		presences: make(map[uuid.UUID]*matchDataBuckets),
+	}
+}
+
+// Allow reports whether a message with the given op code may be sent by the session, and if not whether this is the
+// first message dropped since the last accepted one.
+func (l *matchDataLimiter) Allow(sessionID uuid.UUID, opCode int64, now time.Time) (allowed bool, first bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	buckets, found := l.presences[sessionID]
+	if !found {
+		buckets = &matchDataBuckets{}
+		if limit := l.limits.Presence; limit != nil {
+			buckets.presence = NewTokenBucket(limit.Rate, limit.Burst, now)
+		}
+		l.presences[sessionID] = buckets
+	}
+
+	opCodeBucket, found := buckets.opCodes[opCode]
+	if !found {
+		if limit, ok := l.limits.OpCodes[opCode]; ok {
+			if buckets.opCodes == nil {
+				buckets.opCodes = make(map[int64]*TokenBucket, len(l.limits.OpCodes))
+			}
+			opCodeBucket = NewTokenBucket(limit.Rate, limit.Burst, now)
+			buckets.opCodes[opCode] = opCodeBucket
+		}
+	}
+
+	// Only take tokens if both limits allow the message.
+	if (buckets.presence != nil && !buckets.presence.Available(now)) || (opCodeBucket != nil && !opCodeBucket.Available(now)) {
+		first = !buckets.limited
+		buckets.limited = true
+		return false, first
+	}
+	if buckets.presence != nil {
+		buckets.presence.Take()
+	}
+	if opCodeBucket != nil {
+		opCodeBucket.Take()
+	}
+	buckets.limited = false
+	return true, false
+}
+
+// Remove discards the state of presences that have left the match.
+func (l *matchDataLimiter) Remove(presences []*MatchPresence) {
+	l.Lock()
+	for _, presence := range presences {
+		delete(l.presences, presence.SessionID)
+	}
+	l.Unlock()
+}
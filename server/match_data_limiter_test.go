@@ -0,0 +1,152 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+)
+
+// should combine configured limits with handler overrides
+func TestNewMatchDataRateLimits(t *testing.T) {
+	config := NewMatchConfig()
+	if limits, err := NewMatchDataRateLimits(config, nil); limits != nil || err != nil {
+		t.Fatalf("expected no limits by default, got %v, %v", limits, err)
+	}
+
+	config.DataRateLimit.Rate = 10
+	config.DataOpCodeRateLimits[1] = &MatchDataRateLimitConfig{Rate: 2, Burst: 4}
+	config.DataOpCodeRateLimits[2] = &MatchDataRateLimitConfig{Rate: 3, Burst: 3}
+	limits, err := NewMatchDataRateLimits(config, map[string]interface{}{
+		"action": "kick",
+		"op_codes": map[string]interface{}{
+			"1": map[string]interface{}{"rate": int64(0)},
+			"3": map[string]interface{}{"rate": 0.5},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error building limits: %v", err)
+	}
+	if limits.Action != MatchDataRateLimitActionKick {
+		t.Fatalf("expected kick action, got %v", limits.Action)
+	}
+	if *limits.Presence != (MatchDataRateLimit{Rate: 10, Burst: 20}) {
+		t.Fatalf("unexpected presence limit: %+v", limits.Presence)
+	}
+	if _, found := limits.OpCodes[1]; found {
+		t.Fatalf("expected op code 1 limit to be removed")
+	}
+	if *limits.OpCodes[2] != (MatchDataRateLimit{Rate: 3, Burst: 3}) {
+		t.Fatalf("unexpected op code 2 limit: %+v", limits.OpCodes[2])
+	}
+	if *limits.OpCodes[3] != (MatchDataRateLimit{Rate: 0.5, Burst: 1}) {
+		t.Fatalf("unexpected op code 3 limit: %+v", limits.OpCodes[3])
+	}
+
+	for _, overrides := range []map[string]interface{}{
+		{"rate": "fast"},
+		{"rate": 1, "burst": 0},
+		{"action": "ban"},
+		{"op_codes": map[string]interface{}{"one": map[string]interface{}{"rate": 1}}},
+	} {
+		if _, err := NewMatchDataRateLimits(config, overrides); err == nil {
+			t.Fatalf("expected error for overrides %v", overrides)
+		}
+	}
+}
+
+// should apply presence and op code limits independently for each session, reporting only the first drop of a run
+func TestMatchDataLimiterAllow(t *testing.T) {
+	limiter := newMatchDataLimiter(&MatchDataRateLimits{
+		Presence: &MatchDataRateLimit{Rate: 1, Burst: 3},
+		OpCodes:  map[int64]*MatchDataRateLimit{1: {Rate: 1, Burst: 1}},
+	})
+	sessionID := uuid.Must(uuid.NewV4())
+	otherSessionID := uuid.Must(uuid.NewV4())
+	now := time.Now()
+
+	expect := func(sessionID uuid.UUID, opCode int64, allowed, first bool) {
+		t.Helper()
+		if a, f := limiter.Allow(sessionID, opCode, now); a != allowed || f != first {
+			t.Fatalf("expected allowed %v first %v for op code %v, got %v %v", allowed, first, opCode, a, f)
+		}
+	}
+
+	expect(sessionID, 1, true, false)
+	expect(sessionID, 1, false, true)
+	expect(sessionID, 1, false, false)
+	// A dropped op code message does not use the presence limit.
+	expect(sessionID, 2, true, false)
+	expect(sessionID, 2, true, false)
+	expect(sessionID, 2, false, true)
+	expect(otherSessionID, 1, true, false)
+
+	// One token refills for each limit after a second.
+	now = now.Add(time.Second)
+	expect(sessionID, 1, true, false)
+	expect(sessionID, 2, false, true)
+
+	limiter.Remove([]*MatchPresence{{SessionID: sessionID}})
+	expect(sessionID, 2, true, false)
+}
+
+// should reject match data over the configured limit, and notify the sender once
+func TestMatchHandlerAllowData(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+
+	matchRegistry.config.GetMatch().DataRateLimit.Rate = 0.001
+	matchRegistry.config.GetMatch().DataRateLimit.Burst = 2
+	matchRegistry.config.GetMatch().DataRateLimitAction = "notify"
+
+	var notified []*rtapi.Envelope
+	matchRegistry.router.(*testMessageRouter).sendToPresence = func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		notified = append(notified, envelope)
+	}
+
+	res, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matchID, err := matchUUIDFromString(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mh, _ := matchRegistry.matches.Load(matchID)
+
+	userID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+	var allowed int
+	for i := 0; i < 5; i++ {
+		if mh.AllowData(userID, sessionID, "node", 1) {
+			allowed++
+		}
+	}
+
+	if allowed != 2 {
+		t.Fatalf("expected 2 allowed messages, got %v", allowed)
+	}
+	if len(notified) != 1 || notified[0].GetError().GetContext()["match_id"] != res {
+		t.Fatalf("expected a single rate limit error, got %v", notified)
+	}
+}
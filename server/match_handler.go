@@ -16,17 +16,33 @@ package server
 
 import (
 	"context"
+	"crypto"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var ErrMatchInitStateNil = errors.New("Match initial state must not be nil")
+var (
+	ErrMatchInitStateNil          = errors.New("Match initial state must not be nil")
+	ErrMatchReconnectTokenInvalid = errors.New("match reconnect token invalid")
+)
+
+// A slot held for a disconnected match participant until they reconnect or the window expires.
+type matchSuspension struct {
+	presence   *MatchPresence
+	expiryTick int64
+}
 
 type MatchDataMessage struct {
 	UserID      uuid.UUID
@@ -81,6 +97,7 @@ type MatchHandler struct {
 	sessionRegistry SessionRegistry
 	matchRegistry   MatchRegistry
 	router          MessageRouter
+	metrics         Metrics
 
 	JoinMarkerList *MatchJoinMarkerList
 	PresenceList   *MatchPresenceList
@@ -108,14 +125,43 @@ type MatchHandler struct {
 
 	deferredCh chan *DeferredMessage
 
+	// Set if this match's calls are being recorded.
+	recording *MatchRecording
+	// Number of ticks between state snapshots recorded for match loop calls.
+	recordStateTicks int64
+
+	// Tick performance tracking.
+	profiler *matchProfiler
+
+	// Set if match data sent by presences is rate limited.
+	dataLimiter *matchDataLimiter
+
+	// Reconnect reservations, by reconnect ID.
+	reconnectWindow      time.Duration
+	reconnectWindowTicks int64
+	encryptionKey        []byte
+	suspensions          map[string]*matchSuspension
+
 	// Configuration set by match init.
 	Rate int64
+	// Parameters the match was created with, kept for snapshots.
+	params map[string]interface{}
 
 	// Match state.
 	state interface{}
 }
 
-func NewMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, router MessageRouter, core RuntimeMatchCore, id uuid.UUID, node string, stopped *atomic.Bool, params map[string]interface{}) (*MatchHandler, error) {
+func NewMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, router MessageRouter, recorder MatchRecorder, metrics Metrics, core RuntimeMatchCore, id uuid.UUID, node string, stopped *atomic.Bool, params map[string]interface{}) (*MatchHandler, error) {
+	return newMatchHandler(logger, config, sessionRegistry, matchRegistry, router, recorder, metrics, core, id, node, stopped, params, nil, true)
+}
+
+// NewSteppedMatchHandler creates a match handler that does not tick on its own. Each tick must be queued with
+// QueueLoop, so tests can drive match handlers deterministically.
+func NewSteppedMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, router MessageRouter, recorder MatchRecorder, metrics Metrics, core RuntimeMatchCore, id uuid.UUID, node string, stopped *atomic.Bool, params map[string]interface{}) (*MatchHandler, error) {
+	return newMatchHandler(logger, config, sessionRegistry, matchRegistry, router, recorder, metrics, core, id, node, stopped, params, nil, false)
+}
+
+func newMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, matchRegistry MatchRegistry, router MessageRouter, recorder MatchRecorder, metrics Metrics, core RuntimeMatchCore, id uuid.UUID, node string, stopped *atomic.Bool, params map[string]interface{}, snapshot *MatchStateSnapshot, tick bool) (*MatchHandler, error) {
 	presenceList := NewMatchPresenceList()
 	deferredCh := make(chan *DeferredMessage, config.GetMatch().DeferredQueueSize)
 	deferMessageFn := func(msg *DeferredMessage) error {
@@ -134,12 +180,35 @@ func NewMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionR
 		return nil, err
 	}
 
+	dataRateLimits, err := NewMatchDataRateLimits(config.GetMatch(), core.DataRateLimits())
+	if err != nil {
+		core.Cancel()
+		core.Cleanup()
+		return nil, err
+	}
+
+	// A match re-created after a restart continues from its snapshot state and tick.
+	var startTick int64
+	if snapshot != nil {
+		state, err = core.MatchRestore(snapshot.Tick, state, snapshot.Data)
+		if err == nil && state == nil {
+			err = ErrMatchRestoreStateNil
+		}
+		if err != nil {
+			core.Cancel()
+			core.Cleanup()
+			return nil, err
+		}
+		startTick = snapshot.Tick
+	}
+
 	// Construct the match.
 	mh := &MatchHandler{
 		logger:          logger,
 		sessionRegistry: sessionRegistry,
 		matchRegistry:   matchRegistry,
 		router:          router,
+		metrics:         metrics,
 
 		JoinMarkerList: NewMatchJoinMarkerList(config, int64(rateInt)),
 		PresenceList:   presenceList,
@@ -154,7 +223,7 @@ func NewMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionR
 			Label:   node,
 		},
 
-		tick: 0,
+		tick: startTick,
 
 		emptyTicks:    0,
 		maxEmptyTicks: rateInt * config.GetMatch().MaxEmptySec,
@@ -167,23 +236,50 @@ func NewMatchHandler(logger *zap.Logger, config Config, sessionRegistry SessionR
 		stopCh:        make(chan struct{}),
 		stopped:       stopped,
 
-		Rate: int64(rateInt),
+		reconnectWindow:      time.Duration(config.GetMatch().ReconnectWindowSec) * time.Second,
+		reconnectWindowTicks: int64(rateInt * config.GetMatch().ReconnectWindowSec),
+		encryptionKey:        []byte(config.GetSession().EncryptionKey),
+		suspensions:          make(map[string]*matchSuspension),
+
+		profiler: newMatchProfiler(logger, metrics, core.HandlerName(), int64(rateInt), config.GetMatch().OverrunLogTicks),
+
+		Rate:   int64(rateInt),
+		params: params,
 
 		state: state,
 	}
 
-	// Set up the ticker that governs the match loop.
-	mh.ticker = time.NewTicker(time.Second / time.Duration(mh.Rate))
+	if dataRateLimits != nil {
+		mh.dataLimiter = newMatchDataLimiter(dataRateLimits)
+	}
+
+	if recorder != nil && matchRecordRequested(params) {
+		mh.recording = recorder.Record(id, node, core.HandlerName(), params)
+		mh.recordStateTicks = int64(rateInt) * int64(config.GetMatch().RecordStateIntervalMs) / 1000
+		if mh.recordStateTicks < 1 {
+			mh.recordStateTicks = 1
+		}
+	}
+
+	// Set up the ticker that governs the match loop, unless ticks are queued manually.
+	var tickCh <-chan time.Time
+	if tick {
+		mh.ticker = time.NewTicker(time.Second / time.Duration(mh.Rate))
+		tickCh = mh.ticker.C
+	}
 
 	// Continuously run queued actions until the match stops.
 	go func() {
 		defer core.Cleanup()
+		if mh.recording != nil {
+			defer mh.recording.Close()
+		}
 		for {
 			select {
 			case <-mh.stopCh:
 				// Match has been stopped.
 				return
-			case <-mh.ticker.C:
+			case <-tickCh:
 				// Tick, queue a match loop invocation.
 				if !mh.queueCall(loop) {
 					return
@@ -228,7 +324,9 @@ func (mh *MatchHandler) Stop() {
 
 	mh.Core.Cancel()
 	close(mh.stopCh)
-	mh.ticker.Stop()
+	if mh.ticker != nil {
+		mh.ticker.Stop()
+	}
 }
 
 func (mh *MatchHandler) Label() string {
@@ -243,6 +341,11 @@ func (mh *MatchHandler) HandlerName() string {
 	return mh.Core.HandlerName()
 }
 
+// Profile returns a snapshot of the match tick performance, safe to call from any goroutine.
+func (mh *MatchHandler) Profile() MatchProfile {
+	return mh.profiler.Snapshot()
+}
+
 func (mh *MatchHandler) CreateTime() int64 {
 	return mh.Core.CreateTime()
 }
@@ -264,6 +367,11 @@ func (mh *MatchHandler) queueCall(f func(*MatchHandler)) bool {
 	}
 }
 
+// QueueLoop queues a single match loop invocation, for match handlers that do not tick on their own.
+func (mh *MatchHandler) QueueLoop() bool {
+	return mh.queueCall(loop)
+}
+
 func (mh *MatchHandler) QueueData(m *MatchDataMessage) {
 	if mh.stopped.Load() {
 		return
@@ -279,13 +387,69 @@ func (mh *MatchHandler) QueueData(m *MatchDataMessage) {
 	}
 }
 
+// AllowData applies any match data rate limits to a message from the given presence, and reports whether it should be
+// queued. Presences that exceed a limit are notified or kicked according to the limit action.
+func (mh *MatchHandler) AllowData(userID, sessionID uuid.UUID, node string, opCode int64) bool {
+	if mh.dataLimiter == nil {
+		return true
+	}
+
+	allowed, first := mh.dataLimiter.Allow(sessionID, opCode, time.Now())
+	if allowed {
+		return true
+	}
+
+	action := mh.dataLimiter.limits.Action
+	if mh.metrics != nil {
+		mh.metrics.MatchDataRateLimited(mh.Core.HandlerName(), action.String())
+	}
+
+	// Only act once for each run of dropped messages.
+	if !first {
+		return false
+	}
+	switch action {
+	case MatchDataRateLimitActionNotify:
+		mh.router.SendToPresenceIDs(mh.logger, []*PresenceID{{Node: node, SessionID: sessionID}}, &rtapi.Envelope{Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Match data rate limit exceeded",
+			Context: map[string]string{
+				"match_id": mh.IDStr,
+				"op_code":  strconv.FormatInt(opCode, 10),
+			},
+		}}}, true)
+	case MatchDataRateLimitActionKick:
+		mh.logger.Debug("Kicking presence that exceeded match data rate limit", zap.String("sid", sessionID.String()), zap.Int64("op_code", opCode))
+		mh.matchRegistry.Kick(mh.Stream, []*MatchPresence{{Node: node, UserID: userID, SessionID: sessionID}})
+	}
+	return false
+}
+
 func loop(mh *MatchHandler) {
 	if mh.stopped.Load() {
 		return
 	}
 
+	start := time.Now()
+	inputQueueDepth := len(mh.inputCh)
+
+	var inputCh <-chan *MatchDataMessage = mh.inputCh
+	var event *MatchRecordEvent
+	if mh.recording != nil {
+		// Take a copy of the input queue contents so they can be recorded exactly as the loop sees them.
+		size := len(mh.inputCh)
+		messages := make([]*MatchDataMessage, size)
+		recordedCh := make(chan *MatchDataMessage, size)
+		for i := 0; i < size; i++ {
+			messages[i] = <-mh.inputCh
+			recordedCh <- messages[i]
+		}
+		inputCh = recordedCh
+		event = &MatchRecordEvent{Type: MatchRecordEventLoop, Tick: mh.tick, Messages: messages}
+	}
+
 	// Execute the loop.
-	state, err := mh.Core.MatchLoop(mh.tick, mh.state, mh.inputCh)
+	state, err := mh.Core.MatchLoop(mh.tick, mh.state, inputCh)
 	if err != nil {
 		mh.Stop()
 		mh.disconnectClients()
@@ -296,9 +460,13 @@ func loop(mh *MatchHandler) {
 	// Check if we need to stop the match.
 	if state != nil {
 		// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
+		deferredQueueDepth := len(mh.deferredCh)
 		mh.processDeferred()
+		mh.profiler.observe(mh.tick, time.Since(start), inputQueueDepth, deferredQueueDepth)
+		mh.record(event, state)
 	} else {
 		mh.Stop()
+		mh.record(event, state)
 		mh.logger.Info("Match loop returned nil or no state, stopping match")
 		return
 	}
@@ -312,6 +480,29 @@ func loop(mh *MatchHandler) {
 		}
 	}
 
+	// Participants get a new reconnect token every half window, so the newest token they hold always outlives the
+	// reservation of a disconnect.
+	if mh.reconnectWindowTicks > 0 && mh.tick%mh.Rate == 0 {
+		for _, presence := range mh.PresenceList.ListPresences() {
+			if presence.reconnectID != "" && mh.tick-presence.reconnectIssueTick >= mh.reconnectWindowTicks/2 {
+				mh.sendReconnectToken(presence)
+			}
+		}
+	}
+
+	// Release the slots of disconnected participants whose reconnect window has expired.
+	if len(mh.suspensions) != 0 {
+		if expired := mh.expireSuspensions(); len(expired) != 0 {
+			// Doesn't matter if the call queue was full here. If the match is being closed then leaves don't matter anyway.
+			mh.queueCall(func(mh *MatchHandler) {
+				if mh.stopped.Load() {
+					return
+				}
+				mh.matchLeave(expired)
+			})
+		}
+	}
+
 	// Check if the match has been empty too long.
 	if mh.maxEmptyTicks > 0 {
 		if mh.PresenceList.size.Load() == 0 {
@@ -346,6 +537,25 @@ func (mh *MatchHandler) processDeferred() {
 	}
 }
 
+// Write a recording event with a snapshot of the state it produced, if the match is being recorded. Match loop events
+// only include a snapshot at the configured interval.
+func (mh *MatchHandler) record(event *MatchRecordEvent, state interface{}) {
+	if event == nil {
+		return
+	}
+
+	if state != nil && (event.Type != MatchRecordEventLoop || event.Tick%mh.recordStateTicks == 0) {
+		snapshot, err := mh.Core.GetState(state)
+		if err != nil {
+			mh.logger.Warn("Error getting match state snapshot for recording", zap.Int64("tick", mh.tick), zap.Error(err))
+		}
+		event.State = snapshot
+		event.HasState = true
+	}
+
+	mh.recording.Write(event)
+}
+
 func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *MatchJoinAttemptResult, userID, sessionID uuid.UUID, username string, sessionExpiry int64, vars map[string]string, clientIP, clientPort, node string, metadata map[string]string) bool {
 	if mh.stopped.Load() {
 		return false
@@ -366,6 +576,21 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 			return
 		}
 
+		var event *MatchRecordEvent
+		if mh.recording != nil {
+			event = &MatchRecordEvent{Type: MatchRecordEventJoinAttempt, Tick: mh.tick, JoinAttempt: &MatchRecordJoinAttempt{
+				UserID:        userID,
+				SessionID:     sessionID,
+				Username:      username,
+				SessionExpiry: sessionExpiry,
+				Vars:          vars,
+				ClientIP:      clientIP,
+				ClientPort:    clientPort,
+				Node:          node,
+				Metadata:      metadata,
+			}}
+		}
+
 		state, allow, reason, err := mh.Core.MatchJoinAttempt(mh.tick, mh.state, userID, sessionID, username, sessionExpiry, vars, clientIP, clientPort, node, metadata)
 		if err != nil {
 			mh.Stop()
@@ -375,11 +600,17 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 			return
 		}
 
+		if event != nil {
+			event.Allow = allow
+			event.Result = reason
+		}
 		if state != nil {
 			// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
 			mh.processDeferred()
+			mh.record(event, state)
 		} else {
 			mh.Stop()
+			mh.record(event, state)
 			resultCh <- &MatchJoinAttemptResult{Allow: false}
 			mh.logger.Info("Match join attempt returned nil or no state, stopping match")
 			return
@@ -387,7 +618,7 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 
 		mh.state = state
 		if allow {
-			presence := &MatchPresence{Node: node, UserID: userID, SessionID: sessionID, Username: username}
+			presence := &MatchPresence{Node: node, UserID: userID, SessionID: sessionID, Username: username, Spectator: matchJoinSpectator(metadata), reconnectID: mh.newReconnectID()}
 			mh.JoinMarkerList.Add(presence, mh.tick)
 			mh.QueueJoin([]*MatchPresence{presence}, false)
 		}
@@ -406,6 +637,57 @@ func (mh *MatchHandler) QueueJoinAttempt(ctx context.Context, resultCh chan<- *M
 	}
 }
 
+func (mh *MatchHandler) QueueReconnect(ctx context.Context, resultCh chan<- *MatchJoinAttemptResult, token string, userID, sessionID uuid.UUID, username, node string) bool {
+	if mh.stopped.Load() {
+		return false
+	}
+
+	reconnect := func(mh *MatchHandler) {
+		select {
+		case <-ctx.Done():
+			// Do not restore the reservation if the client has gone away between when this call was inserted into the
+			// match join attempt queue and when it's due for processing.
+			resultCh <- &MatchJoinAttemptResult{Allow: false}
+			return
+		default:
+		}
+
+		if mh.stopped.Load() {
+			resultCh <- &MatchJoinAttemptResult{Allow: false}
+			return
+		}
+
+		var suspension *matchSuspension
+		if reconnectID, err := mh.parseReconnectToken(token); err == nil {
+			suspension = mh.suspensions[reconnectID]
+		}
+		if suspension == nil || suspension.presence.UserID != userID {
+			resultCh <- &MatchJoinAttemptResult{Allow: false, Reason: "Match reconnect reservation not found"}
+			return
+		}
+		delete(mh.suspensions, suspension.presence.reconnectID)
+		mh.PresenceList.Release()
+
+		// Restore the presence without a new join attempt, keeping its reservation. The match handler is still told of
+		// the join, and the participant gets a new reconnect token once it's processed.
+		presence := &MatchPresence{Node: node, UserID: userID, SessionID: sessionID, Username: username, reconnectID: suspension.presence.reconnectID}
+		mh.JoinMarkerList.Add(presence, mh.tick)
+		mh.QueueJoin([]*MatchPresence{presence}, false)
+
+		// Signal client.
+		resultCh <- &MatchJoinAttemptResult{Allow: true, Label: mh.Core.Label()}
+	}
+
+	select {
+	case mh.joinAttemptCh <- reconnect:
+		return true
+	default:
+		// Match join attempt queue is full, the handler isn't processing these fast enough or there are just too many.
+		mh.logger.Warn("Match handler join attempt queue full")
+		return false
+	}
+}
+
 func (mh *MatchHandler) QueueSignal(ctx context.Context, resultCh chan<- *MatchSignalResult, data string) bool {
 	if mh.stopped.Load() {
 		return false
@@ -426,6 +708,11 @@ func (mh *MatchHandler) QueueSignal(ctx context.Context, resultCh chan<- *MatchS
 			return
 		}
 
+		var event *MatchRecordEvent
+		if mh.recording != nil {
+			event = &MatchRecordEvent{Type: MatchRecordEventSignal, Tick: mh.tick, Signal: data}
+		}
+
 		state, resultData, err := mh.Core.MatchSignal(mh.tick, mh.state, data)
 		if err != nil {
 			mh.Stop()
@@ -435,11 +722,16 @@ func (mh *MatchHandler) QueueSignal(ctx context.Context, resultCh chan<- *MatchS
 			return
 		}
 
+		if event != nil {
+			event.Result = resultData
+		}
 		if state != nil {
 			// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
 			mh.processDeferred()
+			mh.record(event, state)
 		} else {
 			mh.Stop()
+			mh.record(event, state)
 			resultCh <- &MatchSignalResult{Success: false}
 			mh.logger.Info("Match signal returned nil or no state, stopping match")
 			return
@@ -496,6 +788,58 @@ func (mh *MatchHandler) QueueGetState(ctx context.Context, resultCh chan<- *Matc
 	return mh.queueCall(getState)
 }
 
+// QueueSnapshot asks the match handler to serialize its state and save it with saveFn, so the match can be re-created
+// after a restart. A match whose snapshot is saved stops immediately without a call to match terminate, its state
+// continues from the snapshot after the restart instead. The result is false if the match handler does not keep its
+// state or it could not be saved.
+func (mh *MatchHandler) QueueSnapshot(saveFn func(*MatchStateSnapshot) error, resultCh chan<- bool) bool {
+	if mh.stopped.Load() {
+		return false
+	}
+
+	snapshot := func(mh *MatchHandler) {
+		if mh.stopped.Load() {
+			resultCh <- false
+			return
+		}
+
+		data, err := mh.Core.MatchSnapshot(mh.tick, mh.state)
+		if err != nil {
+			// Errors taking a snapshot do not result in the match stopping, it will be terminated shortly anyway.
+			mh.logger.Warn("Error from match_snapshot execution", zap.Int64("tick", mh.tick), zap.Error(err))
+			resultCh <- false
+			return
+		}
+		if data == "" {
+			resultCh <- false
+			return
+		}
+
+		if err := saveFn(&MatchStateSnapshot{
+			Version:   MatchSnapshotVersion,
+			ID:        mh.ID,
+			Node:      mh.Node,
+			Module:    mh.Core.HandlerName(),
+			Params:    mh.params,
+			Tick:      mh.tick,
+			Presences: mh.PresenceList.ListPresences(),
+			Data:      data,
+		}); err != nil {
+			// The match is terminated as usual if its snapshot could not be saved.
+			mh.logger.Error("Could not save match snapshot", zap.Error(err))
+			resultCh <- false
+			return
+		}
+
+		// Report the result before stopping, so callers watching for the match to stop see it was saved.
+		resultCh <- true
+		mh.Stop()
+		mh.logger.Info("Match stopped after saving snapshot", zap.Int64("tick", mh.tick))
+	}
+
+	return mh.queueCall(snapshot)
+}
+
 func (mh *MatchHandler) QueueJoin(joins []*MatchPresence, mark bool) bool {
 	if mh.stopped.Load() {
 		return false
@@ -516,6 +860,11 @@ func (mh *MatchHandler) QueueJoin(joins []*MatchPresence, mark bool) bool {
 
 		processed := mh.PresenceList.Join(joins)
 		if len(processed) != 0 {
+			var event *MatchRecordEvent
+			if mh.recording != nil {
+				event = &MatchRecordEvent{Type: MatchRecordEventJoin, Tick: mh.tick, Presences: processed}
+			}
+
 			state, err := mh.Core.MatchJoin(mh.tick, mh.state, processed)
 			if err != nil {
 				mh.Stop()
@@ -526,13 +875,21 @@ func (mh *MatchHandler) QueueJoin(joins []*MatchPresence, mark bool) bool {
 			if state != nil {
 				// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
 				mh.processDeferred()
+				mh.record(event, state)
 			} else {
 				mh.Stop()
+				mh.record(event, state)
 				mh.logger.Info("Match join returned nil or no state, stopping match")
 				return
 			}
 
 			mh.state = state
+
+			for _, presence := range processed {
+				if presence.reconnectID != "" {
+					mh.sendReconnectToken(presence)
+				}
+			}
 		}
 	}
 
@@ -549,35 +906,164 @@ func (mh *MatchHandler) QueueLeave(leaves []*MatchPresence) bool {
 			return
 		}
 
+		// Hold the slots of participants who disconnected, so they may reconnect.
+		mh.suspend(leaves)
+
 		processed := mh.PresenceList.Leave(leaves)
 		if len(processed) != 0 {
 			for _, leave := range processed {
 				mh.JoinMarkerList.Mark(leave.SessionID)
 			}
 
-			state, err := mh.Core.MatchLeave(mh.tick, mh.state, leaves)
-			if err != nil {
-				mh.Stop()
-				mh.disconnectClients()
-				mh.logger.Warn("Stopping match after error from match_leave execution", zap.Int("tick", int(mh.tick)), zap.Error(err))
-				return
-			}
-			if state != nil {
-				// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
-				mh.processDeferred()
-			} else {
-				mh.Stop()
-				mh.logger.Info("Match leave returned nil or no state, stopping match")
-				return
-			}
-
-			mh.state = state
+			mh.matchLeave(leaves)
 		}
 	}
 
 	return mh.queueCall(leave)
 }
 
+func (mh *MatchHandler) matchLeave(leaves []*MatchPresence) {
+	var event *MatchRecordEvent
+	if mh.recording != nil {
+		event = &MatchRecordEvent{Type: MatchRecordEventLeave, Tick: mh.tick, Presences: leaves}
+	}
+
+	if mh.dataLimiter != nil {
+		mh.dataLimiter.Remove(leaves)
+	}
+
+	state, err := mh.Core.MatchLeave(mh.tick, mh.state, leaves)
+	if err != nil {
+		mh.Stop()
+		mh.disconnectClients()
+		mh.logger.Warn("Stopping match after error from match_leave execution", zap.Int("tick", int(mh.tick)), zap.Error(err))
+		return
+	}
+	if state != nil {
+		// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
+		mh.processDeferred()
+		mh.record(event, state)
+	} else {
+		mh.Stop()
+		mh.record(event, state)
+		mh.logger.Info("Match leave returned nil or no state, stopping match")
+		return
+	}
+
+	mh.state = state
+}
+
+// Assign a reservation a new participant may reconnect to, if reconnect reservations are enabled.
+func (mh *MatchHandler) newReconnectID() string {
+	if mh.reconnectWindowTicks == 0 {
+		return ""
+	}
+	return uuid.Must(uuid.NewV4()).String()
+}
+
+// Send a participant a token they may use to reconnect to their reservation. Tokens are valid for twice the reconnect
+// window, so a token issued up to half a window before a disconnect is still valid for the whole reservation, which
+// is what limits reconnects to the window.
+func (mh *MatchHandler) sendReconnectToken(presence *MatchPresence) {
+	expiry := time.Now().UTC().Add(2 * mh.reconnectWindow)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"mid": mh.IDStr,
+		"uid": presence.UserID.String(),
+		"rid": presence.reconnectID,
+		"exp": expiry.Unix(),
+	})
+	signedToken, err := token.SignedString(mh.encryptionKey)
+	if err != nil {
+		mh.logger.Error("Error signing match reconnect token", zap.Error(err))
+		return
+	}
+	presence.reconnectIssueTick = mh.tick
+
+	content, _ := json.Marshal(map[string]interface{}{"match_id": mh.IDStr, "token": signedToken, "expiry_time": expiry.Unix()})
+	envelope := &rtapi.Envelope{Message: &rtapi.Envelope_Notifications{
+		Notifications: &rtapi.Notifications{
+			Notifications: []*api.Notification{
+				{
+					Id:         uuid.Must(uuid.NewV4()).String(),
+					Subject:    "match_reconnect",
+					Content:    string(content),
+					Code:       NotificationCodeMatchReconnect,
+					SenderId:   "",
+					CreateTime: &timestamppb.Timestamp{Seconds: time.Now().Unix()},
+					Persistent: false,
+				},
+			},
+		},
+	}}
+	mh.router.SendToPresenceIDs(mh.logger, []*PresenceID{{Node: presence.Node, SessionID: presence.SessionID}}, envelope, true)
+}
+
+// Check a reconnect token was issued by this match and has not expired, and return the reservation it refers to.
+func (mh *MatchHandler) parseReconnectToken(signedToken string) (string, error) {
+	token, err := jwt.Parse(signedToken, func(token *jwt.Token) (interface{}, error) {
+		if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
+		return mh.encryptionKey, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return "", ErrMatchReconnectTokenInvalid
+	}
+	if mid, _ := claims["mid"].(string); mid != mh.IDStr {
+		return "", ErrMatchReconnectTokenInvalid
+	}
+	reconnectID, _ := claims["rid"].(string)
+	if reconnectID == "" {
+		return "", ErrMatchReconnectTokenInvalid
+	}
+	return reconnectID, nil
+}
+
+// Reserve the slots of disconnected participants who may reconnect, and mark their leaves as suspended.
+func (mh *MatchHandler) suspend(leaves []*MatchPresence) {
+	if mh.reconnectWindowTicks == 0 {
+		return
+	}
+
+	for _, leave := range leaves {
+		if leave.Reason != runtime.PresenceReasonDisconnect {
+			continue
+		}
+		presence := mh.PresenceList.Get(leave.SessionID)
+		if presence == nil || presence.Node != leave.Node || presence.Spectator || presence.reconnectID == "" {
+			continue
+		}
+
+		// The reservation is held for the reconnect window from the disconnect.
+		leave.Suspended = true
+		mh.suspensions[presence.reconnectID] = &matchSuspension{
+			presence:   presence,
+			expiryTick: mh.tick + mh.reconnectWindowTicks,
+		}
+		mh.PresenceList.Reserve()
+	}
+}
+
+// Release reserved slots whose reconnect window has expired, and return the final leaves for them.
+func (mh *MatchHandler) expireSuspensions() []*MatchPresence {
+	var expired []*MatchPresence
+	for reconnectID, suspension := range mh.suspensions {
+		if suspension.expiryTick > mh.tick {
+			continue
+		}
+		delete(mh.suspensions, reconnectID)
+		mh.PresenceList.Release()
+
+		p := suspension.presence
+		expired = append(expired, &MatchPresence{Node: p.Node, UserID: p.UserID, SessionID: p.SessionID, Username: p.Username, Reason: runtime.PresenceReasonDisconnect})
+	}
+	return expired
+}
+
 func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 	if mh.stopped.Load() {
 		return false
@@ -588,6 +1074,11 @@ func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 			return
 		}
 
+		var event *MatchRecordEvent
+		if mh.recording != nil {
+			event = &MatchRecordEvent{Type: MatchRecordEventTerminate, Tick: mh.tick, GraceSeconds: graceSeconds}
+		}
+
 		state, err := mh.Core.MatchTerminate(mh.tick, mh.state, graceSeconds)
 		if err != nil {
 			mh.Stop()
@@ -598,8 +1089,10 @@ func (mh *MatchHandler) QueueTerminate(graceSeconds int) bool {
 		if state != nil {
 			// Broadcast any deferred messages. If match will be stopped broadcasting will be handled as part of the match end cycle.
 			mh.processDeferred()
+			mh.record(event, state)
 		} else {
 			mh.Stop()
+			mh.record(event, state)
 			mh.logger.Info("Match terminate returned nil or no state, stopping match")
 			return
 		}
@@ -0,0 +1,243 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Session ID that addresses relayed match data to the current match host.
+const MatchDataTargetHost = "host"
+
+var (
+	ErrMatchHostNotHost        = errors.New("only the match host can transfer the host role")
+	ErrMatchHostNotParticipant = errors.New("new match host must be a match participant")
+)
+
+// MatchHostRegistry elects a host presence for each relayed match. The first participant to join becomes the host,
+// and when the host leaves the role passes to the longest standing remaining participant. Hosts may also hand the role
+// over to another participant.
+//
+// Participants are told about the current host with a non-persistent notification with code NotificationCodeMatchHost
+// and JSON content holding the match ID and the host's user ID, session ID and username. All participants receive it
+// when the host changes, and new joiners receive it when they join.
+type MatchHostRegistry interface {
+	// Join and Leave are fed relayed match presence events by the tracker.
+	Join(id uuid.UUID, joins []*Presence)
+	Leave(id uuid.UUID, leaves []*Presence)
+	// Host returns the current host of a relayed match, nil if the match has no participants.
+	Host(id uuid.UUID) *PresenceID
+	// Transfer hands the host role from the given user, who must hold it, to another participant.
+	Transfer(id uuid.UUID, userID, hostSessionID uuid.UUID) error
+}
+
+type matchHost struct {
+	// Participants in join order.
+	members []*Presence
+	host    *Presence
+}
+
+type LocalMatchHostRegistry struct {
+	sync.Mutex
+	logger *zap.Logger
+	router MessageRouter
+
+	matches map[uuid.UUID]*matchHost
+}
+
+func NewLocalMatchHostRegistry(logger *zap.Logger, router MessageRouter) MatchHostRegistry {
+	return &LocalMatchHostRegistry{
+		logger: logger,
+		router: router,
+
+		matches: make(map[uuid.UUID]*matchHost),
+	}
+}
+
+func (r *LocalMatchHostRegistry) Join(id uuid.UUID, joins []*Presence) {
+	if len(joins) == 0 {
+		return
+	}
+
+	r.Lock()
+	m, found := r.matches[id]
+	if !found {
+		m = &matchHost{}
+		r.matches[id] = m
+	}
+
+	newJoins := make([]*PresenceID, 0, len(joins))
+	for _, join := range joins {
+		if m.index(join.ID.SessionID) != -1 {
+			continue
+		}
+		m.members = append(m.members, join)
+		newJoins = append(newJoins, &join.ID)
+	}
+
+	// Assign the host if this is the first join.
+	elected := false
+	if m.host == nil && len(m.members) != 0 {
+		m.host = m.members[0]
+		elected = true
+	}
+	host := m.host
+	r.Unlock()
+
+	if elected {
+		r.sendHost(id, host, nil)
+	} else if len(newJoins) != 0 {
+		// Let new joiners know who the existing host is.
+		r.sendHost(id, host, newJoins)
+	}
+}
+
+func (r *LocalMatchHostRegistry) Leave(id uuid.UUID, leaves []*Presence) {
+	if len(leaves) == 0 {
+		return
+	}
+
+	r.Lock()
+	m, found := r.matches[id]
+	if !found {
+		r.Unlock()
+		return
+	}
+
+	for _, leave := range leaves {
+		if m.host != nil && m.host.ID.SessionID == leave.ID.SessionID {
+			m.host = nil
+		}
+		if i := m.index(leave.ID.SessionID); i != -1 {
+			copy(m.members[i:], m.members[i+1:])
+			m.members[len(m.members)-1] = nil
+			m.members = m.members[:len(m.members)-1]
+		}
+	}
+
+	// Match is now empty, stop tracking it.
+	if len(m.members) == 0 {
+		delete(r.matches, id)
+		r.Unlock()
+		return
+	}
+
+	// Host has left, but there are other participants. Promote the oldest presence as the new host.
+	var host *Presence
+	if m.host == nil {
+		m.host = m.members[0]
+		host = m.host
+	}
+	r.Unlock()
+
+	if host != nil {
+		r.sendHost(id, host, nil)
+	}
+}
+
+func (r *LocalMatchHostRegistry) Host(id uuid.UUID) *PresenceID {
+	r.Lock()
+	defer r.Unlock()
+
+	m, found := r.matches[id]
+	if !found || m.host == nil {
+		return nil
+	}
+	hostID := m.host.ID
+	return &hostID
+}
+
+func (r *LocalMatchHostRegistry) Transfer(id uuid.UUID, userID, hostSessionID uuid.UUID) error {
+	r.Lock()
+	m, found := r.matches[id]
+	if !found {
+		r.Unlock()
+		return runtime.ErrMatchNotFound
+	}
+
+	// Only the current host may hand over the role.
+	if m.host == nil || m.host.UserID != userID {
+		r.Unlock()
+		return ErrMatchHostNotHost
+	}
+
+	i := m.index(hostSessionID)
+	if i == -1 {
+		r.Unlock()
+		return ErrMatchHostNotParticipant
+	}
+	if m.host == m.members[i] {
+		// Already the host, nothing to do.
+		r.Unlock()
+		return nil
+	}
+	m.host = m.members[i]
+	host := m.host
+	r.Unlock()
+
+	r.sendHost(id, host, nil)
+
+	return nil
+}
+
+// Send the current host to the given presences, or to the whole match if there are none.
+func (r *LocalMatchHostRegistry) sendHost(id uuid.UUID, host *Presence, presenceIDs []*PresenceID) {
+	content, _ := json.Marshal(map[string]interface{}{
+		"match_id":   id.String() + ".",
+		"user_id":    host.GetUserId(),
+		"session_id": host.GetSessionId(),
+		"username":   host.GetUsername(),
+	})
+	envelope := &rtapi.Envelope{Message: &rtapi.Envelope_Notifications{
+		Notifications: &rtapi.Notifications{
+			Notifications: []*api.Notification{
+				{
+					Id:         uuid.Must(uuid.NewV4()).String(),
+					Subject:    "match_host",
+					Content:    string(content),
+					Code:       NotificationCodeMatchHost,
+					SenderId:   "",
+					CreateTime: &timestamppb.Timestamp{Seconds: time.Now().Unix()},
+					Persistent: false,
+				},
+			},
+		},
+	}}
+
+	if len(presenceIDs) == 0 {
+		r.router.SendToStream(r.logger, PresenceStream{Mode: StreamModeMatchRelayed, Subject: id}, envelope, true)
+		return
+	}
+	r.router.SendToPresenceIDs(r.logger, presenceIDs, envelope, true)
+}
+
+func (m *matchHost) index(sessionID uuid.UUID) int {
+	for i, member := range m.members {
+		if member.ID.SessionID == sessionID {
+			return i
+		}
+	}
+	return -1
+}
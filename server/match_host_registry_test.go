@@ -0,0 +1,183 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type matchHostMessage struct {
+	// Empty if sent to the whole match stream.
+	recipients []*PresenceID
+	host       string
+}
+
+// matchHostTestRouter records the host announcements sent by a match host registry.
+type matchHostTestRouter struct {
+	messages []*matchHostMessage
+}
+
+func (r *matchHostTestRouter) SendToPresenceIDs(_ *zap.Logger, presenceIDs []*PresenceID, envelope *rtapi.Envelope, _ bool) {
+	r.messages = append(r.messages, &matchHostMessage{recipients: presenceIDs, host: matchHostTestSessionID(envelope)})
+}
+func (r *matchHostTestRouter) SendToStream(_ *zap.Logger, _ PresenceStream, envelope *rtapi.Envelope, _ bool) {
+	r.messages = append(r.messages, &matchHostMessage{host: matchHostTestSessionID(envelope)})
+}
+func (r *matchHostTestRouter) SendDeferred(*zap.Logger, []*DeferredMessage) {}
+
+func (r *matchHostTestRouter) drain() []*matchHostMessage {
+	messages := r.messages
+	r.messages = nil
+	return messages
+}
+
+// The host session ID announced by a match host notification, empty if the envelope is not one.
+func matchHostTestSessionID(envelope *rtapi.Envelope) string {
+	notifications := envelope.GetNotifications().GetNotifications()
+	if len(notifications) != 1 || notifications[0].Code != NotificationCodeMatchHost || notifications[0].Persistent {
+		return ""
+	}
+	var content map[string]string
+	if err := json.Unmarshal([]byte(notifications[0].Content), &content); err != nil {
+		return ""
+	}
+	return content["session_id"]
+}
+
+func newMatchHostTestPresence(matchID uuid.UUID) *Presence {
+	return &Presence{
+		ID:     PresenceID{Node: "node", SessionID: uuid.Must(uuid.NewV4())},
+		Stream: PresenceStream{Mode: StreamModeMatchRelayed, Subject: matchID},
+		UserID: uuid.Must(uuid.NewV4()),
+	}
+}
+
+// should elect the first joiner as host, promote the oldest participant when the host leaves, and allow handoffs
+func TestMatchHostRegistry(t *testing.T) {
+	router := &matchHostTestRouter{}
+	registry := NewLocalMatchHostRegistry(zap.NewNop(), router)
+	matchID := uuid.Must(uuid.NewV4())
+
+	alice := newMatchHostTestPresence(matchID)
+	bob := newMatchHostTestPresence(matchID)
+	carol := newMatchHostTestPresence(matchID)
+
+	expectHost := func(presence *Presence) {
+		t.Helper()
+		if host := registry.Host(matchID); host == nil || host.SessionID != presence.ID.SessionID {
+			t.Fatalf("expected host %v, got %v", presence.ID.SessionID, host)
+		}
+	}
+
+	// The first joiner is elected and announced to the match.
+	registry.Join(matchID, []*Presence{alice})
+	expectHost(alice)
+	if messages := router.drain(); len(messages) != 1 || messages[0].recipients != nil || messages[0].host != alice.GetSessionId() {
+		t.Fatalf("expected host announcement to the match, got %v", messages)
+	}
+
+	// Later joiners are only told who the host is.
+	registry.Join(matchID, []*Presence{bob, carol})
+	expectHost(alice)
+	if messages := router.drain(); len(messages) != 1 || len(messages[0].recipients) != 2 || messages[0].host != alice.GetSessionId() {
+		t.Fatalf("expected host announcement to the joiners, got %v", messages)
+	}
+
+	// Only the host may hand the role over, and only to a participant.
+	if err := registry.Transfer(matchID, bob.UserID, carol.ID.SessionID); err != ErrMatchHostNotHost {
+		t.Fatalf("expected not host error, got %v", err)
+	}
+	if err := registry.Transfer(matchID, alice.UserID, uuid.Must(uuid.NewV4())); err != ErrMatchHostNotParticipant {
+		t.Fatalf("expected not participant error, got %v", err)
+	}
+	if err := registry.Transfer(matchID, alice.UserID, carol.ID.SessionID); err != nil {
+		t.Fatalf("error transferring host: %v", err)
+	}
+	expectHost(carol)
+	if messages := router.drain(); len(messages) != 1 || messages[0].recipients != nil || messages[0].host != carol.GetSessionId() {
+		t.Fatalf("expected host announcement to the match, got %v", messages)
+	}
+
+	// A participant other than the host leaving changes nothing.
+	registry.Leave(matchID, []*Presence{alice})
+	expectHost(carol)
+	if messages := router.drain(); len(messages) != 0 {
+		t.Fatalf("expected no host announcement, got %v", messages)
+	}
+
+	// The oldest remaining participant replaces a host that leaves.
+	registry.Leave(matchID, []*Presence{carol})
+	expectHost(bob)
+	if messages := router.drain(); len(messages) != 1 || messages[0].host != bob.GetSessionId() {
+		t.Fatalf("expected host announcement to the match, got %v", messages)
+	}
+
+	registry.Leave(matchID, []*Presence{bob})
+	if host := registry.Host(matchID); host != nil {
+		t.Fatalf("expected no host in an empty match, got %v", host)
+	}
+}
+
+// should hand the host role over through the API, rejecting requests from anyone but the host
+func TestApiTransferMatchHost(t *testing.T) {
+	router := &matchHostTestRouter{}
+	registry := NewLocalMatchHostRegistry(zap.NewNop(), router)
+	s := &ApiServer{logger: zap.NewNop(), runtime: &Runtime{beforeReqFunctions: &RuntimeBeforeReqFunctions{}, afterReqFunctions: &RuntimeAfterReqFunctions{}}, matchHostRegistry: registry}
+	matchID := uuid.Must(uuid.NewV4())
+
+	alice := newMatchHostTestPresence(matchID)
+	bob := newMatchHostTestPresence(matchID)
+	registry.Join(matchID, []*Presence{alice, bob})
+	router.drain()
+
+	transfer := func(userID uuid.UUID, matchID, sessionID string) codes.Code {
+		ctx := context.WithValue(context.WithValue(context.WithValue(context.WithValue(context.Background(), ctxUserIDKey{}, userID), ctxUsernameKey{}, ""), ctxVarsKey{}, map[string]string{}), ctxExpiryKey{}, int64(0))
+		_, err := s.TransferMatchHost(ctx, &apigrpc.TransferMatchHostRequest{MatchId: matchID, SessionId: sessionID})
+		return status.Code(err)
+	}
+
+	if code := transfer(alice.UserID, matchID.String()+".node", bob.GetSessionId()); code != codes.InvalidArgument {
+		t.Fatalf("expected authoritative match ID to be rejected, got %v", code)
+	}
+	if code := transfer(alice.UserID, uuid.Must(uuid.NewV4()).String()+".", bob.GetSessionId()); code != codes.NotFound {
+		t.Fatalf("expected unknown match to be rejected, got %v", code)
+	}
+	if code := transfer(bob.UserID, matchID.String()+".", bob.GetSessionId()); code != codes.PermissionDenied {
+		t.Fatalf("expected transfer by a participant other than the host to be rejected, got %v", code)
+	}
+	if code := transfer(alice.UserID, matchID.String()+".", bob.GetSessionId()); code != codes.OK {
+		t.Fatalf("expected transfer by the host to succeed, got %v", code)
+	}
+	if host := registry.Host(matchID); host == nil || host.SessionID != bob.ID.SessionID {
+		t.Fatalf("expected host %v, got %v", bob.ID.SessionID, host)
+	}
+	if messages := router.drain(); len(messages) != 1 || messages[0].host != bob.GetSessionId() {
+		t.Fatalf("expected host announcement to the match, got %v", messages)
+	}
+
+	s.matchHostRegistry = nil
+	if code := transfer(bob.UserID, matchID.String()+".", alice.GetSessionId()); code != codes.FailedPrecondition {
+		t.Fatalf("expected transfer without host election to be rejected, got %v", code)
+	}
+}
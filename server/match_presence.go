@@ -22,6 +22,9 @@ import (
 	"go.uber.org/atomic"
 )
 
+// Match join metadata key that marks a join as a spectator when set to "true".
+const MatchJoinMetadataSpectator = "spectator"
+
 // Represents routing and identify information for a single match participant.
 type MatchPresence struct {
 	Node      string
@@ -29,6 +32,15 @@ type MatchPresence struct {
 	SessionID uuid.UUID
 	Username  string
 	Reason    runtime.PresenceReason
+	// Spectators receive match broadcasts but do not count towards the match size, and cannot send match data.
+	Spectator bool
+	// Set on leaves of participants who disconnected, and whose slot is held until they reconnect or the window expires.
+	Suspended bool
+	// Identifies the participant's reconnect reservation, when reconnect reservations are enabled. Reconnect tokens
+	// sent to the participant refer to it.
+	reconnectID string
+	// Tick the participant's current reconnect token was issued at.
+	reconnectIssueTick int64
 }
 
 func (p *MatchPresence) GetUserId() string {
@@ -55,6 +67,16 @@ func (p *MatchPresence) GetStatus() string {
 func (p *MatchPresence) GetReason() runtime.PresenceReason {
 	return p.Reason
 }
+func (p *MatchPresence) GetSpectator() bool {
+	return p.Spectator
+}
+func (p *MatchPresence) GetSuspended() bool {
+	return p.Suspended
+}
+
+func matchJoinSpectator(metadata map[string]string) bool {
+	return metadata[MatchJoinMetadataSpectator] == "true"
+}
 
 // Used to monitor when match presences begin and complete their match join process.
 type MatchJoinMarker struct {
@@ -105,12 +127,12 @@ func (m *MatchJoinMarkerList) ClearExpired(tick int64) []*MatchPresence {
 	return presences
 }
 
-// Maintains the match presences for routing and validation purposes.
+// Maintains the match presences for routing and validation purposes. Spectators are not counted in the list size.
 type MatchPresenceList struct {
 	sync.RWMutex
 	size            *atomic.Int32
 	presences       []*MatchPresenceListItem
-	presenceMap     map[uuid.UUID]string
+	presenceMap     map[uuid.UUID]*MatchPresence
 	presencesRead   *atomic.Value
 	presenceIDsRead *atomic.Value
 }
@@ -124,7 +146,7 @@ func NewMatchPresenceList() *MatchPresenceList {
 	m := &MatchPresenceList{
 		size:            atomic.NewInt32(0),
 		presences:       make([]*MatchPresenceListItem, 0, 10),
-		presenceMap:     make(map[uuid.UUID]string, 10),
+		presenceMap:     make(map[uuid.UUID]*MatchPresence, 10),
 		presencesRead:   &atomic.Value{},
 		presenceIDsRead: &atomic.Value{},
 	}
@@ -135,6 +157,7 @@ func NewMatchPresenceList() *MatchPresenceList {
 
 func (m *MatchPresenceList) Join(joins []*MatchPresence) []*MatchPresence {
 	processed := make([]*MatchPresence, 0, len(joins))
+	var spectators int
 	m.Lock()
 	for _, join := range joins {
 		if _, ok := m.presenceMap[join.SessionID]; !ok {
@@ -145,8 +168,11 @@ func (m *MatchPresenceList) Join(joins []*MatchPresence) []*MatchPresence {
 				},
 				Presence: join,
 			})
-			m.presenceMap[join.SessionID] = join.Node
+			m.presenceMap[join.SessionID] = join
 			processed = append(processed, join)
+			if join.Spectator {
+				spectators++
+			}
 		}
 	}
 	l := len(processed)
@@ -161,19 +187,23 @@ func (m *MatchPresenceList) Join(joins []*MatchPresence) []*MatchPresence {
 		m.presenceIDsRead.Store(presenceIDsRead)
 	}
 	m.Unlock()
-	if l != 0 {
-		m.size.Add(int32(l))
+	if l != spectators {
+		m.size.Add(int32(l - spectators))
 	}
 	return processed
 }
 
 func (m *MatchPresenceList) Leave(leaves []*MatchPresence) []*MatchPresence {
 	processed := make([]*MatchPresence, 0, len(leaves))
+	var spectators int
 	m.Lock()
 	for _, leave := range leaves {
 		if _, ok := m.presenceMap[leave.SessionID]; ok {
 			for i, presence := range m.presences {
 				if presence.PresenceID.SessionID == leave.SessionID && presence.PresenceID.Node == leave.Node {
+					if presence.Presence.Spectator {
+						spectators++
+					}
 					m.presences[i] = m.presences[len(m.presences)-1]
 					m.presences[len(m.presences)-1] = nil
 					m.presences = m.presences[:len(m.presences)-1]
@@ -196,8 +226,8 @@ func (m *MatchPresenceList) Leave(leaves []*MatchPresence) []*MatchPresence {
 		m.presenceIDsRead.Store(presenceIDsRead)
 	}
 	m.Unlock()
-	if l != 0 {
-		m.size.Sub(int32(l))
+	if l != spectators {
+		m.size.Sub(int32(l - spectators))
 	}
 	return processed
 }
@@ -205,8 +235,8 @@ func (m *MatchPresenceList) Leave(leaves []*MatchPresence) []*MatchPresence {
 func (m *MatchPresenceList) Contains(presence *PresenceID) bool {
 	var found bool
 	m.RLock()
-	if node, ok := m.presenceMap[presence.SessionID]; ok {
-		found = node == presence.Node
+	if p, ok := m.presenceMap[presence.SessionID]; ok {
+		found = p.Node == presence.Node
 	}
 	m.RUnlock()
 	return found
@@ -215,7 +245,7 @@ func (m *MatchPresenceList) Contains(presence *PresenceID) bool {
 func (m *MatchPresenceList) FilterPresenceIDs(ids []*PresenceID) []*PresenceID {
 	m.RLock()
 	for i := 0; i < len(ids); i++ {
-		if node, ok := m.presenceMap[ids[i].SessionID]; !ok || node != ids[i].Node {
+		if p, ok := m.presenceMap[ids[i].SessionID]; !ok || p.Node != ids[i].Node {
 			ids[i] = ids[len(ids)-1]
 			ids[len(ids)-1] = nil
 			ids = ids[:len(ids)-1]
@@ -226,6 +256,36 @@ func (m *MatchPresenceList) FilterPresenceIDs(ids []*PresenceID) []*PresenceID {
 	return ids
 }
 
+func (m *MatchPresenceList) Get(sessionID uuid.UUID) *MatchPresence {
+	m.RLock()
+	presence := m.presenceMap[sessionID]
+	m.RUnlock()
+	return presence
+}
+
+// FilterSpectatorIDs returns the given presence IDs that belong to spectators, without changing the given slice.
+func (m *MatchPresenceList) FilterSpectatorIDs(ids []*PresenceID) []*PresenceID {
+	spectatorIDs := make([]*PresenceID, 0, len(ids))
+	m.RLock()
+	for _, id := range ids {
+		if p, ok := m.presenceMap[id.SessionID]; ok && p.Node == id.Node && p.Spectator {
+			spectatorIDs = append(spectatorIDs, id)
+		}
+	}
+	m.RUnlock()
+	return spectatorIDs
+}
+
+// Reserve holds a slot in the list size for a participant expected to reconnect.
+func (m *MatchPresenceList) Reserve() {
+	m.size.Inc()
+}
+
+// Release frees a slot held by Reserve.
+func (m *MatchPresenceList) Release() {
+	m.size.Dec()
+}
+
 func (m *MatchPresenceList) ListPresences() []*MatchPresence {
 	return m.presencesRead.Load().([]*MatchPresence)
 }
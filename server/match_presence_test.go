@@ -62,3 +62,40 @@ func TestMatchPresenceList(t *testing.T) {
 		t.Fatalf("list size error: %+v", list.ListPresences())
 	}
 }
+
+func TestMatchPresenceListSpectators(t *testing.T) {
+	list := NewMatchPresenceList()
+
+	player := &MatchPresence{
+		Node:      "nakama",
+		UserID:    uuid.Must(uuid.NewV4()),
+		SessionID: uuid.Must(uuid.NewV4()),
+		Username:  "player",
+	}
+	spectator := &MatchPresence{
+		Node:      "nakama",
+		UserID:    uuid.Must(uuid.NewV4()),
+		SessionID: uuid.Must(uuid.NewV4()),
+		Username:  "spectator",
+		Spectator: true,
+	}
+
+	list.Join([]*MatchPresence{player, spectator})
+	if list.Size() != 1 {
+		t.Fatalf("list size error: %+v", list.ListPresences())
+	}
+	if len(list.ListPresenceIDs()) != 2 {
+		t.Fatalf("expected spectators to receive broadcasts: %+v", list.ListPresenceIDs())
+	}
+
+	// Leaves from the tracker do not necessarily carry the spectator flag.
+	list.Leave([]*MatchPresence{{Node: spectator.Node, UserID: spectator.UserID, SessionID: spectator.SessionID}})
+	if list.Size() != 1 {
+		t.Fatalf("list size error: %+v", list.ListPresences())
+	}
+
+	list.Leave([]*MatchPresence{player})
+	if list.Size() != 0 {
+		t.Fatalf("list size error: %+v", list.ListPresences())
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// MatchProfile is a snapshot of the tick performance of a running authoritative match.
+type MatchProfile struct {
+	// Time available to each tick at the match tick rate.
+	TickBudget time.Duration
+	// Number of ticks observed so far.
+	Ticks    int64
+	LastTick time.Duration
+	AvgTick  time.Duration
+	MaxTick  time.Duration
+	// Ticks that took longer than the tick budget.
+	OverrunCount        int64
+	ConsecutiveOverruns int64
+	// Queue depths observed by the most recent tick.
+	InputQueueDepth    int
+	DeferredQueueDepth int
+}
+
+// matchProfiler tracks tick execution times for a single match handler. Ticks are only ever observed from the match
+// handler goroutine, but snapshots may be taken concurrently.
+type matchProfiler struct {
+	sync.Mutex
+	logger          *zap.Logger
+	metrics         Metrics
+	handlerName     string
+	overrunLogTicks int64
+
+	profile   MatchProfile
+	totalTick time.Duration
+}
+
+func newMatchProfiler(logger *zap.Logger, metrics Metrics, handlerName string, rate int64, overrunLogTicks int) *matchProfiler {
+	return &matchProfiler{
+		logger:          logger,
+		metrics:         metrics,
+		handlerName:     handlerName,
+		overrunLogTicks: int64(overrunLogTicks),
+
+		profile: MatchProfile{TickBudget: time.Second / time.Duration(rate)},
+	}
+}
+
+func (p *matchProfiler) observe(tick int64, elapsed time.Duration, inputQueueDepth, deferredQueueDepth int) {
+	p.Lock()
+	profile := &p.profile
+	profile.Ticks++
+	profile.LastTick = elapsed
+	p.totalTick += elapsed
+	profile.AvgTick = p.totalTick / time.Duration(profile.Ticks)
+	if elapsed > profile.MaxTick {
+		profile.MaxTick = elapsed
+	}
+	overrun := elapsed > profile.TickBudget
+	if overrun {
+		profile.OverrunCount++
+		profile.ConsecutiveOverruns++
+	} else {
+		profile.ConsecutiveOverruns = 0
+	}
+	profile.InputQueueDepth = inputQueueDepth
+	profile.DeferredQueueDepth = deferredQueueDepth
+	consecutiveOverruns := profile.ConsecutiveOverruns
+	avgTick := profile.AvgTick
+	p.Unlock()
+
+	if p.metrics != nil {
+		p.metrics.MatchTick(p.handlerName, elapsed, inputQueueDepth, deferredQueueDepth, overrun)
+	}
+
+	// Log once for every run of consecutive overruns that reaches the configured length.
+	if overrun && p.overrunLogTicks > 0 && consecutiveOverruns%p.overrunLogTicks == 0 {
+		p.logger.Warn("Match handler consistently exceeding tick budget",
+			zap.Int64("tick", tick),
+			zap.Int64("consecutive_overruns", consecutiveOverruns),
+			zap.Duration("tick_budget", p.profile.TickBudget),
+			zap.Duration("last_tick", elapsed),
+			zap.Duration("avg_tick", avgTick),
+			zap.Int("input_queue_depth", inputQueueDepth),
+			zap.Int("deferred_queue_depth", deferredQueueDepth))
+	}
+}
+
+// Snapshot returns a copy of the current profile.
+func (p *matchProfiler) Snapshot() MatchProfile {
+	p.Lock()
+	profile := p.profile
+	p.Unlock()
+	return profile
+}
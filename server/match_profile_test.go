@@ -0,0 +1,111 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// should count overruns against the tick budget, and log once per run of consecutive overruns of the configured length
+func TestMatchProfilerOverruns(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	profiler := newMatchProfiler(zap.New(core), &testMetrics{}, "test", 10, 3)
+
+	// Budget at 10 ticks per second is 100ms.
+	ticks := []time.Duration{50, 150, 150, 150, 150, 150, 150, 40, 150}
+	for i, elapsed := range ticks {
+		profiler.observe(int64(i), elapsed*time.Millisecond, i, 2*i)
+	}
+
+	profile := profiler.Snapshot()
+	if profile.TickBudget != 100*time.Millisecond {
+		t.Fatalf("expected tick budget 100ms, got %v", profile.TickBudget)
+	}
+	if profile.Ticks != 9 {
+		t.Fatalf("expected 9 ticks, got %v", profile.Ticks)
+	}
+	if profile.OverrunCount != 7 {
+		t.Fatalf("expected 7 overruns, got %v", profile.OverrunCount)
+	}
+	if profile.ConsecutiveOverruns != 1 {
+		t.Fatalf("expected 1 consecutive overrun, got %v", profile.ConsecutiveOverruns)
+	}
+	if profile.LastTick != 150*time.Millisecond || profile.MaxTick != 150*time.Millisecond {
+		t.Fatalf("unexpected last or max tick: %v, %v", profile.LastTick, profile.MaxTick)
+	}
+	if profile.AvgTick != 1140*time.Millisecond/9 {
+		t.Fatalf("unexpected average tick: %v", profile.AvgTick)
+	}
+	if profile.InputQueueDepth != 8 || profile.DeferredQueueDepth != 16 {
+		t.Fatalf("unexpected queue depths: %v, %v", profile.InputQueueDepth, profile.DeferredQueueDepth)
+	}
+
+	// Six consecutive overruns with a limit of 3 should log twice.
+	if logs.Len() != 2 {
+		t.Fatalf("expected 2 overrun warnings, got %v", logs.Len())
+	}
+	for i, entry := range logs.All() {
+		if consecutive := entry.ContextMap()["consecutive_overruns"]; consecutive != int64(3*(i+1)) {
+			t.Fatalf("unexpected consecutive overruns in warning %d: %v", i, consecutive)
+		}
+	}
+}
+
+// should expose the tick profile of a running match through the registry
+func TestMatchRegistryGetProfile(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+
+	res, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matchID, err := matchUUIDFromString(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mh, _ := matchRegistry.matches.Load(matchID)
+
+	mh.queueCall(loop)
+	// Calls are processed in order, so once the state is returned the loop has run.
+	resultCh := make(chan *MatchGetStateResult, 1)
+	mh.QueueGetState(context.Background(), resultCh)
+	<-resultCh
+
+	profile, err := matchRegistry.GetProfile(matchID, "node")
+	if err != nil {
+		t.Fatalf("error getting match profile: %v", err)
+	}
+	if profile.Ticks < 1 {
+		t.Fatalf("expected at least 1 tick, got %v", profile.Ticks)
+	}
+	if profile.TickBudget != time.Second/time.Duration(mh.Rate) {
+		t.Fatalf("unexpected tick budget: %v", profile.TickBudget)
+	}
+
+	if profile, err := matchRegistry.GetProfile(matchID, "other"); profile != nil || err != nil {
+		t.Fatalf("expected no profile for a match on another node, got %v, %v", profile, err)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"go.uber.org/zap"
+)
+
+const (
+	MatchRecordingVersion   = 2
+	MatchRecordingExtension = ".nkrec"
+
+	// Match parameter that must be set to true for a match to be recorded.
+	MatchParamRecord = "record"
+)
+
+type MatchRecordEventType uint8
+
+const (
+	MatchRecordEventLoop MatchRecordEventType = iota + 1
+	MatchRecordEventJoinAttempt
+	MatchRecordEventJoin
+	MatchRecordEventLeave
+	MatchRecordEventSignal
+	MatchRecordEventTerminate
+)
+
+func (t MatchRecordEventType) String() string {
+	switch t {
+	case MatchRecordEventLoop:
+		return "loop"
+	case MatchRecordEventJoinAttempt:
+		return "join_attempt"
+	case MatchRecordEventJoin:
+		return "join"
+	case MatchRecordEventLeave:
+		return "leave"
+	case MatchRecordEventSignal:
+		return "signal"
+	case MatchRecordEventTerminate:
+		return "terminate"
+	default:
+		return "unknown"
+	}
+}
+
+// MatchRecordHeader is the first value in every match recording, and holds everything needed to re-create the match.
+type MatchRecordHeader struct {
+	Version int
+	ID      uuid.UUID
+	Node    string
+	Module  string
+	Params  map[string]interface{}
+}
+
+type MatchRecordJoinAttempt struct {
+	UserID        uuid.UUID
+	SessionID     uuid.UUID
+	Username      string
+	SessionExpiry int64
+	Vars          map[string]string
+	ClientIP      string
+	ClientPort    string
+	Node          string
+	Metadata      map[string]string
+}
+
+type MatchRecordBroadcast struct {
+	OpCode   int64
+	Data     []byte
+	Reliable bool
+	// Session ID of the sender, if any.
+	Sender string
+	// Session IDs of the recipients, empty if sent to the whole match stream.
+	Recipients []string
+}
+
+// MatchRecordEvent is a single call into the match handler, along with the outputs it produced.
+type MatchRecordEvent struct {
+	Type MatchRecordEventType
+	Tick int64
+
+	// Inputs, depending on the event type.
+	Messages     []*MatchDataMessage
+	Presences    []*MatchPresence
+	JoinAttempt  *MatchRecordJoinAttempt
+	Signal       string
+	GraceSeconds int
+
+	// Outputs.
+	Allow      bool
+	Result     string
+	Broadcasts []*MatchRecordBroadcast
+	// Snapshot of the match state after the event, if one was taken. Never taken if the match ended.
+	State    string
+	HasState bool
+}
+
+func matchRecordRequested(params map[string]interface{}) bool {
+	record, _ := params[MatchParamRecord].(bool)
+	return record
+}
+
+// MatchRecorder captures broadcasts from match handlers on their way to the wrapped message router, and attributes them
+// to match recordings in progress. Only match handlers send through it, other senders use the wrapped router directly.
+type MatchRecorder interface {
+	MessageRouter
+	// Record starts recording a match that has just completed its init.
+	Record(id uuid.UUID, node, module string, params map[string]interface{}) *MatchRecording
+}
+
+type LocalMatchRecorder struct {
+	logger *zap.Logger
+	router MessageRouter
+	dir    string
+
+	recordings *MapOf[string, *MatchRecording]
+}
+
+// NewLocalMatchRecorder creates a recorder that writes one file per match to the given directory. If the directory is
+// empty recordings are only kept in memory.
+func NewLocalMatchRecorder(logger, startupLogger *zap.Logger, router MessageRouter, dir string) MatchRecorder {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			startupLogger.Fatal("Could not create match recording directory", zap.String("dir", dir), zap.Error(err))
+		}
+	}
+
+	return &LocalMatchRecorder{
+		logger: logger,
+		router: router,
+		dir:    dir,
+
+		recordings: &MapOf[string, *MatchRecording]{},
+	}
+}
+
+func (r *LocalMatchRecorder) Record(id uuid.UUID, node, module string, params map[string]interface{}) *MatchRecording {
+	idStr := fmt.Sprintf("%v.%v", id.String(), node)
+	recording := &MatchRecording{
+		logger: r.logger.With(zap.String("mid", id.String())),
+		onClose: func() {
+			r.recordings.Delete(idStr)
+		},
+	}
+
+	if r.dir != "" {
+		path := filepath.Join(r.dir, idStr+MatchRecordingExtension)
+		file, err := os.Create(path)
+		if err != nil {
+			r.logger.Error("Could not create match recording", zap.String("path", path), zap.Error(err))
+			return nil
+		}
+		recording.closer = file
+		recording.writer = bufio.NewWriter(file)
+		recording.encoder = gob.NewEncoder(recording.writer)
+
+		if err := recording.encoder.Encode(&MatchRecordHeader{
+			Version: MatchRecordingVersion,
+			ID:      id,
+			Node:    node,
+			Module:  module,
+			Params:  params,
+		}); err != nil {
+			_ = file.Close()
+			r.logger.Error("Could not write match recording header", zap.String("path", path), zap.Error(err))
+			return nil
+		}
+	}
+
+	r.recordings.Store(idStr, recording)
+	return recording
+}
+
+func (r *LocalMatchRecorder) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) {
+	r.capture(presenceIDs, envelope, reliable)
+	r.router.SendToPresenceIDs(logger, presenceIDs, envelope, reliable)
+}
+
+func (r *LocalMatchRecorder) SendToStream(logger *zap.Logger, stream PresenceStream, envelope *rtapi.Envelope, reliable bool) {
+	r.capture(nil, envelope, reliable)
+	r.router.SendToStream(logger, stream, envelope, reliable)
+}
+
+func (r *LocalMatchRecorder) SendDeferred(logger *zap.Logger, messages []*DeferredMessage) {
+	for _, message := range messages {
+		r.capture(message.PresenceIDs, message.Envelope, message.Reliable)
+	}
+	r.router.SendDeferred(logger, messages)
+}
+
+func (r *LocalMatchRecorder) capture(presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) {
+	data := envelope.GetMatchData()
+	if data == nil {
+		return
+	}
+	recording, found := r.recordings.Load(data.MatchId)
+	if !found {
+		return
+	}
+
+	broadcast := &MatchRecordBroadcast{
+		OpCode:   data.OpCode,
+		Data:     data.Data,
+		Reliable: reliable,
+		Sender:   data.GetPresence().GetSessionId(),
+	}
+	if len(presenceIDs) != 0 {
+		broadcast.Recipients = make([]string, 0, len(presenceIDs))
+		for _, presenceID := range presenceIDs {
+			broadcast.Recipients = append(broadcast.Recipients, presenceID.SessionID.String())
+		}
+	}
+
+	recording.Lock()
+	if !recording.closed {
+		recording.broadcasts = append(recording.broadcasts, broadcast)
+	}
+	recording.Unlock()
+}
+
+// MatchRecording holds the state of a single match being recorded.
+type MatchRecording struct {
+	sync.Mutex
+	logger  *zap.Logger
+	onClose func()
+	closed  bool
+
+	// Not set if the recording is only kept in memory.
+	closer  io.Closer
+	writer  *bufio.Writer
+	encoder *gob.Encoder
+
+	// Broadcasts captured since the last event was written.
+	broadcasts []*MatchRecordBroadcast
+	// Events written to an in-memory recording.
+	events []*MatchRecordEvent
+}
+
+// Write completes the event with any broadcasts captured since the previous event, and stores it.
+func (r *MatchRecording) Write(event *MatchRecordEvent) {
+	r.Lock()
+	defer r.Unlock()
+	if r.closed {
+		return
+	}
+
+	event.Broadcasts = r.broadcasts
+	r.broadcasts = nil
+
+	if r.encoder == nil {
+		r.events = append(r.events, event)
+		return
+	}
+
+	if err := r.encoder.Encode(event); err != nil {
+		// Stop recording rather than leave a corrupt file that cannot be replayed past this point.
+		r.logger.Error("Could not write match recording event, stopping recording", zap.Error(err))
+		r.closeLocked()
+	}
+}
+
+// Events returns and clears all events stored by an in-memory recording.
+func (r *MatchRecording) Events() []*MatchRecordEvent {
+	r.Lock()
+	events := r.events
+	r.events = nil
+	r.Unlock()
+	return events
+}
+
+func (r *MatchRecording) Close() {
+	r.Lock()
+	r.closeLocked()
+	r.Unlock()
+}
+
+func (r *MatchRecording) closeLocked() {
+	if r.closed {
+		return
+	}
+	r.closed = true
+	r.onClose()
+
+	if r.closer == nil {
+		return
+	}
+	if err := r.writer.Flush(); err != nil {
+		r.logger.Error("Could not flush match recording", zap.Error(err))
+	}
+	if err := r.closer.Close(); err != nil {
+		r.logger.Error("Could not close match recording", zap.Error(err))
+	}
+}
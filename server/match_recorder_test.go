@@ -0,0 +1,301 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+type testRecordedMatchState struct {
+	Joins    int
+	Messages int
+}
+
+// testRecordedMatch keeps a state that formats the same way across runs, and counts messages by a given factor
+type testRecordedMatch struct {
+	factor int
+}
+
+func (m *testRecordedMatch) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
+	return &testRecordedMatchState{}, 1, ""
+}
+
+func (m *testRecordedMatch) MatchJoinAttempt(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presence runtime.Presence, metadata map[string]string) (interface{}, bool, string) {
+	return state, true, ""
+}
+
+func (m *testRecordedMatch) MatchJoin(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	state.(*testRecordedMatchState).Joins += len(presences)
+	return state
+}
+
+func (m *testRecordedMatch) MatchLeave(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, presences []runtime.Presence) interface{} {
+	return state
+}
+
+func (m *testRecordedMatch) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	for _, message := range messages {
+		state.(*testRecordedMatchState).Messages += m.factor
+		dispatcher.BroadcastMessage(1, message.GetData(), []runtime.Presence{message}, nil, true)
+	}
+	return state
+}
+
+func (m *testRecordedMatch) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	return state
+}
+
+func (m *testRecordedMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	return state, "signal received: " + data
+}
+
+// should record a match and replay it with no differences, unless the match handler has changed
+func TestMatchRecordingReplay(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	cfg := NewConfig(consoleLogger)
+	cfg.GetMatch().LabelUpdateIntervalMs = int(time.Hour / time.Millisecond)
+
+	var matchRegistry MatchRegistry
+	createFn := func(factor int) RuntimeMatchCreateFunction {
+		return func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error) {
+			return NewRuntimeGoMatchCore(logger, name, matchRegistry, router, id, node, "", stopped, nil, map[string]string{}, nil, &testRecordedMatch{factor: factor})
+		}
+	}
+
+	router := &testMessageRouter{}
+	recorder := NewLocalMatchRecorder(consoleLogger, consoleLogger, router, t.TempDir())
+	matchRegistry = NewLocalMatchRegistry(consoleLogger, consoleLogger, cfg, &testSessionRegistry{}, &testTracker{}, router, recorder, &testMetrics{}, "node")
+	defer matchRegistry.Stop(0)
+
+	matchID, err := matchRegistry.CreateMatch(context.Background(), createFn(1), "recorded", map[string]interface{}{"mode": "test", MatchParamRecord: true})
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	mh, found := matchRegistry.(*LocalMatchRegistry).matches.Load(uuid.FromStringOrNil(strings.Split(matchID, ".")[0]))
+	if !found {
+		t.Fatal("expected match to be registered")
+	}
+
+	presence := &MatchPresence{Node: "node", UserID: uuid.Must(uuid.NewV4()), SessionID: uuid.Must(uuid.NewV4()), Username: "a"}
+	mh.QueueJoin([]*MatchPresence{presence}, false)
+	mh.QueueData(&MatchDataMessage{UserID: presence.UserID, SessionID: presence.SessionID, Username: presence.Username, Node: presence.Node, OpCode: 1, Data: []byte("hello"), Reliable: true})
+	// Match data sent by anything other than the match handler, such as the console, is not part of the recording,
+	// otherwise the replay below would not match it.
+	router.SendToStream(consoleLogger, PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: mh.ID, Label: "node"}, &rtapi.Envelope{Message: &rtapi.Envelope_MatchData{MatchData: &rtapi.MatchData{MatchId: matchID, OpCode: 2, Data: []byte("console")}}}, true)
+	mh.queueCall(loop)
+
+	// Calls are processed in order, so once the state is returned the join and loop have been recorded.
+	stateCh := make(chan *MatchGetStateResult, 1)
+	mh.QueueGetState(context.Background(), stateCh)
+	if result := <-stateCh; result.Error != nil {
+		t.Fatalf("error getting match state: %v", result.Error)
+	}
+	signalCh := make(chan *MatchSignalResult, 1)
+	mh.QueueSignal(context.Background(), signalCh, "data")
+	if result := <-signalCh; !result.Success {
+		t.Fatal("expected match signal to succeed")
+	}
+
+	mh.Stop()
+	for i := 0; ; i++ {
+		if _, found := recorder.(*LocalMatchRecorder).recordings.Load(mh.IDStr); !found {
+			break
+		}
+		if i == 100 {
+			t.Fatal("expected match recording to be closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	replay := func(factor int) []*MatchReplayDiff {
+		file, err := os.Open(filepath.Join(recorder.(*LocalMatchRecorder).dir, mh.IDStr+MatchRecordingExtension))
+		if err != nil {
+			t.Fatalf("error opening match recording: %v", err)
+		}
+		defer file.Close()
+
+		replayRecorder := NewLocalMatchRecorder(consoleLogger, consoleLogger, &testMessageRouter{}, "")
+		diffs, err := ReplayMatch(context.Background(), consoleLogger, replayRecorder, createFn(factor), file)
+		if err != nil {
+			t.Fatalf("error replaying match: %v", err)
+		}
+		return diffs
+	}
+
+	// Replays only match if the recording holds the match handler's broadcast and nothing else.
+	if diffs := replay(1); len(diffs) != 0 {
+		t.Fatalf("expected no differences, got %v", diffs)
+	}
+
+	diffs := replay(2)
+	if len(diffs) == 0 {
+		t.Fatal("expected differences from a changed match handler")
+	}
+	if diffs[0].Type != MatchRecordEventLoop || diffs[0].Field != "state" || diffs[0].Recorded != "&{Joins:1 Messages:1}" || diffs[0].Replayed != "&{Joins:1 Messages:2}" {
+		t.Fatalf("unexpected first difference: %v", diffs[0])
+	}
+}
+
+// should replay a recording against runtime modules loaded on their own, without the server components they ran with
+func TestMatchRecordingReplayRuntime(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	cfg := NewConfig(consoleLogger)
+	cfg.GetMatch().LabelUpdateIntervalMs = int(time.Hour / time.Millisecond)
+	cfg.Runtime.Path = t.TempDir()
+	module := `
+local M = {}
+function M.match_init(context, params) return { messages = 0 }, 1, "" end
+function M.match_join_attempt(context, dispatcher, tick, state, presence, metadata) return state, true end
+function M.match_join(context, dispatcher, tick, state, presences) return state end
+function M.match_leave(context, dispatcher, tick, state, presences) return state end
+function M.match_loop(context, dispatcher, tick, state, messages)
+	for _, message in ipairs(messages) do
+		state.messages = state.messages + 1
+		dispatcher.broadcast_message(1, message.data, nil, message.sender, true)
+	end
+	dispatcher.match_label_update(tostring(state.messages))
+	return state
+end
+function M.match_terminate(context, dispatcher, tick, state, grace_seconds) return state end
+function M.match_signal(context, dispatcher, tick, state, data) return state, data end
+return M`
+	if err := os.WriteFile(filepath.Join(cfg.Runtime.Path, "recorded.lua"), []byte(module), 0644); err != nil {
+		t.Fatalf("error writing module: %v", err)
+	}
+
+	router := &testMessageRouter{}
+	recorder := NewLocalMatchRecorder(consoleLogger, consoleLogger, router, t.TempDir())
+	matchRegistry := NewLocalMatchRegistry(consoleLogger, consoleLogger, cfg, &testSessionRegistry{}, &testTracker{}, router, recorder, &testMetrics{}, "node")
+	defer matchRegistry.Stop(0)
+	runtime, _, err := NewRuntime(context.Background(), consoleLogger, consoleLogger, nil, protojsonMarshaler, protojsonUnmarshaler, cfg, "",
+		nil, nil, nil, nil, &testSessionRegistry{}, nil, nil, matchRegistry, &testTracker{}, &testMetrics{}, nil, router, nil)
+	if err != nil {
+		t.Fatalf("error creating runtime: %v", err)
+	}
+
+	matchID, err := matchRegistry.CreateMatch(context.Background(), runtime.MatchCreateFunction(), "recorded", map[string]interface{}{MatchParamRecord: true})
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	mh, found := matchRegistry.(*LocalMatchRegistry).matches.Load(uuid.FromStringOrNil(strings.Split(matchID, ".")[0]))
+	if !found {
+		t.Fatal("expected match to be registered")
+	}
+
+	presence := &MatchPresence{Node: "node", UserID: uuid.Must(uuid.NewV4()), SessionID: uuid.Must(uuid.NewV4()), Username: "a"}
+	mh.QueueJoin([]*MatchPresence{presence}, false)
+	mh.QueueData(&MatchDataMessage{UserID: presence.UserID, SessionID: presence.SessionID, Username: presence.Username, Node: presence.Node, OpCode: 1, Data: []byte("hello"), Reliable: true})
+	mh.queueCall(loop)
+	stateCh := make(chan *MatchGetStateResult, 1)
+	mh.QueueGetState(context.Background(), stateCh)
+	if result := <-stateCh; result.Error != nil {
+		t.Fatalf("error getting match state: %v", result.Error)
+	}
+
+	mh.Stop()
+	for i := 0; ; i++ {
+		if _, found := recorder.(*LocalMatchRecorder).recordings.Load(mh.IDStr); !found {
+			break
+		}
+		if i == 100 {
+			t.Fatal("expected match recording to be closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	file, err := os.Open(filepath.Join(recorder.(*LocalMatchRecorder).dir, mh.IDStr+MatchRecordingExtension))
+	if err != nil {
+		t.Fatalf("error opening match recording: %v", err)
+	}
+	defer file.Close()
+	diffs, err := ReplayMatchRecording(context.Background(), consoleLogger, protojsonMarshaler, protojsonUnmarshaler, cfg, "", file)
+	if err != nil {
+		t.Fatalf("error replaying match: %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Fatalf("expected no differences, got %v", diffs)
+	}
+}
+
+// should only record matches that ask for it, and snapshot state on loop ticks at the configured interval
+func TestMatchRecordingOptInInterval(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	cfg := NewConfig(consoleLogger)
+	cfg.GetMatch().LabelUpdateIntervalMs = int(time.Hour / time.Millisecond)
+	cfg.GetMatch().RecordStateIntervalMs = 3000
+
+	recorder := NewLocalMatchRecorder(consoleLogger, consoleLogger, &testMessageRouter{}, "")
+	matchRegistry := NewLocalMatchRegistry(consoleLogger, consoleLogger, cfg, &testSessionRegistry{}, &testTracker{}, recorder, recorder, &testMetrics{}, "node")
+	defer matchRegistry.Stop(0)
+
+	newMatch := func(params map[string]interface{}) *MatchHandler {
+		id := uuid.Must(uuid.NewV4())
+		stopped := atomic.NewBool(false)
+		core, err := NewRuntimeGoMatchCore(consoleLogger, "recorded", matchRegistry, recorder, id, "node", "", stopped, nil, map[string]string{}, nil, &testRecordedMatch{factor: 1})
+		if err != nil {
+			t.Fatalf("error creating match core: %v", err)
+		}
+		mh, err := NewSteppedMatchHandler(consoleLogger, cfg, &testSessionRegistry{}, matchRegistry, recorder, recorder, &testMetrics{}, core, id, "node", stopped, params)
+		if err != nil {
+			t.Fatalf("error creating match: %v", err)
+		}
+		return mh
+	}
+
+	unrecorded := newMatch(map[string]interface{}{})
+	defer unrecorded.Stop()
+	if unrecorded.recording != nil {
+		t.Fatal("expected match without the record parameter not to be recorded")
+	}
+
+	mh := newMatch(map[string]interface{}{MatchParamRecord: true})
+	defer mh.Stop()
+	if mh.recording == nil {
+		t.Fatal("expected match with the record parameter to be recorded")
+	}
+
+	presence := &MatchPresence{Node: "node", UserID: uuid.Must(uuid.NewV4()), SessionID: uuid.Must(uuid.NewV4()), Username: "a"}
+	mh.QueueJoin([]*MatchPresence{presence}, false)
+	for i := 0; i < 4; i++ {
+		mh.QueueLoop()
+	}
+	stateCh := make(chan *MatchGetStateResult, 1)
+	mh.QueueGetState(context.Background(), stateCh)
+	<-stateCh
+
+	events := mh.recording.Events()
+	if len(events) != 5 {
+		t.Fatalf("expected 5 recorded events, got %v", len(events))
+	}
+	for _, event := range events {
+		// A tick rate of 1 and a 3 second interval snapshot state every 3 loop ticks, other events always have it.
+		expected := event.Type != MatchRecordEventLoop || event.Tick%3 == 0
+		if event.HasState != expected || (event.State != "") != expected {
+			t.Fatalf("unexpected state snapshot for %v event at tick %v: %q", event.Type, event.Tick, event.State)
+		}
+	}
+}
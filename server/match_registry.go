@@ -17,10 +17,13 @@ package server
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"encoding/base64"
 	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -33,6 +36,7 @@ import (
 	"github.com/heroiclabs/nakama-common/runtime"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -55,8 +59,25 @@ var (
 	MatchFilterRelayed = map[uint8]*uint8{StreamModeMatchRelayed: MatchFilterPtr}
 
 	MatchLabelMaxBytes = 2048
+
+	ErrMatchListSortInvalid   = errors.New("match list sort fields must be create_time, tick_rate, handler_name or label fields")
+	ErrMatchListCursorInvalid = errors.New("match list cursor invalid")
 )
 
+// Index fields, other than label fields, that match listings may be sorted by.
+var matchListSortFields = map[string]struct{}{
+	"create_time":  {},
+	"tick_rate":    {},
+	"handler_name": {},
+}
+
+type matchListCursor struct {
+	// Sort order the cursor was created for, including the final tie breaker.
+	Sort []string
+	// Sort values of the last match on the previous page.
+	After [][]byte
+}
+
 type MatchIndexEntry struct {
 	Node        string                 `json:"node"`
 	Label       map[string]interface{} `json:"label"`
@@ -98,14 +119,19 @@ type MatchRegistry interface {
 	UpdateMatchLabel(id uuid.UUID, tickRate int, handlerName, label string, createTime int64) error
 	// List (and optionally filter) currently running matches.
 	// This can list across both authoritative and relayed matches.
-	ListMatches(ctx context.Context, limit int, authoritative *wrapperspb.BoolValue, label *wrapperspb.StringValue, minSize *wrapperspb.Int32Value, maxSize *wrapperspb.Int32Value, query *wrapperspb.StringValue, node *wrapperspb.StringValue) ([]*api.Match, []string, error)
+	ListMatches(ctx context.Context, limit int, authoritative *wrapperspb.BoolValue, label *wrapperspb.StringValue, minSize *wrapperspb.Int32Value, maxSize *wrapperspb.Int32Value, query *wrapperspb.StringValue, node *wrapperspb.StringValue, sort []string, cursor string) ([]*api.Match, []string, string, error)
 	// Stop the match registry and close all matches it's tracking.
 	Stop(graceSeconds int) chan struct{}
+	// Re-create the matches this node snapshotted when it last stopped, and notify their participants they can rejoin.
+	// Returns the number of matches restored.
+	RestoreMatches(ctx context.Context, db *sql.DB, createFn RuntimeMatchCreateFunction) int
 	// Returns the total number of currently active authoritative matches.
 	Count() int
 
 	// Pass a user join attempt to a match handler. Returns if the match was found, if the join was accepted, if it's a new user for this match, a reason for any rejection, the match label, and the list of existing match participants.
 	JoinAttempt(ctx context.Context, id uuid.UUID, node string, userID, sessionID uuid.UUID, username string, sessionExpiry int64, vars map[string]string, clientIP, clientPort, fromNode string, metadata map[string]string) (bool, bool, bool, string, string, []*MatchPresence)
+	// Pass a reconnect to a match handler, restoring a disconnected user's reserved slot without a new join attempt. Returns the same values as JoinAttempt.
+	Reconnect(ctx context.Context, id uuid.UUID, node string, token string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, bool, string, string, []*MatchPresence)
 	// Notify a match handler that one or more users have successfully joined the match.
 	// Expects that the caller has already determined the match is hosted on the current node.
 	Join(id uuid.UUID, presences []*MatchPresence)
@@ -121,6 +147,26 @@ type MatchRegistry interface {
 	Signal(ctx context.Context, id, data string) (string, error)
 	// Get a snapshot of the match state in a string representation.
 	GetState(ctx context.Context, id uuid.UUID, node string) ([]*rtapi.UserPresence, int64, string, error)
+	// Get a snapshot of the match tick performance. Returns nil if the match is not hosted on the current node.
+	GetProfile(id uuid.UUID, node string) (*MatchProfile, error)
+	// Terminate a single match, as happens to all matches on shutdown. The match is stopped once the grace period expires.
+	Terminate(id uuid.UUID, node string, graceSeconds int) error
+
+	// Set the matchmaker used to fill open slots in running authoritative matches.
+	SetMatchmaker(matchmaker Matchmaker)
+	// Set the peer used to reach matches hosted on other nodes.
+	SetPeer(peer MatchRegistryPeer)
+	// Ask the matchmaker for players to fill open slots in a running authoritative match. Returns the backfill ticket.
+	MatchmakerBackfillAdd(ctx context.Context, id, queue, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, error)
+	// Withdraw a backfill request made by a running authoritative match.
+	MatchmakerBackfillRemove(ctx context.Context, id, ticket string) error
+}
+
+// MatchRegistryPeer passes match calls to the match registry of the node hosting the match, in deployments where
+// matches are spread across more than one node.
+type MatchRegistryPeer interface {
+	// Pass a reconnect to the match registry on the given node. Returns the same values as MatchRegistry Reconnect.
+	Reconnect(ctx context.Context, id uuid.UUID, node string, token string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, bool, string, string, []*MatchPresence)
 }
 
 type LocalMatchRegistry struct {
@@ -129,7 +175,10 @@ type LocalMatchRegistry struct {
 	sessionRegistry SessionRegistry
 	tracker         Tracker
 	router          MessageRouter
+	recorder        MatchRecorder
 	metrics         Metrics
+	matchmaker      Matchmaker
+	peer            MatchRegistryPeer
 	node            string
 
 	ctx         context.Context
@@ -144,9 +193,17 @@ type LocalMatchRegistry struct {
 
 	stopped   *atomic.Bool
 	stoppedCh chan struct{}
+
+	snapshotOnce *sync.Once
 }
 
-func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, sessionRegistry SessionRegistry, tracker Tracker, router MessageRouter, metrics Metrics, node string) MatchRegistry {
+func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, sessionRegistry SessionRegistry, tracker Tracker, router MessageRouter, recorder MatchRecorder, metrics Metrics, node string) MatchRegistry {
+
+	if dir := config.GetMatch().SnapshotDir; dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			startupLogger.Fatal("Could not create match snapshot directory", zap.String("dir", dir), zap.Error(err))
+		}
+	}
 
 	cfg := BlugeInMemoryConfig()
 	indexWriter, err := bluge.OpenWriter(cfg)
@@ -162,6 +219,7 @@ func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, ses
 		sessionRegistry: sessionRegistry,
 		tracker:         tracker,
 		router:          router,
+		recorder:        recorder,
 		metrics:         metrics,
 		node:            node,
 
@@ -177,6 +235,8 @@ func NewLocalMatchRegistry(logger, startupLogger *zap.Logger, config Config, ses
 
 		stopped:   atomic.NewBool(false),
 		stoppedCh: make(chan struct{}, 2),
+
+		snapshotOnce: &sync.Once{},
 	}
 
 	go func() {
@@ -225,6 +285,18 @@ func (r *LocalMatchRegistry) processLabelUpdates(batch *index.Batch) {
 }
 
 func (r *LocalMatchRegistry) CreateMatch(ctx context.Context, createFn RuntimeMatchCreateFunction, module string, params map[string]interface{}) (string, error) {
+	// Matches created by a matchmaker matched hook are told the region chosen for the matched players.
+	if region, ok := ctx.Value(RUNTIME_CTX_MATCHMAKER_REGION).(string); ok && region != "" {
+		if _, found := params[RUNTIME_CTX_MATCHMAKER_REGION]; !found {
+			regionParams := make(map[string]interface{}, len(params)+1)
+			for k, v := range params {
+				regionParams[k] = v
+			}
+			regionParams[RUNTIME_CTX_MATCHMAKER_REGION] = region
+			params = regionParams
+		}
+	}
+
 	buf := &bytes.Buffer{}
 	if err := gob.NewEncoder(buf).Encode(params); err != nil {
 		return "", runtime.ErrCannotEncodeParams
@@ -237,7 +309,7 @@ func (r *LocalMatchRegistry) CreateMatch(ctx context.Context, createFn RuntimeMa
 	matchLogger := r.logger.With(zap.String("mid", id.String()))
 	stopped := atomic.NewBool(false)
 
-	core, err := createFn(ctx, matchLogger, id, r.node, stopped, module)
+	core, err := createFn(ctx, matchLogger, id, r.node, stopped, r.matchRouter(), module)
 	if err != nil {
 		return "", err
 	}
@@ -255,12 +327,26 @@ func (r *LocalMatchRegistry) CreateMatch(ctx context.Context, createFn RuntimeMa
 }
 
 func (r *LocalMatchRegistry) NewMatch(logger *zap.Logger, id uuid.UUID, core RuntimeMatchCore, stopped *atomic.Bool, params map[string]interface{}) (*MatchHandler, error) {
+	return r.startMatch(logger, id, core, stopped, params, nil)
+}
+
+// The message router for match handlers. When matches may be recorded they send through the recorder, so only
+// broadcasts from the match handlers themselves are captured.
+func (r *LocalMatchRegistry) matchRouter() MessageRouter {
+	if r.recorder != nil {
+		return r.recorder
+	}
+	return r.router
+}
+
+// Start a match handler and register it, restoring its state if it's being re-created from a snapshot.
+func (r *LocalMatchRegistry) startMatch(logger *zap.Logger, id uuid.UUID, core RuntimeMatchCore, stopped *atomic.Bool, params map[string]interface{}, snapshot *MatchStateSnapshot) (*MatchHandler, error) {
 	if r.stopped.Load() {
 		// Server is shutting down, reject new matches.
 		return nil, errors.New("shutdown in progress")
 	}
 
-	match, err := NewMatchHandler(logger, r.config, r.sessionRegistry, r, r.router, core, id, r.node, stopped, params)
+	match, err := newMatchHandler(logger, r.config, r.sessionRegistry, r, r.matchRouter(), r.recorder, r.metrics, core, id, r.node, stopped, params, snapshot, true)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +410,10 @@ func (r *LocalMatchRegistry) RemoveMatch(id uuid.UUID, stream PresenceStream) {
 	r.tracker.UntrackByStream(stream)
 
 	idStr := fmt.Sprintf("%v.%v", id.String(), r.node)
+	if r.matchmaker != nil {
+		// Any players still being sought for this match are no longer needed.
+		_ = r.matchmaker.RemoveBackfillAll(idStr)
+	}
 	r.pendingUpdatesMutex.Lock()
 	r.pendingUpdates[idStr] = nil
 	r.pendingUpdatesMutex.Unlock()
@@ -365,14 +455,25 @@ func (r *LocalMatchRegistry) UpdateMatchLabel(id uuid.UUID, tickRate int, handle
 	return nil
 }
 
-func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authoritative *wrapperspb.BoolValue, label *wrapperspb.StringValue, minSize *wrapperspb.Int32Value, maxSize *wrapperspb.Int32Value, queryString *wrapperspb.StringValue, node *wrapperspb.StringValue) ([]*api.Match, []string, error) {
+func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authoritative *wrapperspb.BoolValue, label *wrapperspb.StringValue, minSize *wrapperspb.Int32Value, maxSize *wrapperspb.Int32Value, queryString *wrapperspb.StringValue, node *wrapperspb.StringValue, sort []string, cursor string) ([]*api.Match, []string, string, error) {
 	if limit == 0 {
-		return make([]*api.Match, 0), make([]string, 0), nil
+		return make([]*api.Match, 0), make([]string, 0), "", nil
+	}
+
+	if err := validateMatchListSort(sort); err != nil {
+		return nil, nil, "", err
+	}
+	var incomingCursor *matchListCursor
+	if cursor != "" {
+		var err error
+		if incomingCursor, err = decodeMatchListCursor(cursor); err != nil {
+			return nil, nil, "", err
+		}
 	}
 
 	indexReader, err := r.indexWriter.Reader()
 	if err != nil {
-		return nil, nil, fmt.Errorf("error accessing index reader: %v", err.Error())
+		return nil, nil, "", fmt.Errorf("error accessing index reader: %v", err.Error())
 	}
 	defer func() {
 		err = indexReader.Close()
@@ -381,21 +482,30 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 		}
 	}()
 
+	// Relayed matches have no labels to sort on and are not part of the index, so they are not listed when a sort order
+	// is requested or when continuing from a cursor.
+	paged := len(sort) != 0 || incomingCursor != nil
+	if paged && authoritative != nil && !authoritative.Value {
+		return make([]*api.Match, 0), make([]string, 0), "", nil
+	}
+
 	var allowRelayed bool
+	var order []string
 	var labelResults *BlugeResult
 	if queryString != nil {
 		if authoritative != nil && !authoritative.Value {
 			// A filter on query is requested but authoritative matches are not allowed.
-			return make([]*api.Match, 0), make([]string, 0), nil
+			return make([]*api.Match, 0), make([]string, 0), "", nil
 		}
 
 		// If there are filters other than query, we don't know which matches will work so get more than the limit.
-		count := limit
+		// Otherwise get one more than the limit to find out if there is a next page.
+		count := limit + 1
 		if minSize != nil || maxSize != nil {
 			count = int(r.matchCount.Load())
 		}
 		if count == 0 {
-			return make([]*api.Match, 0), make([]string, 0), nil
+			return make([]*api.Match, 0), make([]string, 0), "", nil
 		}
 
 		// Apply the query filter to the set of known match labels.
@@ -405,7 +515,7 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 		} else {
 			parsed, err := ParseQueryString(queryString)
 			if err != nil {
-				return nil, nil, fmt.Errorf("error parsing query string: %v", err.Error())
+				return nil, nil, "", fmt.Errorf("error parsing query string: %v", err.Error())
 			}
 			q = parsed
 		}
@@ -419,11 +529,13 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 		}
 
 		searchReq := bluge.NewTopNSearch(count, q)
-		searchReq.SortBy([]string{"-_score", "-create_time"})
+		if order, err = sortMatchListSearch(searchReq, sort, []string{"-_score", "-create_time"}, incomingCursor); err != nil {
+			return nil, nil, "", err
+		}
 
 		labelResultsItr, err := indexReader.Search(ctx, searchReq)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error listing matches by query: %v", err.Error())
+			return nil, nil, "", fmt.Errorf("error listing matches by query: %v", err.Error())
 		}
 		labelResults, err = IterateBlugeMatches(labelResultsItr,
 			map[string]struct{}{
@@ -433,21 +545,22 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 				"node":         {},
 			}, r.logger)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error iterating bluge matches: %v", err.Error())
+			return nil, nil, "", fmt.Errorf("error iterating bluge matches: %v", err.Error())
 		}
 	} else if label != nil {
 		if authoritative != nil && !authoritative.Value {
 			// A filter on label is requested but authoritative matches are not allowed.
-			return make([]*api.Match, 0), make([]string, 0), nil
+			return make([]*api.Match, 0), make([]string, 0), "", nil
 		}
 
 		// If there are filters other than label, we don't know which matches will work so get more than the limit.
-		count := limit
+		// Otherwise get one more than the limit to find out if there is a next page.
+		count := limit + 1
 		if minSize != nil || maxSize != nil {
 			count = int(r.matchCount.Load())
 		}
 		if count == 0 {
-			return make([]*api.Match, 0), make([]string, 0), nil
+			return make([]*api.Match, 0), make([]string, 0), "", nil
 		}
 
 		// Apply the label filter to the set of known match labels.
@@ -455,11 +568,13 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 		indexQuery.SetField("label_string")
 		//indexQuery.SetAnalyzer(BlugeKeywordAnalyzer)
 		searchReq := bluge.NewTopNSearch(count, indexQuery)
-		searchReq.SortBy([]string{"-create_time"})
+		if order, err = sortMatchListSearch(searchReq, sort, []string{"-create_time"}, incomingCursor); err != nil {
+			return nil, nil, "", err
+		}
 
 		labelResultsItr, err := indexReader.Search(ctx, searchReq)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error listing matches by label: %v", err.Error())
+			return nil, nil, "", fmt.Errorf("error listing matches by label: %v", err.Error())
 		}
 		labelResults, err = IterateBlugeMatches(labelResultsItr,
 			map[string]struct{}{
@@ -469,17 +584,17 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 				"node":         {},
 			}, r.logger)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error iterating bluge matches: %v", err.Error())
+			return nil, nil, "", fmt.Errorf("error iterating bluge matches: %v", err.Error())
 		}
 	} else if authoritative == nil || authoritative.Value {
 		// Not using label/query filter but we still need access to the indexed labels to return them
 		// if authoritative matches may be included in the results.
-		count := limit
+		count := limit + 1
 		if minSize != nil || maxSize != nil {
 			count = int(r.matchCount.Load())
 		}
-		if count == 0 && authoritative != nil && authoritative.Value {
-			return make([]*api.Match, 0), make([]string, 0), nil
+		if count == 0 && ((authoritative != nil && authoritative.Value) || paged) {
+			return make([]*api.Match, 0), make([]string, 0), "", nil
 		}
 
 		var q bluge.Query = bluge.NewMatchAllQuery()
@@ -492,11 +607,13 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 			q = multiQuery
 		}
 		searchReq := bluge.NewTopNSearch(count, q)
-		searchReq.SortBy([]string{"-create_time"})
+		if order, err = sortMatchListSearch(searchReq, sort, []string{"-create_time"}, incomingCursor); err != nil {
+			return nil, nil, "", err
+		}
 
 		labelResultsItr, err := indexReader.Search(ctx, searchReq)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error listing matches by label: %v", err.Error())
+			return nil, nil, "", fmt.Errorf("error listing matches by label: %v", err.Error())
 		}
 		labelResults, err = IterateBlugeMatches(labelResultsItr,
 			map[string]struct{}{
@@ -506,10 +623,10 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 				"node":         {},
 			}, r.logger)
 		if err != nil {
-			return nil, nil, fmt.Errorf("error iterating bluge matches: %v", err.Error())
+			return nil, nil, "", fmt.Errorf("error iterating bluge matches: %v", err.Error())
 		}
 
-		if authoritative == nil {
+		if authoritative == nil && !paged {
 			// Expect a possible mix of authoritative and relayed matches.
 			allowRelayed = true
 		}
@@ -520,7 +637,7 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 
 	if labelResults != nil && len(labelResults.Hits) == 0 && authoritative != nil && !authoritative.Value {
 		// No results based on label/query, no point in further filtering by size.
-		return make([]*api.Match, 0), make([]string, 0), nil
+		return make([]*api.Match, 0), make([]string, 0), "", nil
 	}
 
 	// Results.
@@ -529,88 +646,32 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 
 	// Use any eligible authoritative matches first.
 	if labelResults != nil {
+		var lastSortValue [][]byte
 		for _, hit := range labelResults.Hits {
-			matchIDComponents := strings.SplitN(hit.ID, ".", 2)
-			id := uuid.FromStringOrNil(matchIDComponents[0])
-
-			mh, ok := r.matches.Load(id)
+			match, node, ok := r.listMatchesHit(hit, minSize, maxSize)
 			if !ok {
 				continue
 			}
-			size := int32(mh.PresenceList.Size())
 
-			if minSize != nil && minSize.Value > size {
-				// Not eligible based on minimum size.
-				continue
-			}
-
-			if maxSize != nil && maxSize.Value < size {
-				// Not eligible based on maximum size.
-				continue
-			}
-
-			var labelString string
-			if l, ok := hit.Fields["label_string"]; ok {
-				if labelString, ok = l.(string); !ok {
-					r.logger.Warn("Field not a string in match registry label cache: label_string")
-					continue
-				}
-			} else {
-				r.logger.Warn("Field not found in match registry label cache: label_string")
-				continue
-			}
-
-			var tickRate float64
-			if tr, ok := hit.Fields["tick_rate"]; ok {
-				if tickRate, ok = tr.(float64); !ok {
-					r.logger.Warn("Field not an int in match registry label cache: tick_rate")
-					continue
-				}
-			} else {
-				r.logger.Warn("Field not found in match registry label cache: tick_rate")
-				continue
-			}
-
-			var handlerName string
-			if hn, ok := hit.Fields["handler_name"]; ok {
-				if handlerName, ok = hn.(string); !ok {
-					r.logger.Warn("Field not a string in match registry label cache: handler_name")
-					continue
+			if len(results) == limit {
+				// There is at least one more eligible match, so there is a next page.
+				nextCursor, err := encodeMatchListCursor(&matchListCursor{Sort: order, After: lastSortValue})
+				if err != nil {
+					r.logger.Error("Error creating match list cursor", zap.Error(err))
+					return nil, nil, "", err
 				}
-			} else {
-				r.logger.Warn("Field not found in match registry label cache: handler_name")
-				continue
+				return results, nodes, nextCursor, nil
 			}
 
-			var node string
-			if hn, ok := hit.Fields["node"]; ok {
-				if node, ok = hn.(string); !ok {
-					r.logger.Warn("Field not a string in match registry label cache: node")
-					continue
-				}
-			} else {
-				r.logger.Warn("Field not found in match registry label cache: node")
-				continue
-			}
-
-			results = append(results, &api.Match{
-				MatchId:       hit.ID,
-				Authoritative: true,
-				Label:         &wrapperspb.StringValue{Value: labelString},
-				Size:          size,
-				TickRate:      int32(tickRate),
-				HandlerName:   handlerName,
-			})
+			results = append(results, match)
 			nodes = append(nodes, node)
-			if len(results) == limit {
-				return results, nodes, nil
-			}
+			lastSortValue = hit.SortValue
 		}
 	}
 
-	// If relayed matches are not allowed still return any available results.
-	if !allowRelayed {
-		return results, nodes, nil
+	// If relayed matches are not allowed, or there is no more room, still return any available results.
+	if !allowRelayed || len(results) == limit {
+		return results, nodes, "", nil
 	}
 
 	matches := r.tracker.CountByStreamModeFilter(MatchFilterRelayed)
@@ -638,17 +699,160 @@ func (r *LocalMatchRegistry) ListMatches(ctx context.Context, limit int, authori
 			Size:          size,
 		})
 		if len(results) == limit {
-			return results, nodes, nil
+			return results, nodes, "", nil
 		}
 	}
 
-	return results, nodes, nil
+	return results, nodes, "", nil
+}
+
+// Check that an indexed match is still running and eligible for a listing, and build its listing entry.
+func (r *LocalMatchRegistry) listMatchesHit(hit *blugeMatch, minSize *wrapperspb.Int32Value, maxSize *wrapperspb.Int32Value) (*api.Match, string, bool) {
+	matchIDComponents := strings.SplitN(hit.ID, ".", 2)
+	id := uuid.FromStringOrNil(matchIDComponents[0])
+
+	mh, ok := r.matches.Load(id)
+	if !ok {
+		return nil, "", false
+	}
+	size := int32(mh.PresenceList.Size())
+
+	if minSize != nil && minSize.Value > size {
+		// Not eligible based on minimum size.
+		return nil, "", false
+	}
+
+	if maxSize != nil && maxSize.Value < size {
+		// Not eligible based on maximum size.
+		return nil, "", false
+	}
+
+	var labelString string
+	if l, ok := hit.Fields["label_string"]; ok {
+		if labelString, ok = l.(string); !ok {
+			r.logger.Warn("Field not a string in match registry label cache: label_string")
+			return nil, "", false
+		}
+	} else {
+		r.logger.Warn("Field not found in match registry label cache: label_string")
+		return nil, "", false
+	}
+
+	var tickRate float64
+	if tr, ok := hit.Fields["tick_rate"]; ok {
+		if tickRate, ok = tr.(float64); !ok {
+			r.logger.Warn("Field not an int in match registry label cache: tick_rate")
+			return nil, "", false
+		}
+	} else {
+		r.logger.Warn("Field not found in match registry label cache: tick_rate")
+		return nil, "", false
+	}
+
+	var handlerName string
+	if hn, ok := hit.Fields["handler_name"]; ok {
+		if handlerName, ok = hn.(string); !ok {
+			r.logger.Warn("Field not a string in match registry label cache: handler_name")
+			return nil, "", false
+		}
+	} else {
+		r.logger.Warn("Field not found in match registry label cache: handler_name")
+		return nil, "", false
+	}
+
+	var node string
+	if hn, ok := hit.Fields["node"]; ok {
+		if node, ok = hn.(string); !ok {
+			r.logger.Warn("Field not a string in match registry label cache: node")
+			return nil, "", false
+		}
+	} else {
+		r.logger.Warn("Field not found in match registry label cache: node")
+		return nil, "", false
+	}
+
+	return &api.Match{
+		MatchId:       hit.ID,
+		Authoritative: true,
+		Label:         &wrapperspb.StringValue{Value: labelString},
+		Size:          size,
+		TickRate:      int32(tickRate),
+		HandlerName:   handlerName,
+	}, node, true
+}
+
+func validateMatchListSort(sort []string) error {
+	for _, field := range sort {
+		field = strings.TrimPrefix(field, "-")
+		if _, found := matchListSortFields[field]; found {
+			continue
+		}
+		if strings.HasPrefix(field, "label.") && len(field) > len("label.") {
+			continue
+		}
+		return ErrMatchListSortInvalid
+	}
+	return nil
+}
+
+// Set the sort order on a match listing search, falling back to the given default order, and continue from the cursor
+// if there is one. Returns the complete sort order used, to be stored in any next cursor.
+func sortMatchListSearch(searchReq *bluge.TopNSearch, sort, defaultOrder []string, cursor *matchListCursor) ([]string, error) {
+	order := make([]string, 0, len(sort)+len(defaultOrder)+1)
+	if len(sort) != 0 {
+		order = append(order, sort...)
+	} else {
+		order = append(order, defaultOrder...)
+	}
+	// Match IDs are unique, so sorting by them last gives a stable order for pagination.
+	order = append(order, "_id")
+	searchReq.SortBy(order)
+
+	if cursor != nil {
+		if len(cursor.Sort) != len(order) || len(cursor.After) != len(order) {
+			return nil, ErrMatchListCursorInvalid
+		}
+		for i, field := range order {
+			if cursor.Sort[i] != field {
+				// Cursor is for a different sort order.
+				return nil, ErrMatchListCursorInvalid
+			}
+		}
+		searchReq.After(cursor.After)
+	}
+
+	return order, nil
+}
+
+func encodeMatchListCursor(cursor *matchListCursor) (string, error) {
+	cursorBuf := new(bytes.Buffer)
+	if err := gob.NewEncoder(cursorBuf).Encode(cursor); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorBuf.Bytes()), nil
+}
+
+func decodeMatchListCursor(cursor string) (*matchListCursor, error) {
+	cb, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrMatchListCursorInvalid
+	}
+	incomingCursor := &matchListCursor{}
+	if err := gob.NewDecoder(bytes.NewReader(cb)).Decode(incomingCursor); err != nil {
+		return nil, ErrMatchListCursorInvalid
+	}
+	return incomingCursor, nil
 }
 
 func (r *LocalMatchRegistry) Stop(graceSeconds int) chan struct{} {
 	// Mark the match registry as stopped, but allow further calls here to signal periodic termination to any matches still running.
 	r.stopped.Store(true)
 
+	// Persist the state of any matches that keep it across restarts, these stop without being told to terminate.
+	r.snapshotOnce.Do(func() {
+		r.snapshotMatches(graceSeconds)
+	})
+
 	// Graceful shutdown not allowed/required, or grace period has expired.
 	if graceSeconds == 0 {
 		// If grace period is 0 stop match label processing immediately.
@@ -689,6 +893,161 @@ func (r *LocalMatchRegistry) Stop(graceSeconds int) chan struct{} {
 	return r.stoppedCh
 }
 
+func (r *LocalMatchRegistry) snapshotMatches(graceSeconds int) {
+	dir := r.config.GetMatch().SnapshotDir
+	if dir == "" {
+		return
+	}
+
+	// Snapshots are taken in parallel by each match handler, and must complete within the shutdown grace period.
+	timeout := time.Duration(graceSeconds) * time.Second
+	if timeout == 0 {
+		timeout = matchSnapshotMinTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	saveFn := func(snapshot *MatchStateSnapshot) error {
+		return writeMatchSnapshot(dir, snapshot)
+	}
+
+	handlers := make([]*MatchHandler, 0, r.matchCount.Load())
+	r.matches.Range(func(id uuid.UUID, mh *MatchHandler) bool {
+		handlers = append(handlers, mh)
+		return true
+	})
+
+	resultCh := make(chan bool, len(handlers))
+	for _, mh := range handlers {
+		go func(mh *MatchHandler) {
+			savedCh := make(chan bool, 1)
+			if !mh.QueueSnapshot(saveFn, savedCh) {
+				resultCh <- false
+				return
+			}
+			select {
+			case saved := <-savedCh:
+				resultCh <- saved
+			case <-mh.stopCh:
+				// The match stopped, either after saving its snapshot or before the snapshot call was processed.
+				select {
+				case saved := <-savedCh:
+					resultCh <- saved
+				default:
+					resultCh <- false
+				}
+			}
+		}(mh)
+	}
+
+	var count int
+ResultLoop:
+	for pending := len(handlers); pending > 0; pending-- {
+		select {
+		case saved := <-resultCh:
+			if saved {
+				count++
+			}
+		case <-timer.C:
+			// Matches still taking their snapshot will save it if they get to it before they're terminated.
+			r.logger.Warn("Match snapshots not completed within the shutdown grace period", zap.Int("pending", pending))
+			break ResultLoop
+		}
+	}
+
+	if count != 0 {
+		r.logger.Info("Saved authoritative match snapshots", zap.Int("count", count))
+	}
+}
+
+func (r *LocalMatchRegistry) RestoreMatches(ctx context.Context, db *sql.DB, createFn RuntimeMatchCreateFunction) int {
+	dir := r.config.GetMatch().SnapshotDir
+	if dir == "" {
+		return 0
+	}
+
+	paths, err := listMatchSnapshots(dir, r.node)
+	if err != nil {
+		r.logger.Error("Could not list match snapshots", zap.String("dir", dir), zap.Error(err))
+		return 0
+	}
+
+	var count int
+	for _, path := range paths {
+		snapshot, err := readMatchSnapshot(path)
+		// Snapshots are only used once, a match that fails to restore is not retried on every restart.
+		if removeErr := os.Remove(path); removeErr != nil {
+			r.logger.Warn("Could not remove match snapshot", zap.String("path", path), zap.Error(removeErr))
+		}
+		if err != nil {
+			r.logger.Error("Could not read match snapshot", zap.String("path", path), zap.Error(err))
+			continue
+		}
+
+		if err := r.restoreMatch(ctx, db, createFn, snapshot); err != nil {
+			r.logger.Error("Could not restore match from snapshot", zap.String("mid", snapshot.ID.String()), zap.String("module", snapshot.Module), zap.Error(err))
+			continue
+		}
+		count++
+	}
+
+	if count != 0 {
+		r.logger.Info("Restored authoritative matches from snapshots", zap.Int("count", count))
+	}
+	return count
+}
+
+func (r *LocalMatchRegistry) restoreMatch(ctx context.Context, db *sql.DB, createFn RuntimeMatchCreateFunction, snapshot *MatchStateSnapshot) error {
+	if _, found := r.matches.Load(snapshot.ID); found {
+		return errors.New("match already running")
+	}
+
+	matchLogger := r.logger.With(zap.String("mid", snapshot.ID.String()))
+	stopped := atomic.NewBool(false)
+
+	core, err := createFn(ctx, matchLogger, snapshot.ID, r.node, stopped, r.matchRouter(), snapshot.Module)
+	if err != nil {
+		return err
+	}
+	if core == nil {
+		return errors.New("match handler not found")
+	}
+
+	mh, err := r.startMatch(matchLogger, snapshot.ID, core, stopped, snapshot.Params, snapshot)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshot.Presences) == 0 {
+		return nil
+	}
+
+	// Participants were disconnected when the match stopped, let them know it's available to rejoin.
+	content, _ := json.Marshal(map[string]string{"match_id": mh.IDStr})
+	createTime := &timestamppb.Timestamp{Seconds: time.Now().UTC().Unix()}
+	notifications := make(map[uuid.UUID][]*api.Notification, len(snapshot.Presences))
+	for _, presence := range snapshot.Presences {
+		if _, found := notifications[presence.UserID]; found {
+			continue
+		}
+		notifications[presence.UserID] = []*api.Notification{{
+			Id:         uuid.Must(uuid.NewV4()).String(),
+			Subject:    "match_restored",
+			Content:    string(content),
+			Code:       NotificationCodeMatchRestored,
+			SenderId:   "",
+			CreateTime: createTime,
+			Persistent: true,
+		}}
+	}
+	if err := NotificationSend(ctx, matchLogger, db, r.router, notifications); err != nil {
+		// The match is running, participants can still find it through match listings.
+		matchLogger.Warn("Could not notify participants of restored match", zap.Error(err))
+	}
+
+	return nil
+}
+
 func (r *LocalMatchRegistry) Count() int {
 	return int(r.matchCount.Load())
 }
@@ -728,6 +1087,44 @@ func (r *LocalMatchRegistry) JoinAttempt(ctx context.Context, id uuid.UUID, node
 	}
 }
 
+func (r *LocalMatchRegistry) Reconnect(ctx context.Context, id uuid.UUID, node string, token string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, bool, string, string, []*MatchPresence) {
+	if node != r.node {
+		// The reservation is held by the match on its own node, the user may have reconnected to any node.
+		if r.peer != nil {
+			return r.peer.Reconnect(ctx, id, node, token, userID, sessionID, username, fromNode)
+		}
+		return false, false, false, "", "", nil
+	}
+
+	mh, ok := r.matches.Load(id)
+	if !ok {
+		return false, false, false, "", "", nil
+	}
+
+	if mh.PresenceList.Contains(&PresenceID{Node: fromNode, SessionID: sessionID}) {
+		// The user is already part of this match.
+		return true, true, false, "", mh.Label(), mh.PresenceList.ListPresences()
+	}
+
+	resultCh := make(chan *MatchJoinAttemptResult, 1)
+	if !mh.QueueReconnect(ctx, resultCh, token, userID, sessionID, username, fromNode) {
+		// The match join attempt queue was full, match will not close but it can't be joined right now.
+		return true, false, false, "Match is not currently accepting join requests", "", nil
+	}
+
+	// Set up a limit to how long the reconnect will wait, default is 10 seconds.
+	timer := time.NewTimer(time.Second * 10)
+	select {
+	case <-timer.C:
+		// The reconnect has timed out, it is assumed to be rejected.
+		return true, false, false, "", "", nil
+	case r := <-resultCh:
+		// Doesn't matter if the timer has fired concurrently, we're in the desired case anyway.
+		timer.Stop()
+		return true, r.Allow, true, r.Reason, r.Label, mh.PresenceList.ListPresences()
+	}
+}
+
 func (r *LocalMatchRegistry) Join(id uuid.UUID, presences []*MatchPresence) {
 	mh, ok := r.matches.Load(id)
 	if !ok {
@@ -767,6 +1164,10 @@ func (r *LocalMatchRegistry) SendData(id uuid.UUID, node string, userID, session
 		return
 	}
 
+	if !mh.AllowData(userID, sessionID, fromNode, opCode) {
+		return
+	}
+
 	mh.QueueData(&MatchDataMessage{
 		UserID:      userID,
 		SessionID:   sessionID,
@@ -833,6 +1234,42 @@ func (r *LocalMatchRegistry) Signal(ctx context.Context, id, data string) (strin
 	}
 }
 
+func (r *LocalMatchRegistry) GetProfile(id uuid.UUID, node string) (*MatchProfile, error) {
+	if node != r.node {
+		return nil, nil
+	}
+
+	mh, ok := r.matches.Load(id)
+	if !ok {
+		return nil, runtime.ErrMatchNotFound
+	}
+
+	profile := mh.Profile()
+	return &profile, nil
+}
+
+func (r *LocalMatchRegistry) Terminate(id uuid.UUID, node string, graceSeconds int) error {
+	if node != r.node {
+		return runtime.ErrMatchNotFound
+	}
+
+	mh, ok := r.matches.Load(id)
+	if !ok {
+		return runtime.ErrMatchNotFound
+	}
+
+	if !mh.QueueTerminate(graceSeconds) {
+		// The match has already stopped, or its call queue was full and it's being stopped.
+		return runtime.ErrMatchNotFound
+	}
+	if graceSeconds > 0 {
+		// Does nothing if the match ended itself within the grace period.
+		time.AfterFunc(time.Duration(graceSeconds)*time.Second, mh.Stop)
+	}
+
+	return nil
+}
+
 func (r *LocalMatchRegistry) GetState(ctx context.Context, id uuid.UUID, node string) ([]*rtapi.UserPresence, int64, string, error) {
 	if node != r.node {
 		return nil, 0, "", nil
@@ -876,17 +1313,77 @@ func (r *LocalMatchRegistry) GetState(ctx context.Context, id uuid.UUID, node st
 	}
 }
 
+func (r *LocalMatchRegistry) SetMatchmaker(matchmaker Matchmaker) {
+	r.matchmaker = matchmaker
+}
+
+func (r *LocalMatchRegistry) SetPeer(peer MatchRegistryPeer) {
+	r.peer = peer
+}
+
+func (r *LocalMatchRegistry) MatchmakerBackfillAdd(ctx context.Context, id, queue, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, error) {
+	mh, err := r.backfillMatch(id)
+	if err != nil {
+		return "", err
+	}
+
+	// Matches created for matchmaker results are only backfilled with players who can reach their region.
+	region, _ := mh.params[RUNTIME_CTX_MATCHMAKER_REGION].(string)
+	ticket, _, err := r.matchmaker.AddBackfill(ctx, queue, mh.IDStr, region, mh.PresenceList.ListPresences, query, count, stringProperties, numericProperties)
+	return ticket, err
+}
+
+func (r *LocalMatchRegistry) MatchmakerBackfillRemove(ctx context.Context, id, ticket string) error {
+	mh, err := r.backfillMatch(id)
+	if err != nil {
+		return err
+	}
+
+	return r.matchmaker.RemoveBackfill(mh.IDStr, ticket)
+}
+
+// Backfill is only available to authoritative matches running on this node.
+func (r *LocalMatchRegistry) backfillMatch(id string) (*MatchHandler, error) {
+	if r.matchmaker == nil {
+		return nil, runtime.ErrMatchmakerNotAvailable
+	}
+
+	idComponents := strings.SplitN(id, ".", 2)
+	if len(idComponents) != 2 || idComponents[1] == "" {
+		return nil, runtime.ErrMatchIdInvalid
+	}
+	matchID, err := uuid.FromString(idComponents[0])
+	if err != nil {
+		return nil, runtime.ErrMatchIdInvalid
+	}
+	if idComponents[1] != r.node {
+		return nil, runtime.ErrMatchNotFound
+	}
+
+	mh, ok := r.matches.Load(matchID)
+	if !ok {
+		return nil, runtime.ErrMatchNotFound
+	}
+	return mh, nil
+}
+
 func MapMatchIndexEntry(id string, in *MatchIndexEntry) (*bluge.Document, error) {
 	rv := bluge.NewDocument(id)
 
 	rv.AddField(bluge.NewKeywordField("node", in.Node).StoreValue())
 	rv.AddField(bluge.NewKeywordField("label_string", in.LabelString).StoreValue())
 	rv.AddField(bluge.NewNumericField("tick_rate", float64(in.TickRate)).StoreValue())
-	rv.AddField(bluge.NewKeywordField("handler_name", in.HandlerName).StoreValue())
+	rv.AddField(bluge.NewKeywordField("handler_name", in.HandlerName).StoreValue().Sortable())
 	rv.AddField(bluge.NewNumericField("create_time", float64(in.CreateTime)).StoreValue())
 
 	if in.Label != nil {
 		BlugeWalkDocument(in.Label, []string{"label"}, rv)
+		// Keep label fields sortable so match listings can be ordered by them.
+		for _, field := range *rv {
+			if termField, ok := field.(*bluge.TermField); ok {
+				termField.Sortable()
+			}
+		}
 	}
 
 	return rv, nil
@@ -18,12 +18,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/blugelabs/bluge"
 	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -138,6 +143,294 @@ func TestMatchRegistryAuthoritativeMatchAndJoin(t *testing.T) {
 	}
 }
 
+// should terminate a single match, stopping it once the grace period expires
+func TestMatchRegistryTerminate(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+
+	res, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matchID, err := matchUUIDFromString(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := matchRegistry.Terminate(matchID, "other", 1); err != runtime.ErrMatchNotFound {
+		t.Fatalf("expected match on another node not to be found, got %v", err)
+	}
+	if err := matchRegistry.Terminate(matchID, "node", 1); err != nil {
+		t.Fatalf("error terminating match: %v", err)
+	}
+
+	// The test match handler keeps running when terminated, so it's stopped when the grace period expires.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if match, _, _ := matchRegistry.GetMatch(context.Background(), res); match == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected match to stop after the grace period")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if match, _, _ := matchRegistry.GetMatch(context.Background(), other); match == nil {
+		t.Fatalf("expected other match to keep running")
+	}
+	if err := matchRegistry.Terminate(matchID, "node", 0); err != runtime.ErrMatchNotFound {
+		t.Fatalf("expected stopped match not to be found, got %v", err)
+	}
+}
+
+// should hold the slot of a disconnected user, and restore it on reconnect without a join attempt
+func TestMatchRegistryAuthoritativeMatchReconnect(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+	matchRegistry.config.GetMatch().ReconnectWindowSec = 10
+
+	// Reconnect tokens are sent to participants as notifications.
+	tokenCh := make(chan string, 10)
+	matchRegistry.router.(*testMessageRouter).sendToPresence = func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		for _, notification := range envelope.GetNotifications().GetNotifications() {
+			if notification.Code != NotificationCodeMatchReconnect {
+				continue
+			}
+			var content map[string]interface{}
+			if err := json.Unmarshal([]byte(notification.Content), &content); err != nil {
+				t.Errorf("error decoding reconnect notification: %v", err)
+			}
+			tokenCh <- presences[0].SessionID.String() + " " + content["token"].(string)
+		}
+	}
+	receiveToken := func(sessionID uuid.UUID) string {
+		select {
+		case token := <-tokenCh:
+			if !strings.HasPrefix(token, sessionID.String()+" ") {
+				t.Fatalf("expected reconnect token for session %v, got %v", sessionID, token)
+			}
+			return strings.TrimPrefix(token, sessionID.String()+" ")
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected reconnect token for session %v", sessionID)
+		}
+		return ""
+	}
+
+	res, err := matchRegistry.CreateMatch(context.Background(),
+		runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matchID, err := matchUUIDFromString(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mh, _ := matchRegistry.matches.Load(matchID)
+
+	// Calls are processed in order, so once the state is returned all previously queued calls are done.
+	waitForCalls := func() {
+		resultCh := make(chan *MatchGetStateResult, 1)
+		mh.QueueGetState(context.Background(), resultCh)
+		<-resultCh
+	}
+
+	userID, _ := uuid.NewV4()
+	sessionID, _ := uuid.NewV4()
+	_, accepted, _, _, _, _ := matchRegistry.JoinAttempt(context.Background(), matchID, "node", userID,
+		sessionID, "username", 0, map[string]string{}, "clientIP", "clientPort",
+		"fromNode", map[string]string{})
+	if !accepted {
+		t.Fatalf("expected join to be accepted, was not")
+	}
+	waitForCalls()
+
+	token := receiveToken(sessionID)
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(matchRegistry.config.GetSession().EncryptionKey), nil
+	}); err != nil {
+		t.Fatalf("error parsing reconnect token: %v", err)
+	}
+	if exp, _ := claims["exp"].(float64); int64(exp) > time.Now().Add(20*time.Second).Unix() || int64(exp) < time.Now().Add(10*time.Second).Unix() {
+		t.Fatalf("expected reconnect token to expire after twice the reconnect window, got %v", exp)
+	}
+
+	matchRegistry.Leave(matchID, []*MatchPresence{{Node: "fromNode", UserID: userID, SessionID: sessionID, Username: "username", Reason: runtime.PresenceReasonDisconnect}})
+	waitForCalls()
+
+	if mh.PresenceList.Size() != 1 || len(mh.PresenceList.ListPresences()) != 0 {
+		t.Fatalf("expected slot to be held for disconnected user, size %v presences %+v", mh.PresenceList.Size(), mh.PresenceList.ListPresences())
+	}
+
+	otherUserID, _ := uuid.NewV4()
+	otherSessionID, _ := uuid.NewV4()
+	_, accepted, _, _, _, _ = matchRegistry.Reconnect(context.Background(), matchID, "node", token, otherUserID, otherSessionID, "other", "fromNode")
+	if accepted {
+		t.Fatalf("expected reconnect by another user to be rejected")
+	}
+
+	claims["exp"] = time.Now().Add(-time.Second).Unix()
+	expiredToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(matchRegistry.config.GetSession().EncryptionKey))
+	newSessionID, _ := uuid.NewV4()
+	_, accepted, _, _, _, _ = matchRegistry.Reconnect(context.Background(), matchID, "node", expiredToken, userID, newSessionID, "username", "fromNode")
+	if accepted {
+		t.Fatalf("expected reconnect with expired token to be rejected")
+	}
+
+	_, accepted, _, _, _, _ = matchRegistry.Reconnect(context.Background(), matchID, "node", token, userID, newSessionID, "username", "fromNode")
+	if !accepted {
+		t.Fatalf("expected reconnect to be accepted, was not")
+	}
+	waitForCalls()
+
+	presences := mh.PresenceList.ListPresences()
+	if mh.PresenceList.Size() != 1 || len(presences) != 1 || presences[0].SessionID != newSessionID {
+		t.Fatalf("expected presence to be restored, size %v presences %+v", mh.PresenceList.Size(), presences)
+	}
+	// The restored presence is sent a token for the same reservation.
+	receiveToken(newSessionID)
+
+	_, accepted, _, _, _, _ = matchRegistry.Reconnect(context.Background(), matchID, "node", token, userID, newSessionID, "username", "otherNode")
+	if accepted {
+		t.Fatalf("expected used reconnect token to be rejected")
+	}
+}
+
+// should hold the slot of a disconnected user for the whole reconnect window, however long ago their token was sent
+func TestMatchRegistryAuthoritativeMatchReconnectWindow(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+	matchRegistry.config.GetMatch().ReconnectWindowSec = 10
+
+	tokenCh := make(chan string, 10)
+	matchRegistry.router.(*testMessageRouter).sendToPresence = func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		for _, notification := range envelope.GetNotifications().GetNotifications() {
+			var content map[string]interface{}
+			if err := json.Unmarshal([]byte(notification.Content), &content); err != nil {
+				t.Errorf("error decoding reconnect notification: %v", err)
+			}
+			tokenCh <- content["token"].(string)
+		}
+	}
+
+	res, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matchID, err := matchUUIDFromString(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mh, _ := matchRegistry.matches.Load(matchID)
+
+	// Calls are processed in order, and between ticks.
+	call := func(f func(mh *MatchHandler)) {
+		doneCh := make(chan struct{})
+		mh.queueCall(func(mh *MatchHandler) {
+			f(mh)
+			close(doneCh)
+		})
+		<-doneCh
+	}
+
+	userID, _ := uuid.NewV4()
+	sessionID, _ := uuid.NewV4()
+	_, accepted, _, _, _, _ := matchRegistry.JoinAttempt(context.Background(), matchID, "node", userID,
+		sessionID, "username", 0, map[string]string{}, "clientIP", "clientPort",
+		"fromNode", map[string]string{})
+	if !accepted {
+		t.Fatalf("expected join to be accepted, was not")
+	}
+	var token string
+	select {
+	case token = <-tokenCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected reconnect token")
+	}
+
+	// Disconnect a tick before the token would be sent again, so it was sent almost half a window ago.
+	call(func(mh *MatchHandler) {
+		mh.PresenceList.Get(sessionID).reconnectIssueTick = mh.tick - mh.reconnectWindowTicks/2 + 1
+	})
+	matchRegistry.Leave(matchID, []*MatchPresence{{Node: "fromNode", UserID: userID, SessionID: sessionID, Username: "username", Reason: runtime.PresenceReasonDisconnect}})
+
+	// Over half a window after the disconnect, the slot is still held.
+	call(func(mh *MatchHandler) {
+		mh.tick += mh.reconnectWindowTicks/2 + 1
+		if expired := mh.expireSuspensions(); len(expired) != 0 {
+			t.Errorf("expected slot to be held for the reconnect window, was released")
+		}
+	})
+
+	newSessionID, _ := uuid.NewV4()
+	_, accepted, _, _, _, _ = matchRegistry.Reconnect(context.Background(), matchID, "node", token, userID, newSessionID, "username", "fromNode")
+	if !accepted {
+		t.Fatalf("expected reconnect to be accepted, was not")
+	}
+
+	// Once the window has passed since a disconnect, the slot is released.
+	matchRegistry.Leave(matchID, []*MatchPresence{{Node: "fromNode", UserID: userID, SessionID: newSessionID, Username: "username", Reason: runtime.PresenceReasonDisconnect}})
+	call(func(mh *MatchHandler) {
+		mh.tick += mh.reconnectWindowTicks - 1
+		if expired := mh.expireSuspensions(); len(expired) != 0 {
+			t.Errorf("expected slot to be held until the end of the reconnect window, was released")
+		}
+		mh.tick++
+		if expired := mh.expireSuspensions(); len(expired) != 1 {
+			t.Errorf("expected slot to be released at the end of the reconnect window, was not")
+		}
+	})
+}
+
+type testMatchRegistryPeer struct {
+	node string
+}
+
+func (p *testMatchRegistryPeer) Reconnect(ctx context.Context, id uuid.UUID, node string, token string, userID, sessionID uuid.UUID, username, fromNode string) (bool, bool, bool, string, string, []*MatchPresence) {
+	p.node = node
+	return true, true, true, "", "label", nil
+}
+
+// should pass reconnects for matches hosted on other nodes to the peer
+func TestMatchRegistryReconnectPeer(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, _, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+
+	matchID, _ := uuid.NewV4()
+	userID, _ := uuid.NewV4()
+	sessionID, _ := uuid.NewV4()
+	if found, _, _, _, _, _ := matchRegistry.Reconnect(context.Background(), matchID, "other", "token", userID, sessionID, "username", "node"); found {
+		t.Fatalf("expected match on another node not to be found without a peer")
+	}
+
+	peer := &testMatchRegistryPeer{}
+	matchRegistry.SetPeer(peer)
+	found, accepted, _, _, label, _ := matchRegistry.Reconnect(context.Background(), matchID, "other", "token", userID, sessionID, "username", "node")
+	if !found || !accepted || label != "label" || peer.node != "other" {
+		t.Fatalf("expected reconnect to be passed to the peer, got %v %v %q %q", found, accepted, label, peer.node)
+	}
+}
+
 // should create authoritative match, list matches without querying
 func TestMatchRegistryAuthoritativeMatchAndListMatches(t *testing.T) {
 	consoleLogger := loggerForTest(t)
@@ -157,8 +450,8 @@ func TestMatchRegistryAuthoritativeMatchAndListMatches(t *testing.T) {
 
 	matchRegistry.processLabelUpdates(bluge.NewBatch())
 
-	matches, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
-		wrapperspb.String("label"), wrapperspb.Int32(0), wrapperspb.Int32(5), nil, nil)
+	matches, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
+		wrapperspb.String("label"), wrapperspb.Int32(0), wrapperspb.Int32(5), nil, nil, nil, "")
 	if len(matches) != 1 {
 		t.Fatalf("expected one match, got %d", len(matches))
 	}
@@ -192,8 +485,8 @@ func TestMatchRegistryAuthoritativeMatchAndListMatchesWithTokenizableLabel(t *te
 
 	matchRegistry.processLabelUpdates(bluge.NewBatch())
 
-	matches, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
-		wrapperspb.String("label-part2"), wrapperspb.Int32(0), wrapperspb.Int32(5), nil, nil)
+	matches, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
+		wrapperspb.String("label-part2"), wrapperspb.Int32(0), wrapperspb.Int32(5), nil, nil, nil, "")
 	if len(matches) != 1 {
 		t.Fatalf("expected one match, got %d", len(matches))
 	}
@@ -225,9 +518,9 @@ func TestMatchRegistryAuthoritativeMatchAndListMatchesWithQuerying(t *testing.T)
 
 	matchRegistry.processLabelUpdates(bluge.NewBatch())
 
-	matches, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
+	matches, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
 		wrapperspb.String("label"), wrapperspb.Int32(0), wrapperspb.Int32(5),
-		wrapperspb.String("+label.skill:>=50"), nil)
+		wrapperspb.String("+label.skill:>=50"), nil, nil, "")
 	if len(matches) != 1 {
 		t.Fatalf("expected one match, got %d", len(matches))
 	}
@@ -259,9 +552,9 @@ func TestMatchRegistryAuthoritativeMatchAndListAllMatchesWithQueryStar(t *testin
 
 	matchRegistry.processLabelUpdates(bluge.NewBatch())
 
-	matches, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
+	matches, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
 		wrapperspb.String("label"), wrapperspb.Int32(0), wrapperspb.Int32(5),
-		wrapperspb.String("*"), nil)
+		wrapperspb.String("*"), nil, nil, "")
 	if len(matches) != 1 {
 		t.Fatalf("expected one match, got %d", len(matches))
 	}
@@ -297,9 +590,9 @@ func TestMatchRegistryAuthoritativeMatchAndListMatchesWithQueryingArrays(t *test
 
 	matchRegistry.processLabelUpdates(bluge.NewBatch())
 
-	matches, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
+	matches, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
 		wrapperspb.String("label"), wrapperspb.Int32(0), wrapperspb.Int32(5),
-		wrapperspb.String(fmt.Sprintf("+label.convo_ids:%s", convoID2)), nil)
+		wrapperspb.String(fmt.Sprintf("+label.convo_ids:%s", convoID2)), nil, nil, "")
 	if len(matches) != 1 {
 		t.Fatalf("expected one match, got %d", len(matches))
 	}
@@ -323,8 +616,8 @@ func TestMatchRegistryListMatchesAfterLabelsUpdate(t *testing.T) {
 
 	var rgmc *RuntimeGoMatchCore
 
-	matchCreateWrapper := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, name string) (RuntimeMatchCore, error) {
-		rmc, err := runtimeMatchCreateFunc(ctx, logger, id, node, stopped, name)
+	matchCreateWrapper := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error) {
+		rmc, err := runtimeMatchCreateFunc(ctx, logger, id, node, stopped, router, name)
 		if err != nil {
 			return nil, err
 		}
@@ -340,9 +633,9 @@ func TestMatchRegistryListMatchesAfterLabelsUpdate(t *testing.T) {
 	rgmc.MatchLabelUpdate(`{"updated_label": 1}`)
 	matchRegistry.processLabelUpdates(bluge.NewBatch())
 
-	matches, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
+	matches, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true),
 		nil, wrapperspb.Int32(0), wrapperspb.Int32(5),
-		wrapperspb.String(`label.updated_label:1`), nil)
+		wrapperspb.String(`label.updated_label:1`), nil, nil, "")
 	if len(matches) != 1 {
 		t.Fatalf("expected one match, got %d", len(matches))
 	}
@@ -443,9 +736,9 @@ func TestMatchRegistryAuthoritativeMatchAndListMatchesWithQueryingAndBoost(t *te
 		test := test
 
 		t.Run(test.name, func(t *testing.T) {
-			matches, _, err := matchRegistry.ListMatches(context.Background(), 10, wrapperspb.Bool(true),
+			matches, _, _, err := matchRegistry.ListMatches(context.Background(), 10, wrapperspb.Bool(true),
 				wrapperspb.String("label"), wrapperspb.Int32(0), wrapperspb.Int32(5),
-				wrapperspb.String(test.query), nil)
+				wrapperspb.String(test.query), nil, nil, "")
 			if err != nil {
 				t.Fatalf("error listing matches: %v", err)
 			}
@@ -465,6 +758,75 @@ func TestMatchRegistryAuthoritativeMatchAndListMatchesWithQueryingAndBoost(t *te
 	}
 }
 
+// should list matches sorted by typed label fields, one page at a time
+func TestMatchRegistryListMatchesSortedAndPaginated(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+
+	labels := []string{
+		`{"skill":30,"region":"us"}`,
+		`{"skill":10,"region":"eu"}`,
+		`{"skill":50,"region":"us"}`,
+		`{"skill":20,"region":"us"}`,
+		`{"skill":40,"region":"eu"}`,
+	}
+	for _, label := range labels {
+		if _, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{"label": label}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	matchRegistry.processLabelUpdates(bluge.NewBatch())
+
+	listAll := func(sort []string) []string {
+		var listed []string
+		var cursor string
+		for page := 0; ; page++ {
+			matches, _, nextCursor, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true), nil, nil, nil, nil, nil, sort, cursor)
+			if err != nil {
+				t.Fatalf("error listing matches: %v", err)
+			}
+			for _, match := range matches {
+				listed = append(listed, match.Label.Value)
+			}
+			if nextCursor == "" {
+				return listed
+			}
+			if page == len(labels) {
+				t.Fatal("expected listing to end")
+			}
+			cursor = nextCursor
+		}
+	}
+
+	listed := listAll([]string{"-label.skill"})
+	expected := []string{labels[2], labels[4], labels[0], labels[3], labels[1]}
+	if !reflect.DeepEqual(listed, expected) {
+		t.Fatalf("unexpected descending numeric order:\n  got: %v\n  expected: %v", listed, expected)
+	}
+
+	listed = listAll([]string{"label.region", "label.skill"})
+	expected = []string{labels[1], labels[4], labels[3], labels[0], labels[2]}
+	if !reflect.DeepEqual(listed, expected) {
+		t.Fatalf("unexpected string then numeric order:\n  got: %v\n  expected: %v", listed, expected)
+	}
+
+	_, _, cursor, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true), nil, nil, nil, nil, nil, []string{"label.skill"}, "")
+	if err != nil || cursor == "" {
+		t.Fatalf("expected a next page cursor, got %q, %v", cursor, err)
+	}
+	if _, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true), nil, nil, nil, nil, nil, []string{"-label.skill"}, cursor); err != ErrMatchListCursorInvalid {
+		t.Fatalf("expected invalid cursor for a different sort order, got %v", err)
+	}
+	if _, _, _, err := matchRegistry.ListMatches(context.Background(), 2, wrapperspb.Bool(true), nil, nil, nil, nil, nil, []string{"label_string"}, ""); err != ErrMatchListSortInvalid {
+		t.Fatalf("expected invalid sort field, got %v", err)
+	}
+}
+
 func matchUUIDFromString(matchIDString string) (uuid.UUID, error) {
 	matchIDComponents := strings.SplitN(matchIDString, ".", 2)
 	if len(matchIDComponents) != 2 {
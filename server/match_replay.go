@@ -0,0 +1,237 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var ErrMatchReplayModuleNotFound = errors.New("match handler in recording not found")
+
+// MatchReplayDiff is a difference between an output in a match recording and the same output from a replay.
+type MatchReplayDiff struct {
+	Index    int
+	Tick     int64
+	Type     MatchRecordEventType
+	Field    string
+	Recorded string
+	Replayed string
+}
+
+func (d *MatchReplayDiff) String() string {
+	return fmt.Sprintf("event %d (%v at tick %d) %v differs:\n  recorded: %v\n  replayed: %v", d.Index, d.Type, d.Tick, d.Field, d.Recorded, d.Replayed)
+}
+
+// ReplayMatchRecording loads the configured runtime modules on their own and replays a match recording against them.
+// No database or other server components are started, so runtime functions that need them are not available to the
+// modules, either while they load or during the replay.
+func ReplayMatchRecording(ctx context.Context, logger *zap.Logger, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, reader io.Reader) ([]*MatchReplayDiff, error) {
+	config, err := config.Clone()
+	if err != nil {
+		return nil, err
+	}
+	// Never expose metrics for a replay.
+	config.GetMetrics().PrometheusPort = 0
+	metrics := NewLocalMetrics(logger, logger, nil, config)
+	defer metrics.Stop(logger)
+
+	router := &matchReplayRouter{}
+	rt, _, err := NewRuntime(ctx, logger, logger, nil, protojsonMarshaler, protojsonUnmarshaler, config, version, nil, nil, nil, nil, nil, nil, nil, &matchReplayRegistry{}, nil, metrics, nil, router, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replays only capture broadcasts in memory, and never write new recordings.
+	recorder := NewLocalMatchRecorder(logger, logger, router, "")
+	return ReplayMatch(ctx, logger, recorder, rt.MatchCreateFunction(), reader)
+}
+
+// ReplayMatch re-drives a registered match handler with the inputs from a match recording, and returns every
+// difference between the recorded and replayed state snapshots and broadcasts. The match handler sends through the
+// recorder, so replayed broadcasts can be captured.
+func ReplayMatch(ctx context.Context, logger *zap.Logger, recorder MatchRecorder, createFn RuntimeMatchCreateFunction, reader io.Reader) ([]*MatchReplayDiff, error) {
+	decoder := gob.NewDecoder(reader)
+
+	header := &MatchRecordHeader{}
+	if err := decoder.Decode(header); err != nil {
+		return nil, fmt.Errorf("error reading match recording header: %v", err)
+	}
+	if header.Version != MatchRecordingVersion {
+		return nil, fmt.Errorf("unsupported match recording version: %v", header.Version)
+	}
+
+	core, err := createFn(ctx, logger, header.ID, header.Node, atomic.NewBool(false), recorder, header.Module)
+	if err != nil {
+		return nil, err
+	}
+	if core == nil {
+		return nil, ErrMatchReplayModuleNotFound
+	}
+	defer func() {
+		core.Cancel()
+		core.Cleanup()
+	}()
+
+	presenceList := NewMatchPresenceList()
+	var deferred []*DeferredMessage
+	deferMessageFn := func(msg *DeferredMessage) error {
+		deferred = append(deferred, msg)
+		return nil
+	}
+
+	state, _, err := core.MatchInit(presenceList, deferMessageFn, header.Params)
+	if err != nil {
+		return nil, fmt.Errorf("error replaying match init: %v", err)
+	}
+
+	recording := recorder.Record(header.ID, header.Node, header.Module, header.Params)
+	if recording == nil {
+		return nil, errors.New("error starting match replay recording")
+	}
+	defer recording.Close()
+
+	diffs := make([]*MatchReplayDiff, 0)
+	for index := 0; state != nil; index++ {
+		recorded := &MatchRecordEvent{}
+		if err := decoder.Decode(recorded); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return diffs, fmt.Errorf("error reading match recording event %d: %v", index, err)
+		}
+
+		replayed := &MatchRecordEvent{Type: recorded.Type, Tick: recorded.Tick}
+		switch recorded.Type {
+		case MatchRecordEventLoop:
+			inputCh := make(chan *MatchDataMessage, len(recorded.Messages))
+			for _, message := range recorded.Messages {
+				inputCh <- message
+			}
+			state, err = core.MatchLoop(recorded.Tick, state, inputCh)
+		case MatchRecordEventJoinAttempt:
+			a := recorded.JoinAttempt
+			state, replayed.Allow, replayed.Result, err = core.MatchJoinAttempt(recorded.Tick, state, a.UserID, a.SessionID, a.Username, a.SessionExpiry, a.Vars, a.ClientIP, a.ClientPort, a.Node, a.Metadata)
+		case MatchRecordEventJoin:
+			presenceList.Join(recorded.Presences)
+			state, err = core.MatchJoin(recorded.Tick, state, recorded.Presences)
+		case MatchRecordEventLeave:
+			presenceList.Leave(recorded.Presences)
+			state, err = core.MatchLeave(recorded.Tick, state, recorded.Presences)
+		case MatchRecordEventSignal:
+			state, replayed.Result, err = core.MatchSignal(recorded.Tick, state, recorded.Signal)
+		case MatchRecordEventTerminate:
+			state, err = core.MatchTerminate(recorded.Tick, state, recorded.GraceSeconds)
+		default:
+			return diffs, fmt.Errorf("unknown match recording event type %d at event %d", recorded.Type, index)
+		}
+		if err != nil {
+			return diffs, fmt.Errorf("error replaying %v event %d at tick %d: %v", recorded.Type, index, recorded.Tick, err)
+		}
+
+		if len(deferred) != 0 {
+			recorder.SendDeferred(logger, deferred)
+			deferred = nil
+		}
+		// State is only compared where the recording has a snapshot of it.
+		if state != nil && recorded.HasState {
+			if replayed.State, err = core.GetState(state); err != nil {
+				return diffs, fmt.Errorf("error getting match state snapshot at event %d: %v", index, err)
+			}
+			replayed.HasState = true
+		}
+		recording.Write(replayed)
+		recording.Events()
+
+		diffs = append(diffs, diffMatchRecordEvents(index, recorded, replayed)...)
+	}
+
+	return diffs, nil
+}
+
+func diffMatchRecordEvents(index int, recorded, replayed *MatchRecordEvent) []*MatchReplayDiff {
+	var diffs []*MatchReplayDiff
+	diff := func(field, recordedValue, replayedValue string) {
+		if recordedValue != replayedValue {
+			diffs = append(diffs, &MatchReplayDiff{
+				Index:    index,
+				Tick:     recorded.Tick,
+				Type:     recorded.Type,
+				Field:    field,
+				Recorded: recordedValue,
+				Replayed: replayedValue,
+			})
+		}
+	}
+
+	switch recorded.Type {
+	case MatchRecordEventJoinAttempt:
+		diff("allow", fmt.Sprint(recorded.Allow), fmt.Sprint(replayed.Allow))
+		diff("reason", recorded.Result, replayed.Result)
+	case MatchRecordEventSignal:
+		diff("result", recorded.Result, replayed.Result)
+	}
+	diff("broadcasts", formatMatchRecordBroadcasts(recorded.Broadcasts), formatMatchRecordBroadcasts(replayed.Broadcasts))
+	if recorded.HasState {
+		diff("state", recorded.State, replayed.State)
+	}
+
+	return diffs
+}
+
+func formatMatchRecordBroadcasts(broadcasts []*MatchRecordBroadcast) string {
+	formatted := make([]string, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		formatted = append(formatted, fmt.Sprintf("{op_code:%v data:%q reliable:%v sender:%q recipients:%v}", b.OpCode, b.Data, b.Reliable, b.Sender, b.Recipients))
+	}
+	return "[" + strings.Join(formatted, " ") + "]"
+}
+
+// matchReplayRegistry stands in for the match registry during a replay. Only the calls a match handler's dispatcher
+// makes are supported, and they have no effect.
+type matchReplayRegistry struct {
+	MatchRegistry
+}
+
+func (r *matchReplayRegistry) UpdateMatchLabel(id uuid.UUID, tickRate int, handlerName, label string, createTime int64) error {
+	if len(label) > MatchLabelMaxBytes {
+		return runtime.ErrMatchLabelTooLong
+	}
+	return nil
+}
+
+func (r *matchReplayRegistry) Kick(stream PresenceStream, presences []*MatchPresence) {}
+
+// matchReplayRouter discards all messages, replayed broadcasts are only captured by the match recorder.
+type matchReplayRouter struct{}
+
+func (r *matchReplayRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs []*PresenceID, envelope *rtapi.Envelope, reliable bool) {
+}
+
+func (r *matchReplayRouter) SendToStream(logger *zap.Logger, stream PresenceStream, envelope *rtapi.Envelope, reliable bool) {
+}
+
+func (r *matchReplayRouter) SendDeferred(logger *zap.Logger, messages []*DeferredMessage) {}
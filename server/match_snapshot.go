@@ -0,0 +1,122 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	MatchSnapshotVersion   = 1
+	MatchSnapshotExtension = ".nksnap"
+
+	// Time allowed for matches to save snapshots on shutdown when there is no grace period.
+	matchSnapshotMinTimeout = time.Second
+)
+
+var (
+	ErrMatchSnapshotUnsupported = errors.New("match handler does not support snapshot restore")
+	ErrMatchRestoreStateNil     = errors.New("Match restored state must not be nil")
+)
+
+// MatchStateSnapshot holds everything needed to re-create an authoritative match after a server restart.
+type MatchStateSnapshot struct {
+	Version int
+	ID      uuid.UUID
+	Node    string
+	Module  string
+	Params  map[string]interface{}
+	Tick    int64
+	// Presences in the match when the snapshot was taken, notified once the match is restored so they can rejoin.
+	Presences []*MatchPresence
+	// Match state serialized by the match handler.
+	Data string
+}
+
+// Write a snapshot to its own file in the given directory, replacing any earlier snapshot of the same match.
+func writeMatchSnapshot(dir string, snapshot *MatchStateSnapshot) error {
+	path := filepath.Join(dir, fmt.Sprintf("%v.%v%v", snapshot.ID.String(), snapshot.Node, MatchSnapshotExtension))
+
+	// Write to a temporary file first so a failed write never leaves a partial snapshot behind.
+	tmpPath := path + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	if err := gob.NewEncoder(writer).Encode(snapshot); err != nil {
+		_ = file.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		_ = file.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// List the paths of all snapshots in the given directory taken by the given node.
+func listMatchSnapshots(dir, node string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	suffix := "." + node + MatchSnapshotExtension
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), suffix) {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+	return paths, nil
+}
+
+func readMatchSnapshot(path string) (*MatchStateSnapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	snapshot := &MatchStateSnapshot{}
+	if err := gob.NewDecoder(bufio.NewReader(file)).Decode(snapshot); err != nil {
+		return nil, err
+	}
+	if snapshot.Version != MatchSnapshotVersion {
+		return nil, fmt.Errorf("unsupported match snapshot version %v", snapshot.Version)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+type snapshotTestState struct {
+	data string
+}
+
+// snapshotTestMatch keeps a string of state across restarts, and returns it when signalled.
+type snapshotTestMatch struct {
+	testMatch
+	hooks *snapshotTestHooks
+}
+
+// snapshotTestHooks observe and control the snapshot test matches.
+type snapshotTestHooks struct {
+	terminated atomic.Bool
+	// If set, snapshots are not taken until it's closed.
+	release chan struct{}
+}
+
+func (m *snapshotTestMatch) MatchInit(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, params map[string]interface{}) (interface{}, int, string) {
+	return &snapshotTestState{data: params["data"].(string)}, 1, "snapshot"
+}
+
+func (m *snapshotTestMatch) MatchSignal(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) (interface{}, string) {
+	return state, state.(*snapshotTestState).data
+}
+
+func (m *snapshotTestMatch) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	return state
+}
+
+func (m *snapshotTestMatch) MatchTerminate(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, graceSeconds int) interface{} {
+	m.hooks.terminated.Store(true)
+	return state
+}
+
+func (m *snapshotTestMatch) MatchSnapshot(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, tick int64, state interface{}) string {
+	if m.hooks.release != nil && state.(*snapshotTestState).data == "slow" {
+		<-m.hooks.release
+	}
+	return "saved " + state.(*snapshotTestState).data
+}
+
+func (m *snapshotTestMatch) MatchRestore(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) interface{} {
+	return &snapshotTestState{data: data}
+}
+
+func createSnapshotTestMatchRegistry(t *testing.T, dir string, hooks *snapshotTestHooks) (*LocalMatchRegistry, RuntimeMatchCreateFunction) {
+	logger := loggerForTest(t)
+	cfg := NewConfig(logger)
+	cfg.GetMatch().LabelUpdateIntervalMs = 1000
+	cfg.GetMatch().SnapshotDir = dir
+	messageRouter := &testMessageRouter{}
+	matchRegistry := NewLocalMatchRegistry(logger, logger, cfg, &testSessionRegistry{}, &testTracker{},
+		messageRouter, nil, &testMetrics{}, "node")
+
+	createFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error) {
+		var match runtime.Match
+		switch name {
+		case "snapshot":
+			match = &snapshotTestMatch{hooks: hooks}
+		case "plain":
+			match = &testMatch{}
+		default:
+			return nil, nil
+		}
+		return NewRuntimeGoMatchCore(logger, name, matchRegistry, messageRouter, id, "node", "",
+			stopped, nil, map[string]string{}, nil, match)
+	}
+
+	return matchRegistry.(*LocalMatchRegistry), createFn
+}
+
+// should save the state of matches that support snapshots on shutdown, and restore them with the same ID
+func TestMatchRegistrySnapshotRestore(t *testing.T) {
+	dir := t.TempDir()
+	matchRegistry, createFn := createSnapshotTestMatchRegistry(t, dir, &snapshotTestHooks{})
+
+	snapshotID, err := matchRegistry.CreateMatch(context.Background(), createFn, "snapshot", map[string]interface{}{"data": "state"})
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	if _, err := matchRegistry.CreateMatch(context.Background(), createFn, "plain", map[string]interface{}{}); err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+
+	<-matchRegistry.Stop(0)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("error reading snapshot dir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 snapshot, got %v", len(entries))
+	}
+
+	restoredRegistry, createFn := createSnapshotTestMatchRegistry(t, dir, &snapshotTestHooks{})
+	defer restoredRegistry.Stop(0)
+
+	if count := restoredRegistry.RestoreMatches(context.Background(), nil, createFn); count != 1 {
+		t.Fatalf("expected 1 restored match, got %v", count)
+	}
+	if result, err := restoredRegistry.Signal(context.Background(), snapshotID, ""); err != nil || result != "saved state" {
+		t.Fatalf("expected restored state, got %q, %v", result, err)
+	}
+
+	// Snapshots are only restored once.
+	if entries, _ = os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("expected snapshot to be removed, got %v", len(entries))
+	}
+}
+
+// should take snapshots in parallel within the grace period, and stop snapshotted matches without terminating them
+func TestMatchRegistrySnapshotGraceful(t *testing.T) {
+	dir := t.TempDir()
+	hooks := &snapshotTestHooks{release: make(chan struct{})}
+	matchRegistry, createFn := createSnapshotTestMatchRegistry(t, dir, hooks)
+
+	if _, err := matchRegistry.CreateMatch(context.Background(), createFn, "snapshot", map[string]interface{}{"data": "state"}); err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	// This match does not finish its snapshot until released, and must not hold up the others or the shutdown.
+	if _, err := matchRegistry.CreateMatch(context.Background(), createFn, "snapshot", map[string]interface{}{"data": "slow"}); err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+	defer close(hooks.release)
+
+	start := time.Now()
+	matchRegistry.Stop(1)
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Fatalf("expected snapshots to be bounded by the grace period, took %v", elapsed)
+	}
+
+	// Only the match still taking its snapshot is left running.
+	if count := matchRegistry.Count(); count != 1 {
+		t.Fatalf("expected snapshotted match to be stopped, got %v matches", count)
+	}
+	if hooks.terminated.Load() {
+		t.Fatal("expected snapshotted match not to be terminated")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Fatalf("expected 1 snapshot, got %v", len(entries))
+	}
+}
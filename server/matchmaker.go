@@ -16,9 +16,11 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -32,6 +34,19 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// MatchmakerDefaultQueue is the queue for tickets and backfill requests that do not select a named queue.
+	MatchmakerDefaultQueue = "default"
+	// MatchmakerRttPropertyPrefix prefixes the numeric properties tickets use to report their round trip time in
+	// milliseconds to each region, for example "rtt_eu-west".
+	MatchmakerRttPropertyPrefix = "rtt_"
+)
+
+var (
+	ErrMatchmakerBackfillCount = errors.New("matchmaker backfill count must be at least 1")
+	ErrMatchmakerQueueNotFound = errors.New("matchmaker queue not found")
+)
+
 type MatchmakerPresence struct {
 	UserId    string    `json:"user_id"`
 	SessionId string    `json:"session_id"`
@@ -95,6 +110,7 @@ type MatchmakerIndex struct {
 	MaxCount   int                    `json:"max_count"`
 	PartyId    string                 `json:"party_id"`
 	CreatedAt  int64                  `json:"created_at"`
+	Queue      string                 `json:"queue"`
 
 	// Parameters used for correctly processing various matchmaker operations, but not indexed for searching.
 	Query             string              `json:"-"`
@@ -106,6 +122,8 @@ type MatchmakerIndex struct {
 	Node              string              `json:"-"`
 	StringProperties  map[string]string   `json:"-"`
 	NumericProperties map[string]float64  `json:"-"`
+	Rtts              map[string]float64  `json:"-"`
+	BlockedUsers      map[string]struct{} `json:"-"`
 	ParsedQuery       bluge.Query         `json:"-"`
 }
 
@@ -124,6 +142,44 @@ type MatchmakerExtract struct {
 	Intervals         int
 	CreatedAt         int64
 	Node              string
+	Queue             string
+	BlockedUsers      []string
+}
+
+// MatchmakerBackfill is a request from a running authoritative match for players to fill open slots. Backfill requests
+// are indexed alongside ordinary tickets so player queries can be checked against their properties, but they are kept
+// out of ordinary matching and only ever matched against player tickets.
+type MatchmakerBackfill struct {
+	Ticket            string
+	MatchId           string
+	Query             string
+	Count             int
+	Properties        map[string]interface{}
+	StringProperties  map[string]string
+	NumericProperties map[string]float64
+	CreatedAt         int64
+	Node              string
+	Queue             string
+	ParsedQuery       bluge.Query
+	// Lists the players currently in the match, if block-aware matchmaking needs to check them against player tickets.
+	PresencesFn func() []*MatchPresence
+	// Users who have blocked, or been blocked by, any player in the match. Refreshed every interval.
+	BlockedUsers map[string]struct{}
+	// Region the match is hosted in, if any. Only tickets that can reach it within their allowed round trip time fill it.
+	Region string
+}
+
+// Candidate groups found for a single ticket, left for the matchmaker score hook to choose from.
+type matchmakerScoreRequest struct {
+	candidates [][]*MatchmakerEntry
+	regions    []string
+	// Candidate positions from most to least preferred, vetoed candidates are left out.
+	ranked []int
+}
+
+type matchmakerBackfillResult struct {
+	backfill *MatchmakerBackfill
+	entries  []*MatchmakerEntry
 }
 
 type MatchmakerIndexGroup struct {
@@ -173,7 +229,7 @@ type Matchmaker interface {
 	Resume()
 	Stop()
 	OnMatchedEntries(fn func(entries [][]*MatchmakerEntry))
-	Add(ctx context.Context, presences []*MatchmakerPresence, sessionID, partyId, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, int64, error)
+	Add(ctx context.Context, queue string, presences []*MatchmakerPresence, sessionID, partyId, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, int64, error)
 	Insert(extracts []*MatchmakerExtract) error
 	Extract() []*MatchmakerExtract
 	RemoveSession(sessionID, ticket string) error
@@ -182,6 +238,9 @@ type Matchmaker interface {
 	RemovePartyAll(partyID string) error
 	RemoveAll(node string)
 	Remove(tickets []string)
+	AddBackfill(ctx context.Context, queue, matchID, region string, presencesFn func() []*MatchPresence, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, int64, error)
+	RemoveBackfill(matchID, ticket string) error
+	RemoveBackfillAll(matchID string) error
 }
 
 type LocalMatchmaker struct {
@@ -192,6 +251,8 @@ type LocalMatchmaker struct {
 	router  MessageRouter
 	metrics Metrics
 	runtime *Runtime
+	// Only set if block-aware matchmaking is enabled.
+	blockGraph BlockGraph
 
 	active      *atomic.Uint32
 	stopped     *atomic.Bool
@@ -211,12 +272,21 @@ type LocalMatchmaker struct {
 	indexes map[string]*MatchmakerIndex
 	// Indexes that have not yet reached their max interval count.
 	activeIndexes map[string]*MatchmakerIndex
+	// Name and settings of the queue processed by this matchmaker.
+	queue       string
+	queueConfig *MatchmakerQueueConfig
+	// Named queues, each with its own lock, index and ticket pool. Only set on the default queue matchmaker.
+	namedQueues map[string]*LocalMatchmaker
 	// Reverse lookup cache for mutual matching.
 	revCache       map[string]map[string]bool
-	revThresholdFn func() *time.Timer
+	revThresholdFn func(queue *MatchmakerQueueConfig) *time.Timer
+	// Backfill requests for running matches, by backfill ticket.
+	backfills map[string]*MatchmakerBackfill
+	// All backfill tickets for a match ID.
+	matchBackfills map[string]map[string]struct{}
 }
 
-func NewLocalMatchmaker(logger, startupLogger *zap.Logger, config Config, router MessageRouter, metrics Metrics, runtime *Runtime) Matchmaker {
+func NewLocalMatchmaker(logger, startupLogger *zap.Logger, config Config, router MessageRouter, metrics Metrics, runtime *Runtime, blockGraph BlockGraph) Matchmaker {
 	cfg := BlugeInMemoryConfig()
 	indexWriter, err := bluge.OpenWriter(cfg)
 	if err != nil {
@@ -226,12 +296,13 @@ func NewLocalMatchmaker(logger, startupLogger *zap.Logger, config Config, router
 	ctx, ctxCancelFn := context.WithCancel(context.Background())
 
 	m := &LocalMatchmaker{
-		logger:  logger,
-		node:    config.GetName(),
-		config:  config,
-		router:  router,
-		metrics: metrics,
-		runtime: runtime,
+		logger:     logger,
+		node:       config.GetName(),
+		config:     config,
+		router:     router,
+		metrics:    metrics,
+		runtime:    runtime,
+		blockGraph: blockGraph,
 
 		active:      atomic.NewUint32(1),
 		stopped:     atomic.NewBool(false),
@@ -245,30 +316,139 @@ func NewLocalMatchmaker(logger, startupLogger *zap.Logger, config Config, router
 		entries:        make(map[string][]*MatchmakerEntry),
 		indexes:        make(map[string]*MatchmakerIndex),
 		activeIndexes:  make(map[string]*MatchmakerIndex),
+		queue:          MatchmakerDefaultQueue,
+		queueConfig:    matchmakerDefaultQueueConfig(config),
+		namedQueues:    make(map[string]*LocalMatchmaker, len(config.GetMatchmaker().Queues)),
 		revCache:       make(map[string]map[string]bool),
+		backfills:      make(map[string]*MatchmakerBackfill),
+		matchBackfills: make(map[string]map[string]struct{}),
 	}
 
-	if revThreshold := m.config.GetMatchmaker().RevThreshold; revThreshold > 0 && m.config.GetMatchmaker().RevPrecision {
-		m.revThresholdFn = func() *time.Timer {
-			return time.NewTimer(time.Duration(m.config.GetMatchmaker().IntervalSec*revThreshold) * time.Second)
+	m.revThresholdFn = func(queue *MatchmakerQueueConfig) *time.Timer {
+		if queue.RevThreshold <= 0 || !queue.RevPrecision {
+			return nil
 		}
+		return time.NewTimer(time.Duration(queue.IntervalSec*queue.RevThreshold) * time.Second)
 	}
 
-	go func() {
-		ticker := time.NewTicker(time.Duration(config.GetMatchmaker().IntervalSec) * time.Second)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-ticker.C:
-				m.Process()
-			}
+	for name, queueConfig := range config.GetMatchmaker().Queues {
+		if err := m.addQueue(name, queueConfig); err != nil {
+			startupLogger.Fatal("Failed to create matchmaker queue index", zap.String("queue", name), zap.Error(err))
 		}
-	}()
+	}
+
+	// Each queue is processed independently, at its own interval.
+	for _, q := range m.allQueues() {
+		go func(q *LocalMatchmaker) {
+			ticker := time.NewTicker(time.Duration(q.queueConfig.IntervalSec) * time.Second)
+			for {
+				select {
+				case <-ctx.Done():
+					ticker.Stop()
+					return
+				case <-ticker.C:
+					q.process()
+				}
+			}
+		}(q)
+	}
 
 	return m
 }
 
+// matchmakerDefaultQueueConfig builds the settings for the default queue from the top level matchmaker settings.
+func matchmakerDefaultQueueConfig(config Config) *MatchmakerQueueConfig {
+	return &MatchmakerQueueConfig{
+		MaxTickets:   config.GetMatchmaker().MaxTickets,
+		IntervalSec:  config.GetMatchmaker().IntervalSec,
+		MaxIntervals: config.GetMatchmaker().MaxIntervals,
+		RevPrecision: config.GetMatchmaker().RevPrecision,
+		RevThreshold: config.GetMatchmaker().RevThreshold,
+	}
+}
+
+// matchmakerQueue returns the queue a socket ticket selects through the configured ticket string property, and the
+// ticket's string properties without it, so the queue name is never part of the searchable ticket properties.
+func matchmakerQueue(config Config, stringProperties map[string]string) (string, map[string]string) {
+	property := config.GetMatchmaker().QueueProperty
+	queue, found := stringProperties[property]
+	if property == "" || !found {
+		return MatchmakerDefaultQueue, stringProperties
+	}
+
+	properties := make(map[string]string, len(stringProperties)-1)
+	for k, v := range stringProperties {
+		if k != property {
+			properties[k] = v
+		}
+	}
+	if queue == "" {
+		queue = MatchmakerDefaultQueue
+	}
+	return queue, properties
+}
+
+// addQueue creates a named queue with its own lock, index and ticket pool, sharing everything else with the default
+// queue matchmaker. The queue is not processed until its owner's processing loop, or Process, picks it up.
+func (m *LocalMatchmaker) addQueue(name string, queueConfig *MatchmakerQueueConfig) error {
+	indexWriter, err := bluge.OpenWriter(BlugeInMemoryConfig())
+	if err != nil {
+		return err
+	}
+
+	m.namedQueues[name] = &LocalMatchmaker{
+		logger:     m.logger.With(zap.String("queue", name)),
+		node:       m.node,
+		config:     m.config,
+		router:     m.router,
+		metrics:    m.metrics,
+		runtime:    m.runtime,
+		blockGraph: m.blockGraph,
+
+		active:      m.active,
+		stopped:     m.stopped,
+		ctx:         m.ctx,
+		ctxCancelFn: m.ctxCancelFn,
+
+		matchedEntriesFn: m.matchedEntriesFn,
+		batch:            bluge.NewBatch(),
+		indexWriter:      indexWriter,
+		sessionTickets:   make(map[string]map[string]struct{}),
+		partyTickets:     make(map[string]map[string]struct{}),
+		entries:          make(map[string][]*MatchmakerEntry),
+		indexes:          make(map[string]*MatchmakerIndex),
+		activeIndexes:    make(map[string]*MatchmakerIndex),
+		queue:            name,
+		queueConfig:      queueConfig,
+		revCache:         make(map[string]map[string]bool),
+		revThresholdFn:   m.revThresholdFn,
+		backfills:        make(map[string]*MatchmakerBackfill),
+		matchBackfills:   make(map[string]map[string]struct{}),
+	}
+	return nil
+}
+
+// allQueues returns the default queue matchmaker followed by every named queue.
+func (m *LocalMatchmaker) allQueues() []*LocalMatchmaker {
+	queues := make([]*LocalMatchmaker, 0, len(m.namedQueues)+1)
+	queues = append(queues, m)
+	for _, q := range m.namedQueues {
+		queues = append(queues, q)
+	}
+	return queues
+}
+
+// namedQueue returns the matchmaker for the given queue, an empty name selects the default queue.
+func (m *LocalMatchmaker) namedQueue(name string) (*LocalMatchmaker, error) {
+	if name == "" || name == MatchmakerDefaultQueue {
+		return m, nil
+	}
+	if q, found := m.namedQueues[name]; found {
+		return q, nil
+	}
+	return nil, ErrMatchmakerQueueNotFound
+}
+
 func (m *LocalMatchmaker) Pause() {
 	m.active.Store(0)
 }
@@ -283,34 +463,75 @@ func (m *LocalMatchmaker) Stop() {
 }
 
 func (m *LocalMatchmaker) OnMatchedEntries(fn func(entries [][]*MatchmakerEntry)) {
-	m.matchedEntriesFn = fn
+	for _, q := range m.allQueues() {
+		q.matchedEntriesFn = fn
+	}
 }
 
+// Process runs a single matchmaking interval for every queue.
 func (m *LocalMatchmaker) Process() {
+	for _, q := range m.allQueues() {
+		q.process()
+	}
+}
+
+// process runs a single matchmaking interval for the queue this matchmaker holds. Queues never share a lock or index,
+// so a busy queue does not hold up processing or ticket changes in any other.
+func (m *LocalMatchmaker) process() {
 	matchedEntries := make([][]*MatchmakerEntry, 0, 5)
+	matchedRegions := make([]string, 0, 5)
 
 	startTime := time.Now()
+	now := startTime.UTC().UnixNano()
+
+	// Block relations may have changed since tickets were added, look them up again before the lock is taken.
+	ticketBlocked, backfillBlocked := m.refreshBlocked()
 
 	m.Lock()
 
+	for ticket, blockedUsers := range ticketBlocked {
+		if index, ok := m.indexes[ticket]; ok {
+			index.BlockedUsers = blockedUsers
+		}
+	}
+	for ticket, blockedUsers := range backfillBlocked {
+		if backfill, ok := m.backfills[ticket]; ok {
+			backfill.BlockedUsers = blockedUsers
+		}
+	}
+
+	queueConfig := m.queueConfig
 	activeIndexCount := len(m.activeIndexes)
 	indexCount := len(m.indexes)
+	backfillCount := len(m.backfills)
 
 	defer func() {
-		m.metrics.Matchmaker(float64(indexCount), float64(activeIndexCount), time.Now().Sub(startTime))
+		m.metrics.Matchmaker(m.queue, float64(indexCount), float64(activeIndexCount), time.Now().Sub(startTime))
 	}()
 
-	// No active matchmaking tickets, the pool may be non-empty but there are no new tickets to check/query with.
-	if activeIndexCount == 0 {
+	// No active matchmaking tickets or backfill requests, the pool may be non-empty but there are no new tickets to check/query with.
+	if activeIndexCount == 0 && backfillCount == 0 {
 		m.Unlock()
 		return
 	}
 
+	// Running matches looking for players are served first, from the whole ticket pool of the queue.
+	var backfillResults []*matchmakerBackfillResult
+	if m.active.Load() == 1 && backfillCount > 0 {
+		backfillResults = m.processBackfills(now)
+	}
+
+	// If there's a matchmaker score runtime callback, collect several candidate groups per ticket for it to choose from.
+	// The hook is only called once the lock is released, so it cannot hold up ticket changes or other queues.
+	scoreFn := m.runtime.MatchmakerScore()
+	var scoreRequests []*matchmakerScoreRequest
+
 	var threshold bool
 	var timer *time.Timer
 	if m.revThresholdFn != nil {
-		timer = m.revThresholdFn()
-		defer timer.Stop()
+		if timer = m.revThresholdFn(queueConfig); timer != nil {
+			defer timer.Stop()
+		}
 	}
 
 	for ticket, index := range m.activeIndexes {
@@ -323,7 +544,7 @@ func (m *LocalMatchmaker) Process() {
 		}
 
 		index.Intervals++
-		lastInterval := index.Intervals >= m.config.GetMatchmaker().MaxIntervals || index.MinCount == index.MaxCount
+		lastInterval := index.Intervals >= queueConfig.MaxIntervals || index.MinCount == index.MaxCount
 		if lastInterval {
 			// Drop from active indexes if it has reached its max intervals, or if its min/max counts are equal. In the
 			// latter case keeping it active would have the same result as leaving it in the pool, so this saves work.
@@ -397,6 +618,8 @@ func (m *LocalMatchmaker) Process() {
 
 		// Form possible combinations, in case multiple matches might be suitable.
 		entryCombos := make([][]*MatchmakerEntry, 0, 5)
+		var candidates [][]*MatchmakerEntry
+		var candidateRegions []string
 		lastHitCounter := len(blugeMatches.Hits) - 1
 		for hitCounter, hit := range blugeMatches.Hits {
 			hitIndex, ok := m.indexes[hit.ID]
@@ -406,7 +629,7 @@ func (m *LocalMatchmaker) Process() {
 				continue
 			}
 
-			if !threshold && m.config.GetMatchmaker().RevPrecision {
+			if !threshold && queueConfig.RevPrecision {
 				outerMutualMatch, err := validateMatch(m, indexReader, hitIndex.ParsedQuery, hit.ID, ticket)
 				if err != nil {
 					m.logger.Error("error validating mutual match", zap.Error(err))
@@ -417,7 +640,7 @@ func (m *LocalMatchmaker) Process() {
 				}
 			}
 
-			if index.MaxCount < hitIndex.MaxCount && hitIndex.Intervals <= m.config.GetMatchmaker().MaxIntervals {
+			if index.MaxCount < hitIndex.MaxCount && hitIndex.Intervals <= queueConfig.MaxIntervals {
 				// This match would be less than the search hit's preferred max, and they can still wait. Let them wait more.
 				continue
 			}
@@ -434,6 +657,16 @@ func (m *LocalMatchmaker) Process() {
 				continue
 			}
 
+			// Check if these tickets share a region they can both reach within their allowed round trip times.
+			if _, ok := m.matchmakerRegion(now, []*MatchmakerIndex{index, hitIndex}); !ok {
+				continue
+			}
+
+			// Check if any users on these tickets have blocked each other.
+			if m.blocked(index, hitIndex) {
+				continue
+			}
+
 			entries, ok := m.entries[hit.ID]
 			if !ok {
 				// Ticket did not exist, should not happen.
@@ -452,7 +685,7 @@ func (m *LocalMatchmaker) Process() {
 							sessionIdConflict = true
 							break
 						}
-						if !threshold && m.config.GetMatchmaker().RevPrecision {
+						if !threshold && queueConfig.RevPrecision {
 							entryMatchesSearchHitQuery, err := validateMatch(m, indexReader, hitIndex.ParsedQuery, hit.ID, entry.Ticket)
 							if err != nil {
 								mutualMatchConflict = true
@@ -483,6 +716,21 @@ func (m *LocalMatchmaker) Process() {
 					if sessionIdConflict || mutualMatchConflict {
 						continue
 					}
+					// Check if the combo would still share a reachable region with these entries added.
+					if _, ok := m.matchmakerRegion(now, m.entriesIndexes(entryCombo, index, hitIndex)); !ok {
+						continue
+					}
+					// Check if any users in the combo have blocked, or been blocked by, users on the search hit.
+					var blockConflict bool
+					for _, comboIndex := range m.entriesIndexes(entryCombo) {
+						if m.blocked(comboIndex, hitIndex) {
+							blockConflict = true
+							break
+						}
+					}
+					if blockConflict {
+						continue
+					}
 
 					entryCombo = append(entryCombo, entries...)
 					entryCombos[entryComboIdx] = entryCombo
@@ -580,58 +828,78 @@ func (m *LocalMatchmaker) Process() {
 					break
 				}
 				currentMatchedEntries := append(foundCombo, entries...)
+				region, _ := m.matchmakerRegion(now, m.entriesIndexes(currentMatchedEntries))
 
 				// Remove the found combos from currently tracked list.
 				entryCombos = append(entryCombos[:foundComboIdx], entryCombos[foundComboIdx+1:]...)
 
+				if scoreFn != nil {
+					// Keep looking for other candidates, the score hook decides which one is matched.
+					candidates = append(candidates, currentMatchedEntries)
+					candidateRegions = append(candidateRegions, region)
+					if len(candidates) < m.config.GetMatchmaker().MaxCandidates {
+						continue
+					}
+					break
+				}
+
 				matchedEntries = append(matchedEntries, currentMatchedEntries)
+				matchedRegions = append(matchedRegions, region)
 
 				// Remove all entries/indexes that have just matched. It must be done here so any following process iterations
 				// cannot pick up the same tickets to match against.
-				ticketsToDelete := make(map[string]struct{}, len(currentMatchedEntries))
-				for _, entry := range currentMatchedEntries {
-					if _, ok := ticketsToDelete[entry.Ticket]; !ok {
-						m.batch.Delete(bluge.Identifier(entry.Ticket))
-						ticketsToDelete[entry.Ticket] = struct{}{}
-					}
-					delete(m.entries, entry.Ticket)
-					delete(m.indexes, entry.Ticket)
-					delete(m.activeIndexes, entry.Ticket)
-					delete(m.revCache, entry.Ticket)
-					if sessionTickets, ok := m.sessionTickets[entry.Presence.SessionId]; ok {
-						if l := len(sessionTickets); l <= 1 {
-							delete(m.sessionTickets, entry.Presence.SessionId)
-						} else {
-							delete(sessionTickets, entry.Ticket)
-						}
-					}
-					if entry.PartyId != "" {
-						if partyTickets, ok := m.partyTickets[entry.PartyId]; ok {
-							if l := len(partyTickets); l <= 1 {
-								delete(m.partyTickets, entry.PartyId)
-							} else {
-								delete(partyTickets, entry.Ticket)
-							}
-						}
-					}
-				}
-				if err := m.indexWriter.Batch(m.batch); err != nil {
-					m.logger.Error("error deleting matchmaker process entries batch", zap.Error(err))
-				}
-				m.batch.Reset()
+				m.removeMatchedEntries(currentMatchedEntries)
 
 				break
 			}
 		}
+
+		if len(candidates) > 0 {
+			scoreRequests = append(scoreRequests, &matchmakerScoreRequest{
+				candidates: candidates,
+				regions:    candidateRegions,
+			})
+		}
 	}
 
 	m.Unlock()
 
+	if len(scoreRequests) > 0 {
+		// Scoring must finish before the next interval starts.
+		ctx, ctxCancelFn := context.WithTimeout(m.ctx, time.Duration(queueConfig.IntervalSec)*time.Second)
+		for _, request := range scoreRequests {
+			request.ranked = m.rankCandidates(ctx, scoreFn, request.candidates)
+		}
+		ctxCancelFn()
+
+		m.Lock()
+		for _, request := range scoreRequests {
+			for _, i := range request.ranked {
+				currentMatchedEntries := request.candidates[i]
+				if !m.candidateAvailable(currentMatchedEntries) {
+					// Tickets were removed, or matched through another candidate, while the hook was running.
+					continue
+				}
+				matchedEntries = append(matchedEntries, currentMatchedEntries)
+				matchedRegions = append(matchedRegions, request.regions[i])
+
+				// Remove all entries/indexes that have just matched, so no other candidate can pick up the same tickets.
+				m.removeMatchedEntries(currentMatchedEntries)
+				break
+			}
+		}
+		m.Unlock()
+	}
+
+	for _, result := range backfillResults {
+		m.sendBackfillMatched(result)
+	}
+
 	if matchedEntriesCount := len(matchedEntries); matchedEntriesCount > 0 {
 		wg := &sync.WaitGroup{}
 		wg.Add(matchedEntriesCount)
-		for _, entries := range matchedEntries {
-			go func(entries []*MatchmakerEntry) {
+		for i, entries := range matchedEntries {
+			go func(entries []*MatchmakerEntry, region string) {
 				var tokenOrMatchID string
 				var isMatchID bool
 				var err error
@@ -639,7 +907,12 @@ func (m *LocalMatchmaker) Process() {
 				// Check if there's a matchmaker matched runtime callback, call it, and see if it returns a match ID.
 				fn := m.runtime.MatchmakerMatched()
 				if fn != nil {
-					tokenOrMatchID, isMatchID, err = fn(context.Background(), entries)
+					ctx := context.Background()
+					if region != "" {
+						// Expose the chosen region to the hook, and to any match it creates.
+						ctx = context.WithValue(ctx, RUNTIME_CTX_MATCHMAKER_REGION, region)
+					}
+					tokenOrMatchID, isMatchID, err = fn(ctx, entries)
 					if err != nil {
 						m.logger.Error("Error running Matchmaker Matched hook.", zap.Error(err))
 					}
@@ -687,7 +960,7 @@ func (m *LocalMatchmaker) Process() {
 					m.router.SendToPresenceIDs(m.logger, []*PresenceID{{Node: entry.Presence.Node, SessionID: entry.Presence.SessionID}}, outgoing, true)
 				}
 				wg.Done()
-			}(entries)
+			}(entries, matchedRegions[i])
 		}
 		wg.Wait()
 		if m.matchedEntriesFn != nil {
@@ -696,12 +969,17 @@ func (m *LocalMatchmaker) Process() {
 	}
 }
 
-func (m *LocalMatchmaker) Add(ctx context.Context, presences []*MatchmakerPresence, sessionID, partyId, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, int64, error) {
+func (m *LocalMatchmaker) Add(ctx context.Context, queue string, presences []*MatchmakerPresence, sessionID, partyId, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, int64, error) {
 	// Check if the matchmaker has been stopped.
 	if m.stopped.Load() {
 		return "", 0, runtime.ErrMatchmakerNotAvailable
 	}
 
+	q, err := m.namedQueue(queue)
+	if err != nil {
+		return "", 0, err
+	}
+
 	parsedQuery, err := ParseQueryString(query)
 	if err != nil {
 		return "", 0, runtime.ErrMatchmakerQueryInvalid
@@ -730,6 +1008,17 @@ func (m *LocalMatchmaker) Add(ctx context.Context, presences []*MatchmakerPresen
 		}
 		sessionIDs[presence.SessionId] = struct{}{}
 	}
+	// Find users who have blocked, or been blocked by, any of the users on this ticket.
+	var blockedUsers map[string]struct{}
+	if m.blockGraph != nil {
+		userIDs := make([]string, 0, len(presences))
+		for _, presence := range presences {
+			userIDs = append(userIDs, presence.UserId)
+		}
+		if blockedUsers, err = m.blockGraph.Blocked(ctx, userIDs); err != nil {
+			return "", 0, runtime.ErrMatchmakerNotAvailable
+		}
+	}
 	// Prepare index data.
 	createdAt := time.Now().UTC().UnixNano()
 	index := &MatchmakerIndex{
@@ -739,6 +1028,7 @@ func (m *LocalMatchmaker) Add(ctx context.Context, presences []*MatchmakerPresen
 		MaxCount:   maxCount,
 		PartyId:    partyId,
 		CreatedAt:  createdAt,
+		Queue:      q.queue,
 
 		Query:             query,
 		Count:             len(presences),
@@ -749,52 +1039,54 @@ func (m *LocalMatchmaker) Add(ctx context.Context, presences []*MatchmakerPresen
 		Node:              m.node,
 		StringProperties:  stringProperties,
 		NumericProperties: numericProperties,
+		Rtts:              matchmakerRtts(numericProperties),
+		BlockedUsers:      blockedUsers,
 		ParsedQuery:       parsedQuery,
 	}
 
-	m.Lock()
+	q.Lock()
 
 	select {
 	case <-ctx.Done():
-		m.Unlock()
+		q.Unlock()
 		return "", 0, nil
 	default:
 	}
 
-	// Check if all presences are allowed to create more tickets.
+	// Check if all presences are allowed to create more tickets in this queue.
 	for _, presence := range presences {
-		if existingTickets := m.sessionTickets[presence.SessionId]; len(existingTickets) >= m.config.GetMatchmaker().MaxTickets {
-			m.Unlock()
+		if len(q.sessionTickets[presence.SessionId]) >= q.queueConfig.MaxTickets {
+			q.Unlock()
 			return "", 0, runtime.ErrMatchmakerTooManyTickets
 		}
 	}
-	// Check if party is allowed to create more tickets.
+	// Check if party is allowed to create more tickets in this queue.
 	if partyId != "" {
-		if existingTickets := m.partyTickets[partyId]; len(existingTickets) >= m.config.GetMatchmaker().MaxTickets {
-			m.Unlock()
+		if len(q.partyTickets[partyId]) >= q.queueConfig.MaxTickets {
+			q.Unlock()
 			return "", 0, runtime.ErrMatchmakerTooManyTickets
 		}
 	}
 
 	matchmakerIndexDoc, err := MapMatchmakerIndex(ticket, index)
 	if err != nil {
-		m.Unlock()
-		m.logger.Error("error mapping matchmaker index document", zap.Error(err))
+		q.Unlock()
+		q.logger.Error("error mapping matchmaker index document", zap.Error(err))
 		return "", 0, runtime.ErrMatchmakerIndex
 	}
 
-	if err := m.indexWriter.Update(bluge.Identifier(ticket), matchmakerIndexDoc); err != nil {
-		m.Unlock()
-		m.logger.Error("error indexing matchmaker entries", zap.Error(err))
+	if err := q.indexWriter.Update(bluge.Identifier(ticket), matchmakerIndexDoc); err != nil {
+		q.Unlock()
+		q.logger.Error("error indexing matchmaker entries", zap.Error(err))
 		return "", 0, runtime.ErrMatchmakerIndex
 	}
 
 	entries := make([]*MatchmakerEntry, 0, len(presences))
 	for _, presence := range presences {
-		if _, ok := m.sessionTickets[presence.SessionId]; ok {
-			m.sessionTickets[presence.SessionId][ticket] = struct{}{}
+		if _, ok := q.sessionTickets[presence.SessionId]; ok {
+			q.sessionTickets[presence.SessionId][ticket] = struct{}{}
 		} else {
-			m.sessionTickets[presence.SessionId] = map[string]struct{}{ticket: {}}
+			q.sessionTickets[presence.SessionId] = map[string]struct{}{ticket: {}}
 		}
 		entries = append(entries, &MatchmakerEntry{
 			Ticket:            ticket,
@@ -806,17 +1098,17 @@ func (m *LocalMatchmaker) Add(ctx context.Context, presences []*MatchmakerPresen
 		})
 	}
 	if partyId != "" {
-		if _, ok := m.partyTickets[partyId]; ok {
-			m.partyTickets[partyId][ticket] = struct{}{}
+		if _, ok := q.partyTickets[partyId]; ok {
+			q.partyTickets[partyId][ticket] = struct{}{}
 		} else {
-			m.partyTickets[partyId] = map[string]struct{}{ticket: {}}
+			q.partyTickets[partyId] = map[string]struct{}{ticket: {}}
 		}
 	}
-	m.entries[ticket] = entries
-	m.indexes[ticket] = index
-	m.activeIndexes[ticket] = index
+	q.entries[ticket] = entries
+	q.indexes[ticket] = index
+	q.activeIndexes[ticket] = index
 
-	m.Unlock()
+	q.Unlock()
 	return ticket, createdAt, nil
 }
 
@@ -828,6 +1120,26 @@ func (m *LocalMatchmaker) Insert(extracts []*MatchmakerExtract) error {
 		return nil
 	}
 
+	// Extracts from a node without named queues have no queue, and go to the default queue.
+	queueExtracts := make(map[*LocalMatchmaker][]*MatchmakerExtract, len(m.namedQueues)+1)
+	for _, extract := range extracts {
+		q, err := m.namedQueue(extract.Queue)
+		if err != nil {
+			m.logger.Error("error finding matchmaker queue", zap.String("queue", extract.Queue), zap.String("ticket", extract.Ticket))
+			continue
+		}
+		queueExtracts[q] = append(queueExtracts[q], extract)
+	}
+
+	for q, extracts := range queueExtracts {
+		if err := q.insert(extracts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *LocalMatchmaker) insert(extracts []*MatchmakerExtract) error {
 	batch := bluge.NewBatch()
 	indexes := make(map[string]*MatchmakerIndex, len(extracts))
 	entries := make(map[string][]*MatchmakerEntry, len(extracts))
@@ -862,6 +1174,14 @@ func (m *LocalMatchmaker) Insert(extracts []*MatchmakerExtract) error {
 			sessionIDs[presence.SessionId] = struct{}{}
 		}
 
+		var blockedUsers map[string]struct{}
+		if len(extract.BlockedUsers) != 0 {
+			blockedUsers = make(map[string]struct{}, len(extract.BlockedUsers))
+			for _, userID := range extract.BlockedUsers {
+				blockedUsers[userID] = struct{}{}
+			}
+		}
+
 		index := &MatchmakerIndex{
 			Ticket:     extract.Ticket,
 			Properties: properties,
@@ -869,6 +1189,7 @@ func (m *LocalMatchmaker) Insert(extracts []*MatchmakerExtract) error {
 			MaxCount:   extract.MaxCount,
 			PartyId:    extract.PartyId,
 			CreatedAt:  extract.CreatedAt,
+			Queue:      m.queue,
 
 			Query:             extract.Query,
 			Count:             len(extract.Presences),
@@ -879,6 +1200,8 @@ func (m *LocalMatchmaker) Insert(extracts []*MatchmakerExtract) error {
 			Node:              extract.Node,
 			StringProperties:  extract.StringProperties,
 			NumericProperties: extract.NumericProperties,
+			Rtts:              matchmakerRtts(extract.NumericProperties),
+			BlockedUsers:      blockedUsers,
 			ParsedQuery:       parsedQuery,
 		}
 
@@ -914,7 +1237,7 @@ func (m *LocalMatchmaker) Insert(extracts []*MatchmakerExtract) error {
 	}
 	for ticket, index := range indexes {
 		m.indexes[ticket] = index
-		if index.Intervals < m.config.GetMatchmaker().MaxIntervals {
+		if index.Intervals < m.queueConfig.MaxIntervals {
 			m.activeIndexes[ticket] = index
 		}
 		if index.PartyId != "" {
@@ -947,6 +1270,13 @@ func (m *LocalMatchmaker) Extract() []*MatchmakerExtract {
 	}
 
 	extracts := make([]*MatchmakerExtract, 0, 100)
+	for _, q := range m.allQueues() {
+		extracts = q.extract(extracts)
+	}
+	return extracts
+}
+
+func (m *LocalMatchmaker) extract(extracts []*MatchmakerExtract) []*MatchmakerExtract {
 	m.Lock()
 
 	for ticket, index := range m.indexes {
@@ -974,10 +1304,17 @@ func (m *LocalMatchmaker) Extract() []*MatchmakerExtract {
 			Intervals:         index.Intervals,
 			CreatedAt:         index.CreatedAt,
 			Node:              index.Node,
+			Queue:             index.Queue,
 		}
 		for _, entry := range entries {
 			extract.Presences = append(extract.Presences, entry.Presence)
 		}
+		if len(index.BlockedUsers) != 0 {
+			extract.BlockedUsers = make([]string, 0, len(index.BlockedUsers))
+			for userID := range index.BlockedUsers {
+				extract.BlockedUsers = append(extract.BlockedUsers, userID)
+			}
+		}
 
 		extracts = append(extracts, extract)
 	}
@@ -988,6 +1325,15 @@ func (m *LocalMatchmaker) Extract() []*MatchmakerExtract {
 }
 
 func (m *LocalMatchmaker) RemoveSession(sessionID, ticket string) error {
+	for _, q := range m.allQueues() {
+		if err := q.removeSession(sessionID, ticket); err != runtime.ErrMatchmakerTicketNotFound {
+			return err
+		}
+	}
+	return runtime.ErrMatchmakerTicketNotFound
+}
+
+func (m *LocalMatchmaker) removeSession(sessionID, ticket string) error {
 	m.Lock()
 
 	index, ok := m.indexes[ticket]
@@ -1038,6 +1384,16 @@ func (m *LocalMatchmaker) RemoveSession(sessionID, ticket string) error {
 }
 
 func (m *LocalMatchmaker) RemoveSessionAll(sessionID string) error {
+	var err error
+	for _, q := range m.allQueues() {
+		if qErr := q.removeSessionAll(sessionID); qErr != nil {
+			err = qErr
+		}
+	}
+	return err
+}
+
+func (m *LocalMatchmaker) removeSessionAll(sessionID string) error {
 	batch := bluge.NewBatch()
 
 	m.Lock()
@@ -1105,6 +1461,15 @@ func (m *LocalMatchmaker) RemoveSessionAll(sessionID string) error {
 }
 
 func (m *LocalMatchmaker) RemoveParty(partyID, ticket string) error {
+	for _, q := range m.allQueues() {
+		if err := q.removeParty(partyID, ticket); err != runtime.ErrMatchmakerTicketNotFound {
+			return err
+		}
+	}
+	return runtime.ErrMatchmakerTicketNotFound
+}
+
+func (m *LocalMatchmaker) removeParty(partyID, ticket string) error {
 	m.Lock()
 
 	index, ok := m.indexes[ticket]
@@ -1153,6 +1518,16 @@ func (m *LocalMatchmaker) RemoveParty(partyID, ticket string) error {
 }
 
 func (m *LocalMatchmaker) RemovePartyAll(partyID string) error {
+	var err error
+	for _, q := range m.allQueues() {
+		if qErr := q.removePartyAll(partyID); qErr != nil {
+			err = qErr
+		}
+	}
+	return err
+}
+
+func (m *LocalMatchmaker) removePartyAll(partyID string) error {
 	batch := bluge.NewBatch()
 
 	m.Lock()
@@ -1206,6 +1581,12 @@ func (m *LocalMatchmaker) RemovePartyAll(partyID string) error {
 }
 
 func (m *LocalMatchmaker) RemoveAll(node string) {
+	for _, q := range m.allQueues() {
+		q.removeAll(node)
+	}
+}
+
+func (m *LocalMatchmaker) removeAll(node string) {
 	batch := bluge.NewBatch()
 
 	m.Lock()
@@ -1250,6 +1631,16 @@ func (m *LocalMatchmaker) RemoveAll(node string) {
 		}
 	}
 
+	for ticket, backfill := range m.backfills {
+		if backfill.Node != node {
+			continue
+		}
+
+		batch.Delete(bluge.Identifier(ticket))
+
+		m.removeBackfillLocked(backfill)
+	}
+
 	err := m.indexWriter.Batch(batch)
 	m.Unlock()
 	if err != nil {
@@ -1258,6 +1649,12 @@ func (m *LocalMatchmaker) RemoveAll(node string) {
 }
 
 func (m *LocalMatchmaker) Remove(tickets []string) {
+	for _, q := range m.allQueues() {
+		q.remove(tickets)
+	}
+}
+
+func (m *LocalMatchmaker) remove(tickets []string) {
 	batch := bluge.NewBatch()
 
 	m.Lock()
@@ -1310,6 +1707,645 @@ func (m *LocalMatchmaker) Remove(tickets []string) {
 	}
 }
 
+func (m *LocalMatchmaker) AddBackfill(ctx context.Context, queue, matchID, region string, presencesFn func() []*MatchPresence, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, int64, error) {
+	// Check if the matchmaker has been stopped.
+	if m.stopped.Load() {
+		return "", 0, runtime.ErrMatchmakerNotAvailable
+	}
+
+	if count < 1 {
+		return "", 0, ErrMatchmakerBackfillCount
+	}
+
+	q, err := m.namedQueue(queue)
+	if err != nil {
+		return "", 0, err
+	}
+
+	parsedQuery, err := ParseQueryString(query)
+	if err != nil {
+		return "", 0, runtime.ErrMatchmakerQueryInvalid
+	}
+	if parsedQuery, ok := parsedQuery.(ValidatableQuery); ok {
+		if parsedQuery.Validate() != nil {
+			return "", 0, runtime.ErrMatchmakerQueryInvalid
+		}
+	}
+
+	// Merge incoming properties.
+	properties := make(map[string]interface{}, len(stringProperties)+len(numericProperties))
+	for k, v := range stringProperties {
+		properties[k] = v
+	}
+	for k, v := range numericProperties {
+		properties[k] = v
+	}
+	// Generate a ticket ID.
+	ticket := uuid.Must(uuid.NewV4()).String()
+	createdAt := time.Now().UTC().UnixNano()
+	backfill := &MatchmakerBackfill{
+		Ticket:            ticket,
+		MatchId:           matchID,
+		Query:             query,
+		Count:             count,
+		Properties:        properties,
+		StringProperties:  stringProperties,
+		NumericProperties: numericProperties,
+		CreatedAt:         createdAt,
+		Node:              m.node,
+		Queue:             q.queue,
+		ParsedQuery:       parsedQuery,
+		PresencesFn:       presencesFn,
+		Region:            region,
+	}
+
+	q.Lock()
+
+	select {
+	case <-ctx.Done():
+		q.Unlock()
+		return "", 0, nil
+	default:
+	}
+
+	// Check if the match is allowed to create more backfill requests in this queue.
+	if len(q.matchBackfills[matchID]) >= q.queueConfig.MaxTickets {
+		q.Unlock()
+		return "", 0, runtime.ErrMatchmakerTooManyTickets
+	}
+
+	matchmakerBackfillDoc, err := MapMatchmakerBackfill(ticket, backfill)
+	if err != nil {
+		q.Unlock()
+		q.logger.Error("error mapping matchmaker backfill document", zap.Error(err))
+		return "", 0, runtime.ErrMatchmakerIndex
+	}
+
+	if err := q.indexWriter.Update(bluge.Identifier(ticket), matchmakerBackfillDoc); err != nil {
+		q.Unlock()
+		q.logger.Error("error indexing matchmaker backfill", zap.Error(err))
+		return "", 0, runtime.ErrMatchmakerIndex
+	}
+
+	if _, ok := q.matchBackfills[matchID]; ok {
+		q.matchBackfills[matchID][ticket] = struct{}{}
+	} else {
+		q.matchBackfills[matchID] = map[string]struct{}{ticket: {}}
+	}
+	q.backfills[ticket] = backfill
+
+	q.Unlock()
+	return ticket, createdAt, nil
+}
+
+func (m *LocalMatchmaker) RemoveBackfill(matchID, ticket string) error {
+	for _, q := range m.allQueues() {
+		if err := q.removeBackfill(matchID, ticket); err != runtime.ErrMatchmakerTicketNotFound {
+			return err
+		}
+	}
+	return runtime.ErrMatchmakerTicketNotFound
+}
+
+func (m *LocalMatchmaker) removeBackfill(matchID, ticket string) error {
+	m.Lock()
+
+	backfill, ok := m.backfills[ticket]
+	if !ok || backfill.MatchId != matchID {
+		// Ticket did not exist, or the caller was not the ticket owner.
+		m.Unlock()
+		return runtime.ErrMatchmakerTicketNotFound
+	}
+
+	m.removeBackfillLocked(backfill)
+
+	if err := m.indexWriter.Delete(bluge.Identifier(ticket)); err != nil {
+		m.Unlock()
+		m.logger.Error("error deleting matchmaker backfill", zap.Error(err))
+		return runtime.ErrMatchmakerDelete
+	}
+
+	m.Unlock()
+	return nil
+}
+
+func (m *LocalMatchmaker) RemoveBackfillAll(matchID string) error {
+	var err error
+	for _, q := range m.allQueues() {
+		if qErr := q.removeBackfillAll(matchID); qErr != nil {
+			err = qErr
+		}
+	}
+	return err
+}
+
+func (m *LocalMatchmaker) removeBackfillAll(matchID string) error {
+	batch := bluge.NewBatch()
+
+	m.Lock()
+
+	matchBackfills, ok := m.matchBackfills[matchID]
+	if !ok {
+		// Match does not have any active backfill requests.
+		m.Unlock()
+		return nil
+	}
+
+	for ticket := range matchBackfills {
+		batch.Delete(bluge.Identifier(ticket))
+		delete(m.backfills, ticket)
+		delete(m.revCache, ticket)
+	}
+	delete(m.matchBackfills, matchID)
+
+	err := m.indexWriter.Batch(batch)
+	m.Unlock()
+	if err != nil {
+		m.logger.Error("error deleting matchmaker backfill batch", zap.Error(err))
+		return runtime.ErrMatchmakerDelete
+	}
+	return nil
+}
+
+// Must be called with the matchmaker lock held. Does not remove the backfill document from the index.
+func (m *LocalMatchmaker) removeBackfillLocked(backfill *MatchmakerBackfill) {
+	delete(m.backfills, backfill.Ticket)
+	delete(m.revCache, backfill.Ticket)
+	if matchBackfills, ok := m.matchBackfills[backfill.MatchId]; ok {
+		if l := len(matchBackfills); l <= 1 {
+			delete(m.matchBackfills, backfill.MatchId)
+		} else {
+			delete(matchBackfills, backfill.Ticket)
+		}
+	}
+}
+
+// Must be called without the matchmaker lock held.
+// rankCandidates asks the matchmaker score hook to score the candidate groups found for a single ticket, and returns
+// their positions from highest to lowest score. Candidates scored below zero are vetoed and left out. If the hook
+// fails, does not return before the context deadline, or returns an unexpected number of scores, the candidates are
+// ranked in the order they were found.
+func (m *LocalMatchmaker) rankCandidates(ctx context.Context, fn RuntimeMatchmakerScoreFunction, candidates [][]*MatchmakerEntry) []int {
+	type scoreResult struct {
+		scores []float64
+		err    error
+	}
+	resultCh := make(chan *scoreResult, 1)
+	go func() {
+		scores, err := fn(ctx, candidates)
+		resultCh <- &scoreResult{scores: scores, err: err}
+	}()
+
+	ranked := make([]int, 0, len(candidates))
+	var result *scoreResult
+	select {
+	case <-ctx.Done():
+		result = &scoreResult{err: ctx.Err()}
+	case result = <-resultCh:
+	}
+	if result.err != nil || len(result.scores) != len(candidates) {
+		if result.err != nil {
+			m.logger.Error("error running matchmaker score hook", zap.Error(result.err))
+		} else {
+			m.logger.Error("matchmaker score hook returned unexpected number of scores", zap.Int("candidates", len(candidates)), zap.Int("scores", len(result.scores)))
+		}
+		for i := range candidates {
+			ranked = append(ranked, i)
+		}
+		return ranked
+	}
+
+	for i, score := range result.scores {
+		if score < 0 {
+			// Candidate vetoed.
+			continue
+		}
+		ranked = append(ranked, i)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return result.scores[ranked[i]] > result.scores[ranked[j]]
+	})
+	return ranked
+}
+
+// Must be called with the matchmaker lock held.
+// candidateAvailable reports whether every ticket in a candidate group is still in the pool.
+func (m *LocalMatchmaker) candidateAvailable(entries []*MatchmakerEntry) bool {
+	for _, entry := range entries {
+		if _, found := m.indexes[entry.Ticket]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchmakerRtts extracts the round trip times to each region reported in ticket numeric properties.
+func matchmakerRtts(numericProperties map[string]float64) map[string]float64 {
+	var rtts map[string]float64
+	for k, v := range numericProperties {
+		if region := strings.TrimPrefix(k, MatchmakerRttPropertyPrefix); region != k && region != "" {
+			if rtts == nil {
+				rtts = make(map[string]float64, len(numericProperties))
+			}
+			rtts[region] = v
+		}
+	}
+	return rtts
+}
+
+// blocked reports whether any user on one ticket has blocked, or been blocked by, any user on the other.
+func (m *LocalMatchmaker) blocked(a, b *MatchmakerIndex) bool {
+	if len(a.BlockedUsers) == 0 && len(b.BlockedUsers) == 0 {
+		return false
+	}
+	for _, entry := range m.entries[b.Ticket] {
+		if _, found := a.BlockedUsers[entry.Presence.UserId]; found {
+			return true
+		}
+	}
+	for _, entry := range m.entries[a.Ticket] {
+		if _, found := b.BlockedUsers[entry.Presence.UserId]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// Must be called without the matchmaker lock held.
+// refreshBlocked looks up the current block relations of the users on every ticket in the pool, and of the players in
+// every match with a backfill request. Tickets and backfill requests whose relations could not be loaded are left out,
+// and keep the relations they were last checked with.
+func (m *LocalMatchmaker) refreshBlocked() (map[string]map[string]struct{}, map[string]map[string]struct{}) {
+	if m.blockGraph == nil {
+		return nil, nil
+	}
+
+	m.Lock()
+	ticketUsers := make(map[string][]string, len(m.indexes))
+	for ticket := range m.indexes {
+		entries := m.entries[ticket]
+		userIDs := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			userIDs = append(userIDs, entry.Presence.UserId)
+		}
+		ticketUsers[ticket] = userIDs
+	}
+	backfillPresencesFns := make(map[string]func() []*MatchPresence, len(m.backfills))
+	for ticket, backfill := range m.backfills {
+		if backfill.PresencesFn != nil {
+			backfillPresencesFns[ticket] = backfill.PresencesFn
+		}
+	}
+	m.Unlock()
+
+	backfillUsers := make(map[string][]string, len(backfillPresencesFns))
+	for ticket, presencesFn := range backfillPresencesFns {
+		presences := presencesFn()
+		userIDs := make([]string, 0, len(presences))
+		for _, presence := range presences {
+			userIDs = append(userIDs, presence.UserID.String())
+		}
+		backfillUsers[ticket] = userIDs
+	}
+
+	// Load every user at once first, so the lookups for each ticket below are served from the block graph cache.
+	seen := make(map[string]struct{}, len(ticketUsers))
+	allUserIDs := make([]string, 0, len(ticketUsers))
+	for _, users := range []map[string][]string{ticketUsers, backfillUsers} {
+		for _, userIDs := range users {
+			for _, userID := range userIDs {
+				if _, found := seen[userID]; !found {
+					seen[userID] = struct{}{}
+					allUserIDs = append(allUserIDs, userID)
+				}
+			}
+		}
+	}
+	if len(allUserIDs) == 0 {
+		return nil, nil
+	}
+	if _, err := m.blockGraph.Blocked(m.ctx, allUserIDs); err != nil {
+		m.logger.Error("error refreshing matchmaker block relations", zap.Error(err))
+		return nil, nil
+	}
+
+	lookup := func(users map[string][]string) map[string]map[string]struct{} {
+		blocked := make(map[string]map[string]struct{}, len(users))
+		for ticket, userIDs := range users {
+			blockedUsers, err := m.blockGraph.Blocked(m.ctx, userIDs)
+			if err != nil {
+				m.logger.Error("error refreshing matchmaker block relations", zap.Error(err), zap.String("ticket", ticket))
+				continue
+			}
+			blocked[ticket] = blockedUsers
+		}
+		return blocked
+	}
+	return lookup(ticketUsers), lookup(backfillUsers)
+}
+
+// blockedBackfill reports whether any user on the ticket has blocked, or been blocked by, any player in the match
+// making the backfill request, or any user on the tickets already selected to fill it.
+func (m *LocalMatchmaker) blockedBackfill(backfill *MatchmakerBackfill, selected []*MatchmakerIndex, index *MatchmakerIndex) bool {
+	if len(backfill.BlockedUsers) != 0 {
+		for _, entry := range m.entries[index.Ticket] {
+			if _, found := backfill.BlockedUsers[entry.Presence.UserId]; found {
+				return true
+			}
+		}
+	}
+	for _, selectedIndex := range selected {
+		if m.blocked(selectedIndex, index) {
+			return true
+		}
+	}
+	return false
+}
+
+// entriesIndexes returns the unique indexes for the tickets of the given entries, plus any extra indexes.
+func (m *LocalMatchmaker) entriesIndexes(entries []*MatchmakerEntry, extra ...*MatchmakerIndex) []*MatchmakerIndex {
+	indexes := make([]*MatchmakerIndex, 0, len(entries)+len(extra))
+	seen := make(map[string]struct{}, len(entries)+len(extra))
+	for _, index := range extra {
+		seen[index.Ticket] = struct{}{}
+		indexes = append(indexes, index)
+	}
+	for _, entry := range entries {
+		if _, found := seen[entry.Ticket]; found {
+			continue
+		}
+		seen[entry.Ticket] = struct{}{}
+		if index, ok := m.indexes[entry.Ticket]; ok {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// matchmakerRegion chooses the region with the lowest worst-case round trip time that every given ticket reporting
+// round trip times can reach within its allowed maximum. The allowed maximum widens the longer a ticket has waited.
+// Tickets that do not report round trip times do not restrict the region. If no ticket reports round trip times the
+// region is empty, if no region satisfies every ticket the tickets cannot be matched together.
+func (m *LocalMatchmaker) matchmakerRegion(now int64, indexes []*MatchmakerIndex) (string, bool) {
+	var reporting *MatchmakerIndex
+	for _, index := range indexes {
+		if len(index.Rtts) != 0 {
+			reporting = index
+			break
+		}
+	}
+	if reporting == nil {
+		return "", true
+	}
+
+	// Any region every ticket can reach must be reported by the first reporting ticket, so only its regions are candidates.
+	var region string
+	var regionRtt float64
+regionLoop:
+	for candidate := range reporting.Rtts {
+		var worstRtt float64
+		for _, index := range indexes {
+			if len(index.Rtts) == 0 {
+				continue
+			}
+			rtt, found := index.Rtts[candidate]
+			if !found || rtt > m.allowedRtt(now, index) {
+				continue regionLoop
+			}
+			if rtt > worstRtt {
+				worstRtt = rtt
+			}
+		}
+		if region == "" || worstRtt < regionRtt || (worstRtt == regionRtt && candidate < region) {
+			region = candidate
+			regionRtt = worstRtt
+		}
+	}
+
+	return region, region != ""
+}
+
+// allowedRtt returns the maximum round trip time a ticket accepts, widened by how long it has waited.
+func (m *LocalMatchmaker) allowedRtt(now int64, index *MatchmakerIndex) float64 {
+	config := m.config.GetMatchmaker()
+	waitedSec := float64(now-index.CreatedAt) / float64(time.Second)
+	if waitedSec < 0 {
+		waitedSec = 0
+	}
+	return math.Min(float64(config.RttMaxMs)+waitedSec*float64(config.RttWidenMs), float64(config.RttLimitMs))
+}
+
+func (m *LocalMatchmaker) removeMatchedEntries(matchedEntries []*MatchmakerEntry) {
+	ticketsToDelete := make(map[string]struct{}, len(matchedEntries))
+	for _, entry := range matchedEntries {
+		if _, ok := ticketsToDelete[entry.Ticket]; !ok {
+			m.batch.Delete(bluge.Identifier(entry.Ticket))
+			ticketsToDelete[entry.Ticket] = struct{}{}
+		}
+		delete(m.entries, entry.Ticket)
+		delete(m.indexes, entry.Ticket)
+		delete(m.activeIndexes, entry.Ticket)
+		delete(m.revCache, entry.Ticket)
+		if sessionTickets, ok := m.sessionTickets[entry.Presence.SessionId]; ok {
+			if l := len(sessionTickets); l <= 1 {
+				delete(m.sessionTickets, entry.Presence.SessionId)
+			} else {
+				delete(sessionTickets, entry.Ticket)
+			}
+		}
+		if entry.PartyId != "" {
+			if partyTickets, ok := m.partyTickets[entry.PartyId]; ok {
+				if l := len(partyTickets); l <= 1 {
+					delete(m.partyTickets, entry.PartyId)
+				} else {
+					delete(partyTickets, entry.Ticket)
+				}
+			}
+		}
+	}
+	if err := m.indexWriter.Batch(m.batch); err != nil {
+		m.logger.Error("error deleting matchmaker process entries batch", zap.Error(err))
+	}
+	m.batch.Reset()
+}
+
+// Must be called with the matchmaker lock held. Fills as many open slots as possible in each backfill request, oldest
+// requests first, and removes any matched tickets from the pool along with any backfill requests that are now full.
+func (m *LocalMatchmaker) processBackfills(now int64) []*matchmakerBackfillResult {
+	backfills := make([]*MatchmakerBackfill, 0, len(m.backfills))
+	for _, backfill := range m.backfills {
+		backfills = append(backfills, backfill)
+	}
+	sort.Slice(backfills, func(i, j int) bool {
+		return backfills[i].CreatedAt < backfills[j].CreatedAt
+	})
+
+	results := make([]*matchmakerBackfillResult, 0, len(backfills))
+	for _, backfill := range backfills {
+		indexQuery := bluge.NewBooleanQuery()
+
+		// Results must match the backfill query string.
+		indexQuery.AddMust(backfill.ParsedQuery)
+
+		// Results must be player tickets, backfill requests do not have a count range.
+		countRange := bluge.NewNumericRangeInclusiveQuery(
+			math.Inf(-1), math.Inf(1), true, true).
+			SetField("max_count")
+		indexQuery.AddMust(countRange)
+
+		searchRequest := bluge.NewTopNSearch(len(m.indexes), indexQuery)
+		// Sort results to try and select the best match, or if the
+		// matches are equivalent, the longest waiting tickets first.
+		searchRequest.SortBy([]string{"-_score", "created_at"})
+
+		indexReader, err := m.indexWriter.Reader()
+		if err != nil {
+			m.logger.Error("error accessing index reader", zap.Error(err))
+			continue
+		}
+
+		result, err := indexReader.Search(m.ctx, searchRequest)
+		if err != nil {
+			_ = indexReader.Close()
+			m.logger.Error("error searching index", zap.Error(err))
+			continue
+		}
+
+		blugeMatches, err := IterateBlugeMatches(result, map[string]struct{}{}, m.logger)
+		if err != nil {
+			_ = indexReader.Close()
+			m.logger.Error("error iterating search results", zap.Error(err))
+			continue
+		}
+
+		var matchedEntries []*MatchmakerEntry
+		var matchedIndexes []*MatchmakerIndex
+		sessionIDs := make(map[string]struct{}, backfill.Count)
+		for _, hit := range blugeMatches.Hits {
+			if len(matchedEntries) >= backfill.Count {
+				break
+			}
+
+			hitIndex, ok := m.indexes[hit.ID]
+			if !ok {
+				// Ticket did not exist, should not happen.
+				m.logger.Warn("matchmaker process backfill missing index", zap.String("ticket", hit.ID))
+				continue
+			}
+
+			if hitIndex.Count > backfill.Count-len(matchedEntries) {
+				// Not enough open slots left for every player on this ticket.
+				continue
+			}
+
+			// Check if the ticket can reach the match's region within its allowed round trip time.
+			if backfill.Region != "" && len(hitIndex.Rtts) != 0 {
+				if rtt, found := hitIndex.Rtts[backfill.Region]; !found || rtt > m.allowedRtt(now, hitIndex) {
+					continue
+				}
+			}
+
+			if m.queueConfig.RevPrecision {
+				mutualMatch, err := validateMatch(m, indexReader, hitIndex.ParsedQuery, hit.ID, backfill.Ticket)
+				if err != nil {
+					m.logger.Error("error validating mutual backfill match", zap.Error(err))
+					continue
+				} else if !mutualMatch {
+					// The backfill request does not satisfy the search hit's own query.
+					continue
+				}
+			}
+
+			// Check if there are overlapping session IDs with tickets already selected for this backfill.
+			var sessionIdConflict bool
+			for sessionID := range hitIndex.SessionIDs {
+				if _, found := sessionIDs[sessionID]; found {
+					sessionIdConflict = true
+					break
+				}
+			}
+			if sessionIdConflict {
+				continue
+			}
+
+			// Check if any users on the ticket have blocked, or been blocked by, players in the match or other selected users.
+			if m.blockedBackfill(backfill, matchedIndexes, hitIndex) {
+				continue
+			}
+
+			entries, ok := m.entries[hit.ID]
+			if !ok {
+				// Ticket did not exist, should not happen.
+				m.logger.Warn("matchmaker process backfill missing entries", zap.String("ticket", hit.ID))
+				continue
+			}
+
+			for sessionID := range hitIndex.SessionIDs {
+				sessionIDs[sessionID] = struct{}{}
+			}
+			matchedEntries = append(matchedEntries, entries...)
+			matchedIndexes = append(matchedIndexes, hitIndex)
+		}
+
+		if err = indexReader.Close(); err != nil {
+			m.logger.Error("error closing index reader", zap.Error(err))
+		}
+
+		if len(matchedEntries) == 0 {
+			continue
+		}
+
+		// Remove all matched tickets so no following backfill or ordinary matching can pick them up.
+		m.removeMatchedEntries(matchedEntries)
+
+		backfill.Count -= len(matchedEntries)
+		if backfill.Count <= 0 {
+			m.removeBackfillLocked(backfill)
+			if err := m.indexWriter.Delete(bluge.Identifier(backfill.Ticket)); err != nil {
+				m.logger.Error("error deleting matchmaker backfill", zap.Error(err))
+			}
+		}
+
+		results = append(results, &matchmakerBackfillResult{
+			backfill: backfill,
+			entries:  matchedEntries,
+		})
+	}
+
+	return results
+}
+
+// Notify players matched through a backfill request, sending them straight to the match that requested them.
+func (m *LocalMatchmaker) sendBackfillMatched(result *matchmakerBackfillResult) {
+	users := make([]*rtapi.MatchmakerMatched_MatchmakerUser, 0, len(result.entries))
+	for _, entry := range result.entries {
+		users = append(users, &rtapi.MatchmakerMatched_MatchmakerUser{
+			Presence: &rtapi.UserPresence{
+				UserId:    entry.Presence.UserId,
+				SessionId: entry.Presence.SessionId,
+				Username:  entry.Presence.Username,
+			},
+			StringProperties:  entry.StringProperties,
+			NumericProperties: entry.NumericProperties,
+			PartyId:           entry.PartyId,
+		})
+	}
+	outgoing := &rtapi.Envelope{Message: &rtapi.Envelope_MatchmakerMatched{MatchmakerMatched: &rtapi.MatchmakerMatched{
+		// Ticket is set individually below for each recipient.
+		Id:    &rtapi.MatchmakerMatched_MatchId{MatchId: result.backfill.MatchId},
+		Users: users,
+		// Self is set individually below for each recipient.
+	}}}
+
+	for i, entry := range result.entries {
+		// Set per-recipient fields.
+		outgoing.GetMatchmakerMatched().Self = users[i]
+		outgoing.GetMatchmakerMatched().Ticket = entry.Ticket
+		// Route outgoing message.
+		m.router.SendToPresenceIDs(m.logger, []*PresenceID{{Node: entry.Presence.Node, SessionID: entry.Presence.SessionID}}, outgoing, true)
+	}
+}
+
 func MapMatchmakerIndex(id string, in *MatchmakerIndex) (*bluge.Document, error) {
 	rv := bluge.NewDocument(id)
 
@@ -1326,6 +2362,20 @@ func MapMatchmakerIndex(id string, in *MatchmakerIndex) (*bluge.Document, error)
 	return rv, nil
 }
 
+func MapMatchmakerBackfill(id string, in *MatchmakerBackfill) (*bluge.Document, error) {
+	rv := bluge.NewDocument(id)
+
+	rv.AddField(bluge.NewKeywordField("ticket", in.Ticket).StoreValue())
+	rv.AddField(bluge.NewKeywordField("match_id", in.MatchId).StoreValue())
+	rv.AddField(bluge.NewNumericField("created_at", float64(in.CreatedAt)).StoreValue())
+
+	if in.Properties != nil {
+		BlugeWalkDocument(in.Properties, []string{"properties"}, rv)
+	}
+
+	return rv, nil
+}
+
 func validateMatch(m *LocalMatchmaker, r *bluge.Reader, fromTicketQuery bluge.Query, fromTicket, toTicket string) (bool, error) {
 	cache, found := m.revCache[fromTicket]
 	if found {
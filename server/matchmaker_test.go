@@ -19,6 +19,7 @@ import (
 	"errors"
 	"math"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -42,7 +43,7 @@ func TestMatchmakerAddOnly(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -70,7 +71,7 @@ func TestMatchmakerAddRemoveRepeated(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -92,7 +93,7 @@ func TestMatchmakerAddRemoveRepeated(t *testing.T) {
 		t.Fatalf("error matchmaker remove: %v", err)
 	}
 
-	ticket, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -114,7 +115,7 @@ func TestMatchmakerAddRemoveRepeated(t *testing.T) {
 		t.Fatalf("error matchmaker remove: %v", err)
 	}
 
-	ticket, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -136,7 +137,7 @@ func TestMatchmakerAddRemoveRepeated(t *testing.T) {
 		t.Fatalf("error matchmaker remove: %v", err)
 	}
 
-	ticket, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -387,7 +388,7 @@ func TestMatchmakerAddAndRemove(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -426,7 +427,7 @@ func TestMatchmakerAddWithBasicMatch(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -445,7 +446,7 @@ func TestMatchmakerAddWithBasicMatch(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -537,7 +538,7 @@ func TestMatchmakerAddWithMatchOnStar(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		{
 			UserId:    "a",
 			SessionId: "a",
@@ -556,7 +557,7 @@ func TestMatchmakerAddWithMatchOnStar(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -654,7 +655,7 @@ func TestMatchmakerAddWithMatchOnRange(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		{
 			UserId:    "a",
 			SessionId: "a",
@@ -673,7 +674,7 @@ func TestMatchmakerAddWithMatchOnRange(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -771,7 +772,7 @@ func TestMatchmakerAddWithMatchOnRangeAndValue(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -796,7 +797,7 @@ func TestMatchmakerAddWithMatchOnRangeAndValue(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -897,7 +898,7 @@ func TestMatchmakerAddRemoveNotMatch(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -944,7 +945,7 @@ func TestMatchmakerAddButNotMatch(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -967,7 +968,7 @@ func TestMatchmakerAddButNotMatch(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1016,7 +1017,7 @@ func TestMatchmakerAddButNotMatchOnRange(t *testing.T) {
 	testID, _ := uuid.NewV4()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -1041,7 +1042,7 @@ func TestMatchmakerAddButNotMatchOnRange(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1092,7 +1093,7 @@ func TestMatchmakerAddButNotMatchOnRangeAndValue(t *testing.T) {
 	testID, _ := uuid.NewV4()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -1118,7 +1119,7 @@ func TestMatchmakerAddButNotMatchOnRangeAndValue(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1167,7 +1168,7 @@ func TestMatchmakerAddMultipleAndSomeMatch(t *testing.T) {
 	testID, _ := uuid.NewV4()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -1191,7 +1192,7 @@ func TestMatchmakerAddMultipleAndSomeMatch(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1215,7 +1216,7 @@ func TestMatchmakerAddMultipleAndSomeMatch(t *testing.T) {
 	}
 
 	sessionID3, _ := uuid.NewV4()
-	ticket3, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket3, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "c",
 			SessionId: "c",
@@ -1269,7 +1270,7 @@ func TestMatchmakerAddMultipleAndSomeMatchWithBoost(t *testing.T) {
 	testID, _ := uuid.NewV4()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -1295,7 +1296,7 @@ func TestMatchmakerAddMultipleAndSomeMatchWithBoost(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1321,7 +1322,7 @@ func TestMatchmakerAddMultipleAndSomeMatchWithBoost(t *testing.T) {
 	}
 
 	sessionID3, _ := uuid.NewV4()
-	ticket3, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket3, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "c",
 			SessionId: "c",
@@ -1387,7 +1388,7 @@ func TestMatchmakerAddMultipleAndSomeMatchOptionalTextAlteringScore(t *testing.T
 	testID, _ := uuid.NewV4()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -1411,7 +1412,7 @@ func TestMatchmakerAddMultipleAndSomeMatchOptionalTextAlteringScore(t *testing.T
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1435,7 +1436,7 @@ func TestMatchmakerAddMultipleAndSomeMatchOptionalTextAlteringScore(t *testing.T
 	}
 
 	sessionID3, _ := uuid.NewV4()
-	ticket3, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket3, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "c",
 			SessionId: "c",
@@ -1487,7 +1488,7 @@ func TestMatchmakerAddAndMatchAuthoritative(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "a",
 			SessionId: "a",
@@ -1510,7 +1511,7 @@ func TestMatchmakerAddAndMatchAuthoritative(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1591,6 +1592,658 @@ func TestMatchmakerAddAndMatchAuthoritative(t *testing.T) {
 	}
 }
 
+// should fill an open slot in a running match with one ticket, and leave the other in the pool
+func TestMatchmakerBackfill(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	matchID := uuid.Must(uuid.NewV4()).String() + ".node1"
+	backfillTicket, _, err := matchMaker.AddBackfill(context.Background(), MatchmakerDefaultQueue, matchID, "", nil, "+properties.mode:ranked", 1,
+		map[string]string{
+			"mode": "ranked",
+		}, map[string]float64{})
+	if err != nil {
+		t.Fatalf("error matchmaker add backfill: %v", err)
+	}
+	if backfillTicket == "" {
+		t.Fatal("expected non-empty backfill ticket")
+	}
+
+	sessionIDs := make([]uuid.UUID, 0, 2)
+	for _, userID := range []string{"a", "b"} {
+		sessionID, _ := uuid.NewV4()
+		sessionIDs = append(sessionIDs, sessionID)
+		_, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    userID,
+				SessionId: sessionID.String(),
+				Username:  userID,
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"+properties.mode:ranked",
+			4, 4, 1,
+			map[string]string{
+				"mode": "ranked",
+			}, map[string]float64{})
+		if err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+	}
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 1 {
+		t.Fatalf("expected 1 backfill match, got %d", len(matchesSeen))
+	}
+	for _, mm := range matchesSeen {
+		if mm.GetMatchId() != matchID {
+			t.Fatalf("expected match id %v, got '%s'", matchID, mm.GetMatchId())
+		}
+		if len(mm.GetUsers()) != 1 {
+			t.Fatalf("expected users length to be 1, got %d", len(mm.GetUsers()))
+		}
+	}
+
+	if len(matchMaker.indexes) != 1 {
+		t.Fatalf("expected 1 ticket left in the pool, got %d", len(matchMaker.indexes))
+	}
+	if len(matchMaker.backfills) != 0 {
+		t.Fatalf("expected filled backfill to be removed, got %d", len(matchMaker.backfills))
+	}
+
+	// Further processing must not match the remaining ticket to the filled backfill.
+	matchMaker.Process()
+
+	if len(matchesSeen) != 1 {
+		t.Fatalf("expected 1 backfill match, got %d", len(matchesSeen))
+	}
+
+	// A match hosted in a region is only backfilled with tickets that can reach it.
+	regionMatchID := uuid.Must(uuid.NewV4()).String() + ".node1"
+	if _, _, err := matchMaker.AddBackfill(context.Background(), MatchmakerDefaultQueue, regionMatchID, "eu-west", nil, "+properties.mode:casual", 2,
+		map[string]string{"mode": "casual"}, map[string]float64{}); err != nil {
+		t.Fatalf("error matchmaker add backfill: %v", err)
+	}
+	regionSessionIDs := make(map[string]uuid.UUID, 3)
+	for userID, rtts := range map[string]map[string]float64{
+		"us":  {MatchmakerRttPropertyPrefix + "us-east": 20},
+		"far": {MatchmakerRttPropertyPrefix + "eu-west": 150, MatchmakerRttPropertyPrefix + "us-east": 20},
+		"eu":  {MatchmakerRttPropertyPrefix + "eu-west": 30},
+	} {
+		sessionID, _ := uuid.NewV4()
+		regionSessionIDs[userID] = sessionID
+		if _, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    userID,
+				SessionId: sessionID.String(),
+				Username:  userID,
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "", "+properties.mode:casual", 4, 4, 1, map[string]string{"mode": "casual"}, rtts); err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+	}
+
+	matchMaker.Process()
+
+	if mm, found := matchesSeen[regionSessionIDs["eu"].String()]; !found || mm.GetMatchId() != regionMatchID {
+		t.Fatalf("expected ticket reaching the region to backfill match %v, got %v", regionMatchID, mm)
+	}
+	for _, userID := range []string{"us", "far"} {
+		if _, found := matchesSeen[regionSessionIDs[userID].String()]; found {
+			t.Fatalf("expected ticket %v not reaching the region to be left in the pool", userID)
+		}
+	}
+}
+
+// should let the score hook pick the best candidate and veto the rest
+func TestMatchmakerScore(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	// Prefer the closest skill, veto anything more than 50 apart.
+	matchMaker.runtime.matchmakerScoreFunction = func(ctx context.Context, candidates [][]*MatchmakerEntry) ([]float64, error) {
+		scores := make([]float64, 0, len(candidates))
+		for _, entries := range candidates {
+			diff := math.Abs(entries[0].NumericProperties["skill"] - entries[1].NumericProperties["skill"])
+			if diff > 50 {
+				scores = append(scores, -1)
+				continue
+			}
+			scores = append(scores, 100-diff)
+		}
+		return scores, nil
+	}
+
+	sessionIDs := make(map[float64]string, 3)
+	tickets := make(map[float64]string, 3)
+	for _, skill := range []float64{10, 20, 90} {
+		sessionID, _ := uuid.NewV4()
+		sessionIDs[skill] = sessionID.String()
+		ticket, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    sessionID.String(),
+				SessionId: sessionID.String(),
+				Username:  sessionID.String(),
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"+properties.mode:ranked",
+			2, 2, 1,
+			map[string]string{
+				"mode": "ranked",
+			}, map[string]float64{
+				"skill": skill,
+			})
+		if err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+		tickets[skill] = ticket
+	}
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 2 {
+		t.Fatalf("expected 2 matched sessions, got %d", len(matchesSeen))
+	}
+	for _, skill := range []float64{10, 20} {
+		if _, found := matchesSeen[sessionIDs[skill]]; !found {
+			t.Fatalf("expected session with skill %v to be matched", skill)
+		}
+	}
+	if len(matchMaker.indexes) != 1 {
+		t.Fatalf("expected 1 ticket left in the pool, got %d", len(matchMaker.indexes))
+	}
+	if _, found := matchMaker.indexes[tickets[90]]; !found {
+		t.Fatal("expected vetoed ticket to remain in the pool")
+	}
+}
+
+// should run the score hook without the matchmaker lock, and skip candidates whose tickets were removed meanwhile
+func TestMatchmakerScoreRemovedTicket(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	sessionIDs := make(map[float64]string, 3)
+	tickets := make(map[float64]string, 3)
+
+	// The ticket with skill 20 is the preferred partner for everyone, but it is withdrawn while the hook runs.
+	var removed bool
+	matchMaker.runtime.matchmakerScoreFunction = func(ctx context.Context, candidates [][]*MatchmakerEntry) ([]float64, error) {
+		if !removed {
+			removed = true
+			if err := matchMaker.RemoveSession(sessionIDs[20], tickets[20]); err != nil {
+				t.Errorf("error matchmaker remove session: %v", err)
+			}
+		}
+		scores := make([]float64, 0, len(candidates))
+		for _, entries := range candidates {
+			scores = append(scores, 100-math.Abs(entries[0].NumericProperties["skill"]-entries[1].NumericProperties["skill"]))
+		}
+		return scores, nil
+	}
+
+	for _, skill := range []float64{10, 20, 30} {
+		sessionID, _ := uuid.NewV4()
+		sessionIDs[skill] = sessionID.String()
+		ticket, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    sessionID.String(),
+				SessionId: sessionID.String(),
+				Username:  sessionID.String(),
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"+properties.mode:ranked",
+			2, 2, 1,
+			map[string]string{
+				"mode": "ranked",
+			}, map[string]float64{
+				"skill": skill,
+			})
+		if err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+		tickets[skill] = ticket
+	}
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 2 {
+		t.Fatalf("expected 2 matched sessions, got %d", len(matchesSeen))
+	}
+	for _, skill := range []float64{10, 30} {
+		if _, found := matchesSeen[sessionIDs[skill]]; !found {
+			t.Fatalf("expected session with skill %v to be matched", skill)
+		}
+	}
+	if len(matchMaker.indexes) != 0 {
+		t.Fatalf("expected empty pool, got %d", len(matchMaker.indexes))
+	}
+}
+
+// should only match tickets within the same queue, and apply each queue's ticket limit
+func TestMatchmakerQueues(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	if err := matchMaker.addQueue("ranked", &MatchmakerQueueConfig{
+		MaxTickets:   1,
+		IntervalSec:  1,
+		MaxIntervals: 1,
+	}); err != nil {
+		t.Fatalf("error adding matchmaker queue: %v", err)
+	}
+	ranked := matchMaker.namedQueues["ranked"]
+
+	add := func(sessionID uuid.UUID, queue string) (string, error) {
+		stringProperties := map[string]string{
+			"mode": "duel",
+		}
+		ticket, _, err := matchMaker.Add(context.Background(), queue, []*MatchmakerPresence{
+			{
+				UserId:    sessionID.String(),
+				SessionId: sessionID.String(),
+				Username:  sessionID.String(),
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"+properties.mode:duel",
+			2, 2, 1,
+			stringProperties, map[string]float64{})
+		return ticket, err
+	}
+
+	if _, err := add(uuid.Must(uuid.NewV4()), "unknown"); err != ErrMatchmakerQueueNotFound {
+		t.Fatalf("expected queue not found error, got %v", err)
+	}
+
+	sessionA := uuid.Must(uuid.NewV4())
+	if _, err := add(sessionA, ""); err != nil {
+		t.Fatalf("error matchmaker add: %v", err)
+	}
+	sessionB := uuid.Must(uuid.NewV4())
+	if _, err := add(sessionB, "ranked"); err != nil {
+		t.Fatalf("error matchmaker add: %v", err)
+	}
+
+	// The ranked queue allows a single ticket per session, the default queue is unaffected.
+	if _, err := add(sessionB, "ranked"); err != runtime.ErrMatchmakerTooManyTickets {
+		t.Fatalf("expected too many tickets error, got %v", err)
+	}
+	if _, err := add(sessionB, ""); err != nil {
+		t.Fatalf("error matchmaker add: %v", err)
+	}
+
+	// Only the default queue tickets match each other, the ranked ticket is alone in its queue.
+	matchMaker.Process()
+
+	if len(matchesSeen) != 2 {
+		t.Fatalf("expected 2 matched sessions, got %d", len(matchesSeen))
+	}
+	if _, found := matchesSeen[sessionA.String()]; !found {
+		t.Fatal("expected default queue session to be matched")
+	}
+	if len(matchMaker.indexes) != 0 {
+		t.Fatalf("expected empty default pool, got %d", len(matchMaker.indexes))
+	}
+	if len(ranked.indexes) != 1 {
+		t.Fatalf("expected 1 ranked ticket left in the pool, got %d", len(ranked.indexes))
+	}
+
+	sessionC := uuid.Must(uuid.NewV4())
+	if _, err := add(sessionC, "ranked"); err != nil {
+		t.Fatalf("error matchmaker add: %v", err)
+	}
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 3 {
+		t.Fatalf("expected 3 matched sessions, got %d", len(matchesSeen))
+	}
+	if _, found := matchesSeen[sessionC.String()]; !found {
+		t.Fatal("expected ranked session to be matched")
+	}
+	if len(ranked.indexes) != 0 {
+		t.Fatalf("expected empty ranked pool, got %d", len(ranked.indexes))
+	}
+
+	// Socket tickets select a queue through a ticket property, which is not kept as a searchable property.
+	properties := map[string]string{"matchmaker_queue": "ranked", "mode": "duel"}
+	queue, stringProperties := matchmakerQueue(matchMaker.config, properties)
+	if queue != "ranked" {
+		t.Fatalf("expected ranked queue, got %v", queue)
+	}
+	if len(stringProperties) != 1 || stringProperties["mode"] != "duel" {
+		t.Fatalf("expected only the mode property to be kept, got %v", stringProperties)
+	}
+	if queue, _ := matchmakerQueue(matchMaker.config, map[string]string{"mode": "duel"}); queue != MatchmakerDefaultQueue {
+		t.Fatalf("expected default queue, got %v", queue)
+	}
+	matchMaker.config.GetMatchmaker().QueueProperty = ""
+	if queue, stringProperties := matchmakerQueue(matchMaker.config, properties); queue != MatchmakerDefaultQueue || len(stringProperties) != 2 {
+		t.Fatalf("expected default queue with all properties, got %v %v", queue, stringProperties)
+	}
+}
+
+// should only match tickets sharing a region within their allowed round trip times, widening as they wait
+func TestMatchmakerRegion(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	var regionsMutex sync.Mutex
+	regions := make([]string, 0, 2)
+	matchMaker.runtime.matchmakerMatchedFunction = func(ctx context.Context, entries []*MatchmakerEntry) (string, bool, error) {
+		region, _ := ctx.Value(RUNTIME_CTX_MATCHMAKER_REGION).(string)
+		regionsMutex.Lock()
+		regions = append(regions, region)
+		regionsMutex.Unlock()
+		return "", false, nil
+	}
+
+	add := func(rtts map[string]float64) (uuid.UUID, string) {
+		sessionID := uuid.Must(uuid.NewV4())
+		numericProperties := make(map[string]float64, len(rtts))
+		for region, rtt := range rtts {
+			numericProperties[MatchmakerRttPropertyPrefix+region] = rtt
+		}
+		ticket, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    sessionID.String(),
+				SessionId: sessionID.String(),
+				Username:  sessionID.String(),
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"*",
+			2, 2, 1,
+			map[string]string{}, numericProperties)
+		if err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+		return sessionID, ticket
+	}
+
+	sessionA, _ := add(map[string]float64{"eu": 30, "us": 150})
+	sessionB, _ := add(map[string]float64{"eu": 250, "us": 40})
+	sessionC, _ := add(map[string]float64{"eu": 50, "us": 200})
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 2 {
+		t.Fatalf("expected 2 matched sessions, got %d", len(matchesSeen))
+	}
+	for _, sessionID := range []uuid.UUID{sessionA, sessionC} {
+		if _, found := matchesSeen[sessionID.String()]; !found {
+			t.Fatalf("expected session %v to be matched", sessionID)
+		}
+	}
+	if len(regions) != 1 || regions[0] != "eu" {
+		t.Fatalf("expected region eu, got %v", regions)
+	}
+
+	// Neither region is within the default allowed round trip time for both remaining tickets.
+	sessionD, ticketD := add(map[string]float64{"eu": 40, "us": 120})
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 2 {
+		t.Fatalf("expected 2 matched sessions, got %d", len(matchesSeen))
+	}
+
+	// Once the ticket has waited long enough its allowed round trip time covers the us region.
+	matchMaker.indexes[ticketD].CreatedAt -= int64(10 * time.Second)
+	matchMaker.activeIndexes[ticketD] = matchMaker.indexes[ticketD]
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 4 {
+		t.Fatalf("expected 4 matched sessions, got %d", len(matchesSeen))
+	}
+	for _, sessionID := range []uuid.UUID{sessionB, sessionD} {
+		if _, found := matchesSeen[sessionID.String()]; !found {
+			t.Fatalf("expected session %v to be matched", sessionID)
+		}
+	}
+	if len(regions) != 2 || regions[1] != "us" {
+		t.Fatalf("expected region us, got %v", regions)
+	}
+}
+
+// should never match users who have blocked each other
+func TestMatchmakerBlocked(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	matchMaker.blockGraph = newTestBlockGraph([2]string{"a", "b"})
+
+	add := func(userID string) uuid.UUID {
+		sessionID := uuid.Must(uuid.NewV4())
+		_, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    userID,
+				SessionId: sessionID.String(),
+				Username:  userID,
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"*",
+			2, 2, 1,
+			map[string]string{}, map[string]float64{})
+		if err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+		return sessionID
+	}
+
+	sessionA := add("a")
+	sessionB := add("b")
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 0 {
+		t.Fatalf("expected 0 matches, got %d", len(matchesSeen))
+	}
+
+	// Re-activate the blocked tickets so they may search for the new ticket too.
+	for ticket, index := range matchMaker.indexes {
+		matchMaker.activeIndexes[ticket] = index
+	}
+	sessionC := add("c")
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 2 {
+		t.Fatalf("expected 2 matched sessions, got %d", len(matchesSeen))
+	}
+	if _, found := matchesSeen[sessionC.String()]; !found {
+		t.Fatal("expected unblocked session to be matched")
+	}
+	_, foundA := matchesSeen[sessionA.String()]
+	_, foundB := matchesSeen[sessionB.String()]
+	if foundA && foundB {
+		t.Fatal("expected blocked sessions not to be matched together")
+	}
+}
+
+// should not fill a backfill with users blocked by match players or each other, checking blocks made after tickets were added
+func TestMatchmakerBackfillBlocked(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	playerID := uuid.Must(uuid.NewV4())
+	userIDs := map[string]string{
+		"b": uuid.Must(uuid.NewV4()).String(),
+		"c": uuid.Must(uuid.NewV4()).String(),
+		"d": uuid.Must(uuid.NewV4()).String(),
+	}
+	matchMaker.blockGraph = newTestBlockGraph([2]string{playerID.String(), userIDs["b"]})
+
+	sessionIDs := make(map[string]uuid.UUID, len(userIDs))
+	for name, userID := range userIDs {
+		sessionID := uuid.Must(uuid.NewV4())
+		sessionIDs[name] = sessionID
+		_, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+			{
+				UserId:    userID,
+				SessionId: sessionID.String(),
+				Username:  name,
+				Node:      "node1",
+				SessionID: sessionID,
+			},
+		}, sessionID.String(), "",
+			"*",
+			4, 4, 1,
+			map[string]string{}, map[string]float64{})
+		if err != nil {
+			t.Fatalf("error matchmaker add: %v", err)
+		}
+	}
+
+	// Users c and d block each other after their tickets were added.
+	matchMaker.blockGraph = newTestBlockGraph([2]string{playerID.String(), userIDs["b"]}, [2]string{userIDs["c"], userIDs["d"]})
+
+	matchID := uuid.Must(uuid.NewV4()).String() + ".node1"
+	presencesFn := func() []*MatchPresence {
+		return []*MatchPresence{{Node: "node1", UserID: playerID, SessionID: uuid.Must(uuid.NewV4()), Username: "a"}}
+	}
+	if _, _, err := matchMaker.AddBackfill(context.Background(), MatchmakerDefaultQueue, matchID, "", presencesFn, "*", 3, nil, nil); err != nil {
+		t.Fatalf("error matchmaker add backfill: %v", err)
+	}
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 1 {
+		t.Fatalf("expected 1 backfill match, got %d", len(matchesSeen))
+	}
+	if _, found := matchesSeen[sessionIDs["b"].String()]; found {
+		t.Fatal("expected user blocked by a match player not to fill the backfill")
+	}
+	if len(matchMaker.indexes) != 2 {
+		t.Fatalf("expected 2 tickets left in the pool, got %d", len(matchMaker.indexes))
+	}
+}
+
+// should withdraw all backfill requests for a match
+func TestMatchmakerBackfillRemoveAll(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchesSeen := make(map[string]*rtapi.MatchmakerMatched)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if len(presences) == 1 {
+			matchesSeen[presences[0].SessionID.String()] = envelope.GetMatchmakerMatched()
+		}
+	})
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+
+	matchID := uuid.Must(uuid.NewV4()).String() + ".node1"
+	for i := 0; i < 2; i++ {
+		if _, _, err := matchMaker.AddBackfill(context.Background(), MatchmakerDefaultQueue, matchID, "", nil, "*", 2, nil, nil); err != nil {
+			t.Fatalf("error matchmaker add backfill: %v", err)
+		}
+	}
+
+	if err := matchMaker.RemoveBackfillAll(matchID); err != nil {
+		t.Fatalf("error matchmaker remove backfill all: %v", err)
+	}
+	if len(matchMaker.backfills) != 0 || len(matchMaker.matchBackfills) != 0 {
+		t.Fatalf("expected all backfills to be removed")
+	}
+
+	sessionID, _ := uuid.NewV4()
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
+		{
+			UserId:    "a",
+			SessionId: sessionID.String(),
+			Username:  "a",
+			Node:      "node1",
+			SessionID: sessionID,
+		},
+	}, sessionID.String(), "", "*", 2, 2, 1, map[string]string{}, map[string]float64{})
+	if err != nil {
+		t.Fatalf("error matchmaker add: %v", err)
+	}
+
+	matchMaker.Process()
+
+	if len(matchesSeen) != 0 {
+		t.Fatalf("expected no matches, got %d", len(matchesSeen))
+	}
+}
+
 func TestGroupIndexes(t *testing.T) {
 	a := &MatchmakerIndex{Ticket: "a", Count: 1, CreatedAt: 100}
 	b := &MatchmakerIndex{Ticket: "b", Count: 2, CreatedAt: 110}
@@ -1657,7 +2310,7 @@ func createTestMatchmaker(t fatalable, logger *zap.Logger, tickerActive bool, me
 
 	runtime, _, err := NewRuntime(context.Background(), logger, logger, nil, jsonpbMarshaler, jsonpbUnmarshaler, cfg, "",
 		nil, nil, nil, nil, sessionRegistry, nil, nil,
-		nil, tracker, metrics, nil, messageRouter)
+		nil, tracker, metrics, nil, messageRouter, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1723,7 +2376,12 @@ func NewLocalBenchMatchmaker(logger, startupLogger *zap.Logger, config Config, r
 		entries:        make(map[string][]*MatchmakerEntry),
 		indexes:        make(map[string]*MatchmakerIndex),
 		activeIndexes:  make(map[string]*MatchmakerIndex),
+		queue:          MatchmakerDefaultQueue,
+		queueConfig:    matchmakerDefaultQueueConfig(config),
+		namedQueues:    make(map[string]*LocalMatchmaker),
 		revCache:       make(map[string]map[string]bool),
+		backfills:      make(map[string]*MatchmakerBackfill),
+		matchBackfills: make(map[string]map[string]struct{}),
 	}
 
 	if tickerActive {
@@ -1761,7 +2419,7 @@ func TestMatchmakerRequireMutualMatch(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	ticket1, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket1, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		{
 			UserId:    "a",
 			SessionId: "a",
@@ -1781,7 +2439,7 @@ func TestMatchmakerRequireMutualMatch(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	ticket2, _, err := matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	ticket2, _, err := matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1843,7 +2501,7 @@ func TestMatchmakerRequireMutualMatchLarger(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		{
 			UserId:    "a",
 			SessionId: "a",
@@ -1865,7 +2523,7 @@ func TestMatchmakerRequireMutualMatchLarger(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1887,7 +2545,7 @@ func TestMatchmakerRequireMutualMatchLarger(t *testing.T) {
 	}
 
 	sessionID3, _ := uuid.NewV4()
-	_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "c",
 			SessionId: "c",
@@ -1945,7 +2603,7 @@ func TestMatchmakerRequireMutualMatchLargerReversed(t *testing.T) {
 	defer cleanup()
 
 	sessionID, _ := uuid.NewV4()
-	_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		{
 			UserId:    "a",
 			SessionId: "a",
@@ -1967,7 +2625,7 @@ func TestMatchmakerRequireMutualMatchLargerReversed(t *testing.T) {
 	}
 
 	sessionID2, _ := uuid.NewV4()
-	_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "b",
 			SessionId: "b",
@@ -1989,7 +2647,7 @@ func TestMatchmakerRequireMutualMatchLargerReversed(t *testing.T) {
 	}
 
 	sessionID3, _ := uuid.NewV4()
-	_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+	_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 		&MatchmakerPresence{
 			UserId:    "c",
 			SessionId: "c",
@@ -2148,7 +2806,7 @@ func benchmarkMatchmakerHelper(b *testing.B, activeCount, minCount, maxCount, co
 			matchQuery, props := withQueryAndProps(matchMakerAdded)
 
 			sessionID, _ := uuid.NewV4()
-			_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+			_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 				{
 					UserId:    sessionID.String(),
 					SessionId: sessionID.String(),
@@ -2203,7 +2861,7 @@ func TestMatchmakerMaxPartyTracking(t *testing.T) {
 
 	createTicketFunc := func(party string) error {
 		sessionID, _ := uuid.NewV4()
-		_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+		_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 			&MatchmakerPresence{
 				UserId:    sessionID.String(),
 				SessionId: sessionID.String(),
@@ -2283,7 +2941,7 @@ func TestMatchmakerMaxSessionTracking(t *testing.T) {
 	defer cleanup()
 
 	createTicketFunc := func(sessionID uuid.UUID) error {
-		_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+		_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 			&MatchmakerPresence{
 				UserId:    sessionID.String(),
 				SessionId: sessionID.String(),
@@ -2376,7 +3034,7 @@ func benchmarkMatchmakerProcessTickets(ticketsMax int32, unmatchable int, minCou
 		userID, _ := uuid.NewV4()
 		userIDStr := userID.String()
 
-		_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+		_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 			{
 				UserId:    userIDStr,
 				SessionId: sessionIDStr,
@@ -2407,7 +3065,7 @@ func benchmarkMatchmakerProcessTickets(ticketsMax int32, unmatchable int, minCou
 			userID, _ := uuid.NewV4()
 			userIDStr := userID.String()
 
-			_, _, err = matchMaker.Add(context.Background(), []*MatchmakerPresence{
+			_, _, err = matchMaker.Add(context.Background(), MatchmakerDefaultQueue, []*MatchmakerPresence{
 				{
 					UserId:    userIDStr,
 					SessionId: sessionIDStr,
@@ -0,0 +1,171 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageRateLimitAction is what happens to a real-time message a session sends faster than its limits allow.
+type MessageRateLimitAction uint8
+
+const (
+	// Reject the message and send the session an error.
+	MessageRateLimitActionError MessageRateLimitAction = iota
+	// Process the message once the limit allows it, holding up the session's later messages meanwhile.
+	MessageRateLimitActionThrottle
+)
+
+func (a MessageRateLimitAction) String() string {
+	switch a {
+	case MessageRateLimitActionThrottle:
+		return "throttle"
+	default:
+		return "error"
+	}
+}
+
+func ParseMessageRateLimitAction(action string) (MessageRateLimitAction, error) {
+	switch action {
+	case "error":
+		return MessageRateLimitActionError, nil
+	case "throttle":
+		return MessageRateLimitActionThrottle, nil
+	default:
+		return MessageRateLimitActionError, fmt.Errorf("message rate limit action must be error or throttle, got %q", action)
+	}
+}
+
+var envelopeMessageOneof = (&rtapi.Envelope{}).ProtoReflect().Descriptor().Oneofs().ByName("message")
+
+// Name of the message type an envelope holds, as used in its JSON form and in config, for example
+// "channel_message_send". Returns an empty string if the envelope is empty.
+func envelopeMessageName(envelope *rtapi.Envelope) string {
+	field := envelope.ProtoReflect().WhichOneof(envelopeMessageOneof)
+	if field == nil {
+		return ""
+	}
+	return string(field.Name())
+}
+
+// Reports whether the name is a message type an envelope may hold.
+func isEnvelopeMessageName(name string) bool {
+	return envelopeMessageOneof.Fields().ByName(protoreflect.Name(name)) != nil
+}
+
+// messageRateLimitResult is the outcome of applying a rate limit to a real-time message.
+type messageRateLimitResult uint8
+
+const (
+	// Process the message now.
+	messageRateLimitAllowed messageRateLimitResult = iota
+	// The message exceeded its limit, and is processed once the limit allows it.
+	messageRateLimitThrottled
+	// The message exceeded its limit and should be rejected.
+	messageRateLimitRejected
+	// The message exceeded its limit and the session has now exceeded its limits often enough to be closed.
+	messageRateLimitExhausted
+)
+
+// Longest a throttled message may wait for its limit, messages that would wait longer are rejected. Keeps sessions
+// reading often enough for their connection keepalives.
+const messageRateThrottleMaxDelay = 5 * time.Second
+
+// messageRateLimiter tracks the token buckets of every session sending message types with a rate limit.
+type messageRateLimiter struct {
+	limits        map[string]*MessageRateLimitConfig
+	action        MessageRateLimitAction
+	maxViolations int
+	sessions      *MapOf[uuid.UUID, *messageRateBuckets]
+}
+
+type messageRateBuckets struct {
+	sync.Mutex
+	messages   map[string]*TokenBucket
+	violations int
+}
+
+// Returns nil if no message types have a rate limit.
+func newMessageRateLimiter(config *SocketConfig) *messageRateLimiter {
+	limits := make(map[string]*MessageRateLimitConfig, len(config.MessageRateLimits))
+	for name, limit := range config.MessageRateLimits {
+		if limit != nil && limit.Rate > 0 {
+			limits[name] = limit
+		}
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+
+	// Validated by config checks.
+	action, _ := ParseMessageRateLimitAction(config.MessageLimitAction)
+	return &messageRateLimiter{
+		limits:        limits,
+		action:        action,
+		maxViolations: config.MessageMaxViolations,
+		sessions:      &MapOf[uuid.UUID, *messageRateBuckets]{},
+	}
+}
+
+// Allow applies any rate limit on the message type to a message from the session. Throttled messages come with the
+// delay before the limit allows them, which the caller waits out before processing the message and reading any more
+// of the session's messages, so a session's messages are always processed one at a time and in the order sent.
+func (l *messageRateLimiter) Allow(session Session, name string) (messageRateLimitResult, time.Duration) {
+	limit, found := l.limits[name]
+	if !found {
+		return messageRateLimitAllowed, 0
+	}
+
+	buckets, loaded := l.sessions.LoadOrStore(session.ID(), &messageRateBuckets{
+		messages: make(map[string]*TokenBucket, 1),
+	})
+	if !loaded {
+		// Discard the session's buckets once it closes.
+		go func() {
+			<-session.Context().Done()
+			l.sessions.Delete(session.ID())
+		}()
+	}
+
+	buckets.Lock()
+	defer buckets.Unlock()
+	now := time.Now()
+	bucket, found := buckets.messages[name]
+	if !found {
+		bucket = NewTokenBucket(limit.Rate, limit.Burst, now)
+		buckets.messages[name] = bucket
+	}
+	if bucket.Allow(now) {
+		return messageRateLimitAllowed, 0
+	}
+	buckets.violations++
+	if l.maxViolations > 0 && buckets.violations >= l.maxViolations {
+		return messageRateLimitExhausted, 0
+	}
+	delay := bucket.Delay(now)
+	if l.action != MessageRateLimitActionThrottle || delay > messageRateThrottleMaxDelay {
+		return messageRateLimitRejected, 0
+	}
+
+	// Reserve the next token now, so later messages of the same type wait behind this one.
+	bucket.Take()
+	return messageRateLimitThrottled, delay
+}
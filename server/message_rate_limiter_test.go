@@ -0,0 +1,143 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+)
+
+func dialMessageRateLimitTest(t *testing.T, configure func(config *SocketConfig)) (*websocket.Conn, func(cid string) *rtapi.Envelope) {
+	server := newSocketTestServer(t, &testMetrics{})
+	configure(server.config.GetSocket())
+	server.pipeline.messageLimiter = newMessageRateLimiter(server.config.GetSocket())
+
+	httpServer := httptest.NewServer(http.HandlerFunc(NewSocketWsAcceptor(server.logger, server.config, server.sessionRegistry, server.sessionCache, server.statusRegistry, server.matchmaker, server.tracker, server.metrics, server.runtime, protojsonMarshaler, protojsonUnmarshaler, server.pipeline)))
+	t.Cleanup(httpServer.Close)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+"?token="+server.newToken(), nil)
+	if err != nil {
+		t.Fatalf("error dialling: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ping := func(cid string) *rtapi.Envelope {
+		t.Helper()
+		payload, err := protojsonMarshaler.Marshal(&rtapi.Envelope{Cid: cid, Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+		if err != nil {
+			t.Fatalf("error marshalling ping: %v", err)
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+			t.Fatalf("error sending ping: %v", err)
+		}
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("error reading response: %v", err)
+		}
+		envelope := &rtapi.Envelope{}
+		if err := protojsonUnmarshaler.Unmarshal(data, envelope); err != nil {
+			t.Fatalf("error unmarshalling response: %v", err)
+		}
+		return envelope
+	}
+	return conn, ping
+}
+
+// should reject messages over the limit for their type, and close sessions after repeated violations
+func TestMessageRateLimitError(t *testing.T) {
+	conn, ping := dialMessageRateLimitTest(t, func(config *SocketConfig) {
+		config.MessageRateLimits["ping"] = &MessageRateLimitConfig{Rate: 0.001, Burst: 1}
+		config.MessageMaxViolations = 2
+	})
+
+	if envelope := ping("1"); envelope.GetPong() == nil {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+	if envelope := ping("2"); envelope.GetError().GetMessage() != "Message rate limit exceeded" || envelope.Cid != "2" {
+		t.Fatalf("expected rate limit error, got %v", envelope)
+	}
+	if envelope := ping("3"); envelope.GetError() == nil {
+		t.Fatalf("expected rate limit error, got %v", envelope)
+	}
+	if _, _, err := conn.ReadMessage(); err == nil {
+		t.Fatal("expected session to be closed")
+	}
+}
+
+// should process messages over the limit once the limit allows them, keeping the session's messages in order
+func TestMessageRateLimitThrottle(t *testing.T) {
+	conn, ping := dialMessageRateLimitTest(t, func(config *SocketConfig) {
+		config.MessageRateLimits["ping"] = &MessageRateLimitConfig{Rate: 10, Burst: 1}
+		config.MessageLimitAction = "throttle"
+	})
+
+	if envelope := ping("1"); envelope.GetPong() == nil {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+	start := time.Now()
+	if envelope := ping("2"); envelope.GetPong() == nil {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("expected throttled pong, got it after %v", elapsed)
+	}
+
+	// A throttled ping holds up an RPC sent after it.
+	for _, envelope := range []*rtapi.Envelope{
+		{Cid: "3", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}},
+		{Cid: "4", Message: &rtapi.Envelope_Rpc{Rpc: &api.Rpc{Id: "missing"}}},
+	} {
+		payload, err := protojsonMarshaler.Marshal(envelope)
+		if err != nil {
+			t.Fatalf("error marshalling envelope: %v", err)
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+			t.Fatalf("error sending envelope: %v", err)
+		}
+	}
+	var cids []string
+	for i := 0; i < 2; i++ {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("error reading response: %v", err)
+		}
+		envelope := &rtapi.Envelope{}
+		if err := protojsonUnmarshaler.Unmarshal(data, envelope); err != nil {
+			t.Fatalf("error unmarshalling response: %v", err)
+		}
+		cids = append(cids, envelope.Cid)
+	}
+	if cids[0] != "3" || cids[1] != "4" {
+		t.Fatalf("expected throttled pong before RPC response, got %v", cids)
+	}
+
+	// Messages that would wait too long for their limit are rejected.
+	conn, ping = dialMessageRateLimitTest(t, func(config *SocketConfig) {
+		config.MessageRateLimits["ping"] = &MessageRateLimitConfig{Rate: 0.001, Burst: 1}
+		config.MessageLimitAction = "throttle"
+	})
+	if envelope := ping("1"); envelope.GetPong() == nil {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+	if envelope := ping("2"); envelope.GetError().GetMessage() != "Message rate limit exceeded" {
+		t.Fatalf("expected rate limit error, got %v", envelope)
+	}
+}
@@ -57,6 +57,7 @@ func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs [
 
 	// Prepare payload variables but do not initialize until we hit a session that needs them to avoid unnecessary work.
 	var payloadProtobuf []byte
+	var payloadMsgpack []byte
 	var payloadJSON []byte
 
 	for _, presenceID := range presenceIDs {
@@ -78,6 +79,16 @@ func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs [
 				}
 			}
 			err = session.SendBytes(payloadProtobuf, reliable)
+		case SessionFormatMsgpack:
+			if payloadMsgpack == nil {
+				// Marshal the payload now that we know this format is needed.
+				payloadMsgpack, err = msgpackMarshal(envelope)
+				if err != nil {
+					logger.Error("Could not marshal message", zap.Error(err))
+					return
+				}
+			}
+			err = session.SendBytes(payloadMsgpack, reliable)
 		case SessionFormatJson:
 			fallthrough
 		default:
@@ -90,7 +101,7 @@ func (r *LocalMessageRouter) SendToPresenceIDs(logger *zap.Logger, presenceIDs [
 					return
 				}
 			}
-			err = session.SendBytes(payloadJSON, reliable)
+			err = sessionSendJSON(session, envelope, payloadJSON, reliable)
 		}
 		if err != nil {
 			logger.Error("Failed to route message", zap.String("sid", presenceID.SessionID.String()), zap.Error(err))
@@ -43,9 +43,11 @@ type Metrics interface {
 	ApiRpc(id string, elapsed time.Duration, recvBytes, sentBytes int64, isErr bool)
 	ApiBefore(name string, elapsed time.Duration, isErr bool)
 	ApiAfter(name string, elapsed time.Duration, isErr bool)
+	ApiRateLimited(name, reason string)
 
 	Message(recvBytes int64, isErr bool)
-	MessageBytesSent(sentBytes int64)
+	MessageBytesSent(sentBytes, compressedBytes int64)
+	MessageRateLimited(messageName, action string)
 
 	GaugeRuntimes(value float64)
 	GaugeLuaRuntimes(value float64)
@@ -59,7 +61,10 @@ type Metrics interface {
 
 	GaugeOnlineStatus(userID uuid.UUID, online bool)
 
-	Matchmaker(tickets, activeTickets float64, processTime time.Duration)
+	Matchmaker(queue string, tickets, activeTickets float64, processTime time.Duration)
+
+	MatchTick(handlerName string, elapsed time.Duration, inputQueueDepth, deferredQueueDepth int, overrun bool)
+	MatchDataRateLimited(handlerName, action string)
 
 	PresenceEvent(dequeueElapsed, processElapsed time.Duration)
 
@@ -335,6 +340,11 @@ func (m *LocalMetrics) ApiAfter(name string, elapsed time.Duration, isErr bool)
 	}
 }
 
+// Count API requests rejected by a rate limit or daily quota, by API method name or "rpc:" followed by the RPC ID.
+func (m *LocalMetrics) ApiRateLimited(name, reason string) {
+	m.PrometheusScope.Tagged(map[string]string{"name": name, "reason": reason}).Counter("api_rate_limited").Inc(1)
+}
+
 func (m *LocalMetrics) Message(recvBytes int64, isErr bool) {
 	//name = strings.TrimPrefix(name, API_PREFIX)
 
@@ -366,13 +376,25 @@ func (m *LocalMetrics) Message(recvBytes int64, isErr bool) {
 	}
 }
 
-func (m *LocalMetrics) MessageBytesSent(sentBytes int64) {
+// Record an outgoing real-time message of the given size. If the message was compressed, compressedBytes is the size
+// actually written to the connection, otherwise it is 0.
+func (m *LocalMetrics) MessageBytesSent(sentBytes, compressedBytes int64) {
 	// Increment ongoing statistics for current measurement window.
 	m.currentSentBytes.Add(sentBytes)
 
 	// Global stats.
 	m.PrometheusScope.Counter("overall_sent_bytes").Inc(sentBytes)
 	m.PrometheusScope.Counter("overall_message_sent_bytes").Inc(sentBytes)
+	if compressedBytes > 0 {
+		m.PrometheusScope.Counter("overall_message_sent_compressed_bytes").Inc(compressedBytes)
+	} else {
+		m.PrometheusScope.Counter("overall_message_sent_uncompressed_bytes").Inc(sentBytes)
+	}
+}
+
+// Count real-time messages that exceeded a session rate limit.
+func (m *LocalMetrics) MessageRateLimited(messageName, action string) {
+	m.PrometheusScope.Tagged(map[string]string{"message": messageName, "action": action}).Counter("message_rate_limited").Inc(1)
 }
 
 // Set the absolute value of currently allocated Lua runtime VMs.
@@ -431,10 +453,33 @@ func (m *LocalMetrics) GaugeOnlineStatus(userID uuid.UUID, online bool) {
 }
 
 // Record a set of matchmaker metrics.
-func (m *LocalMetrics) Matchmaker(tickets, activeTickets float64, processTime time.Duration) {
-	m.PrometheusScope.Gauge("matchmaker_tickets").Update(tickets)
-	m.PrometheusScope.Gauge("matchmaker_active_tickets").Update(activeTickets)
-	m.PrometheusScope.Timer("matchmaker_process_time").Record(processTime)
+func (m *LocalMetrics) Matchmaker(queue string, tickets, activeTickets float64, processTime time.Duration) {
+	scope := m.PrometheusScope.Tagged(map[string]string{"queue": queue})
+	scope.Gauge("matchmaker_tickets").Update(tickets)
+	scope.Gauge("matchmaker_active_tickets").Update(activeTickets)
+	scope.Timer("matchmaker_process_time").Record(processTime)
+}
+
+// Bucket boundaries for match tick execution times, 0.25ms up to ~4s.
+var matchTickDurationBuckets = tally.MustMakeExponentialDurationBuckets(250*time.Microsecond, 2, 15)
+
+// Bucket boundaries for match queue depths, 1 up to 1024 messages.
+var matchQueueDepthBuckets = tally.MustMakeExponentialValueBuckets(1, 2, 11)
+
+// Record the execution time and queue depths of a single match tick.
+func (m *LocalMetrics) MatchTick(handlerName string, elapsed time.Duration, inputQueueDepth, deferredQueueDepth int, overrun bool) {
+	scope := m.PrometheusScope.Tagged(map[string]string{"handler": handlerName})
+	scope.Histogram("match_tick_time", matchTickDurationBuckets).RecordDuration(elapsed)
+	scope.Histogram("match_tick_input_queue_depth", matchQueueDepthBuckets).RecordValue(float64(inputQueueDepth))
+	scope.Histogram("match_tick_deferred_queue_depth", matchQueueDepthBuckets).RecordValue(float64(deferredQueueDepth))
+	if overrun {
+		scope.Counter("match_tick_overruns").Inc(1)
+	}
+}
+
+// Count match data messages dropped because the sender exceeded a rate limit.
+func (m *LocalMetrics) MatchDataRateLimited(handlerName, action string) {
+	m.PrometheusScope.Tagged(map[string]string{"handler": handlerName, "action": action}).Counter("match_data_rate_limited").Inc(1)
 }
 
 // Count presence events and time their processing.
@@ -0,0 +1,666 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessagePack encoding of protobuf messages, for sessions using the msgpack format. Messages are maps keyed by proto
+// field name, with unset fields left out, the same structure as the JSON format. Enums are numbers, bytes fields are
+// binary, wrapper types are their plain value, and timestamps use the MessagePack timestamp extension type.
+
+var (
+	ErrMsgpackTruncated   = errors.New("msgpack data is truncated")
+	ErrMsgpackTrailing    = errors.New("msgpack data has trailing bytes")
+	ErrMsgpackUnsupported = errors.New("msgpack data has an unsupported type")
+	ErrMsgpackRange       = errors.New("msgpack number is out of range")
+	ErrMsgpackTooDeep     = errors.New("msgpack data is nested too deeply")
+)
+
+const (
+	msgpackMaxDepth          = 64
+	msgpackTimestampExtType  = 0xff // -1
+	msgpackTimestampFullName = "google.protobuf.Timestamp"
+	msgpackWrappersPackage   = "google.protobuf"
+)
+
+// Wrapper types are encoded as their plain value.
+var msgpackWrapperNames = map[protoreflect.Name]struct{}{
+	"BoolValue": {}, "Int32Value": {}, "Int64Value": {}, "UInt32Value": {}, "UInt64Value": {},
+	"FloatValue": {}, "DoubleValue": {}, "StringValue": {}, "BytesValue": {},
+}
+
+func isMsgpackWrapper(desc protoreflect.MessageDescriptor) bool {
+	if desc.ParentFile().Package() != msgpackWrappersPackage {
+		return false
+	}
+	_, found := msgpackWrapperNames[desc.Name()]
+	return found
+}
+
+func msgpackMarshal(m proto.Message) ([]byte, error) {
+	e := &msgpackEncoder{}
+	if err := e.message(m.ProtoReflect()); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+func msgpackUnmarshal(b []byte, m proto.Message) error {
+	proto.Reset(m)
+	d := &msgpackDecoder{buf: b}
+	if err := d.message(m.ProtoReflect(), 0); err != nil {
+		return err
+	}
+	if d.pos != len(d.buf) {
+		return ErrMsgpackTrailing
+	}
+	return nil
+}
+
+type msgpackEncoder struct {
+	buf []byte
+}
+
+func (e *msgpackEncoder) message(m protoreflect.Message) error {
+	desc := m.Descriptor()
+	if desc.FullName() == msgpackTimestampFullName {
+		e.timestamp(m.Get(desc.Fields().ByName("seconds")).Int(), uint32(m.Get(desc.Fields().ByName("nanos")).Int()))
+		return nil
+	}
+	if isMsgpackWrapper(desc) {
+		fd := desc.Fields().ByName("value")
+		return e.value(fd, m.Get(fd))
+	}
+
+	var count int
+	m.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		count++
+		return true
+	})
+	e.mapLen(count)
+
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		e.str(string(fd.Name()))
+		err = e.field(fd, v)
+		return err == nil
+	})
+	return err
+}
+
+func (e *msgpackEncoder) field(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		e.arrayLen(list.Len())
+		for i := 0; i < list.Len(); i++ {
+			if err := e.value(fd, list.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case fd.IsMap():
+		m := v.Map()
+		e.mapLen(m.Len())
+		var err error
+		m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			if err = e.value(fd.MapKey(), k.Value()); err != nil {
+				return false
+			}
+			err = e.value(fd.MapValue(), v)
+			return err == nil
+		})
+		return err
+	default:
+		return e.value(fd, v)
+	}
+}
+
+func (e *msgpackEncoder) value(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if v.Bool() {
+			e.buf = append(e.buf, 0xc3)
+		} else {
+			e.buf = append(e.buf, 0xc2)
+		}
+	case protoreflect.EnumKind:
+		e.int(int64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		e.int(v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		e.uint(v.Uint())
+	case protoreflect.FloatKind:
+		e.buf = append(e.buf, 0xca)
+		e.buf = msgpackAppendUint32(e.buf, math.Float32bits(float32(v.Float())))
+	case protoreflect.DoubleKind:
+		e.buf = append(e.buf, 0xcb)
+		e.buf = msgpackAppendUint64(e.buf, math.Float64bits(v.Float()))
+	case protoreflect.StringKind:
+		e.str(v.String())
+	case protoreflect.BytesKind:
+		e.bin(v.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return e.message(v.Message())
+	default:
+		return fmt.Errorf("msgpack cannot encode field %v of kind %v", fd.FullName(), fd.Kind())
+	}
+	return nil
+}
+
+func (e *msgpackEncoder) int(v int64) {
+	switch {
+	case v >= 0:
+		e.uint(uint64(v))
+	case v >= -32:
+		e.buf = append(e.buf, byte(int8(v)))
+	case v >= math.MinInt8:
+		e.buf = append(e.buf, 0xd0, byte(int8(v)))
+	case v >= math.MinInt16:
+		e.buf = append(e.buf, 0xd1)
+		e.buf = msgpackAppendUint16(e.buf, uint16(int16(v)))
+	case v >= math.MinInt32:
+		e.buf = append(e.buf, 0xd2)
+		e.buf = msgpackAppendUint32(e.buf, uint32(int32(v)))
+	default:
+		e.buf = append(e.buf, 0xd3)
+		e.buf = msgpackAppendUint64(e.buf, uint64(v))
+	}
+}
+
+func (e *msgpackEncoder) uint(v uint64) {
+	switch {
+	case v < 128:
+		e.buf = append(e.buf, byte(v))
+	case v <= math.MaxUint8:
+		e.buf = append(e.buf, 0xcc, byte(v))
+	case v <= math.MaxUint16:
+		e.buf = append(e.buf, 0xcd)
+		e.buf = msgpackAppendUint16(e.buf, uint16(v))
+	case v <= math.MaxUint32:
+		e.buf = append(e.buf, 0xce)
+		e.buf = msgpackAppendUint32(e.buf, uint32(v))
+	default:
+		e.buf = append(e.buf, 0xcf)
+		e.buf = msgpackAppendUint64(e.buf, v)
+	}
+}
+
+// Append a header with a length, using the short form below fixMax, then 8, 16 and 32 bit forms. Types without an
+// 8 bit form pass 0 for its code.
+func (e *msgpackEncoder) header(length int, fixCode byte, fixMax int, code8, code16, code32 byte) {
+	switch {
+	case length < fixMax:
+		e.buf = append(e.buf, fixCode|byte(length))
+	case code8 != 0 && length <= math.MaxUint8:
+		e.buf = append(e.buf, code8, byte(length))
+	case length <= math.MaxUint16:
+		e.buf = append(e.buf, code16)
+		e.buf = msgpackAppendUint16(e.buf, uint16(length))
+	default:
+		e.buf = append(e.buf, code32)
+		e.buf = msgpackAppendUint32(e.buf, uint32(length))
+	}
+}
+
+func (e *msgpackEncoder) str(v string) {
+	e.header(len(v), 0xa0, 32, 0xd9, 0xda, 0xdb)
+	e.buf = append(e.buf, v...)
+}
+
+func (e *msgpackEncoder) bin(v []byte) {
+	// Binary has no short form.
+	e.header(len(v), 0, 0, 0xc4, 0xc5, 0xc6)
+	e.buf = append(e.buf, v...)
+}
+
+func (e *msgpackEncoder) arrayLen(length int) {
+	e.header(length, 0x90, 16, 0, 0xdc, 0xdd)
+}
+
+func (e *msgpackEncoder) mapLen(length int) {
+	e.header(length, 0x80, 16, 0, 0xde, 0xdf)
+}
+
+// Use the smallest of the 32, 64 and 96 bit timestamp extension formats that fits the value.
+func (e *msgpackEncoder) timestamp(seconds int64, nanos uint32) {
+	switch {
+	case nanos == 0 && seconds >= 0 && seconds <= math.MaxUint32:
+		e.buf = append(e.buf, 0xd6, msgpackTimestampExtType)
+		e.buf = msgpackAppendUint32(e.buf, uint32(seconds))
+	case seconds >= 0 && seconds>>34 == 0:
+		e.buf = append(e.buf, 0xd7, msgpackTimestampExtType)
+		e.buf = msgpackAppendUint64(e.buf, uint64(nanos)<<34|uint64(seconds))
+	default:
+		e.buf = append(e.buf, 0xc7, 12, msgpackTimestampExtType)
+		e.buf = msgpackAppendUint32(e.buf, nanos)
+		e.buf = msgpackAppendUint64(e.buf, uint64(seconds))
+	}
+}
+
+func msgpackAppendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func msgpackAppendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func msgpackAppendUint64(b []byte, v uint64) []byte {
+	return msgpackAppendUint32(msgpackAppendUint32(b, uint32(v>>32)), uint32(v))
+}
+
+type msgpackDecoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *msgpackDecoder) message(m protoreflect.Message, depth int) error {
+	if depth > msgpackMaxDepth {
+		return ErrMsgpackTooDeep
+	}
+	desc := m.Descriptor()
+	if desc.FullName() == msgpackTimestampFullName {
+		seconds, nanos, err := d.timestamp()
+		if err != nil {
+			return err
+		}
+		m.Set(desc.Fields().ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+		m.Set(desc.Fields().ByName("nanos"), protoreflect.ValueOfInt32(int32(nanos)))
+		return nil
+	}
+	if isMsgpackWrapper(desc) {
+		fd := desc.Fields().ByName("value")
+		v, err := d.value(fd, nil, depth)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+		return nil
+	}
+
+	count, err := d.mapLen()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		name, err := d.str()
+		if err != nil {
+			return err
+		}
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = desc.Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return fmt.Errorf("msgpack data has unknown field %q for %v", name, desc.FullName())
+		}
+		if d.nil() {
+			// Same as leaving the field out.
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			length, err := d.arrayLen()
+			if err != nil {
+				return err
+			}
+			list := m.Mutable(fd).List()
+			for j := 0; j < length; j++ {
+				v, err := d.value(fd, list.NewElement, depth)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+		case fd.IsMap():
+			length, err := d.mapLen()
+			if err != nil {
+				return err
+			}
+			mp := m.Mutable(fd).Map()
+			for j := 0; j < length; j++ {
+				k, err := d.value(fd.MapKey(), nil, depth)
+				if err != nil {
+					return err
+				}
+				v, err := d.value(fd.MapValue(), mp.NewValue, depth)
+				if err != nil {
+					return err
+				}
+				mp.Set(k.MapKey(), v)
+			}
+		default:
+			v, err := d.value(fd, func() protoreflect.Value { return m.NewField(fd) }, depth)
+			if err != nil {
+				return err
+			}
+			m.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+// Decode a single value for the field, using newValue to create messages.
+func (d *msgpackDecoder) value(fd protoreflect.FieldDescriptor, newValue func() protoreflect.Value, depth int) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := d.next()
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		switch b {
+		case 0xc2:
+			return protoreflect.ValueOfBool(false), nil
+		case 0xc3:
+			return protoreflect.ValueOfBool(true), nil
+		default:
+			return protoreflect.Value{}, ErrMsgpackUnsupported
+		}
+	case protoreflect.EnumKind:
+		v, err := d.int(math.MinInt32, math.MaxInt32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := d.int(math.MinInt32, math.MaxInt32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := d.int(math.MinInt64, math.MaxInt64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := d.uint(math.MaxUint32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := d.uint(math.MaxUint64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := d.float()
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := d.float()
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.StringKind:
+		v, err := d.str()
+		return protoreflect.ValueOfString(v), err
+	case protoreflect.BytesKind:
+		v, err := d.bin()
+		return protoreflect.ValueOfBytes(v), err
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		v := newValue()
+		return v, d.message(v.Message(), depth+1)
+	default:
+		return protoreflect.Value{}, fmt.Errorf("msgpack cannot decode field %v of kind %v", fd.FullName(), fd.Kind())
+	}
+}
+
+func (d *msgpackDecoder) next() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, ErrMsgpackTruncated
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *msgpackDecoder) take(n int) ([]byte, error) {
+	if n < 0 || n > len(d.buf)-d.pos {
+		return nil, ErrMsgpackTruncated
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *msgpackDecoder) uintN(size int) (uint64, error) {
+	b, err := d.take(size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	default:
+		return binary.BigEndian.Uint64(b), nil
+	}
+}
+
+// Consume a nil value if one is next.
+func (d *msgpackDecoder) nil() bool {
+	if d.pos < len(d.buf) && d.buf[d.pos] == 0xc0 {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+// Read a length following a header, given the short form range and the codes of the 8, 16 and 32 bit forms.
+func (d *msgpackDecoder) length(fixCode, fixMask, code8, code16, code32 byte) (int, error) {
+	b, err := d.next()
+	if err != nil {
+		return 0, err
+	}
+	var length uint64
+	switch {
+	case fixMask != 0 && b&^fixMask == fixCode:
+		return int(b & fixMask), nil
+	case code8 != 0 && b == code8:
+		length, err = d.uintN(1)
+	case b == code16:
+		length, err = d.uintN(2)
+	case b == code32:
+		length, err = d.uintN(4)
+	default:
+		return 0, ErrMsgpackUnsupported
+	}
+	if err != nil {
+		return 0, err
+	}
+	// Every element takes at least a byte, so longer lengths can only be truncated data.
+	if length > uint64(len(d.buf)-d.pos) {
+		return 0, ErrMsgpackTruncated
+	}
+	return int(length), nil
+}
+
+func (d *msgpackDecoder) mapLen() (int, error) {
+	return d.length(0x80, 0x0f, 0, 0xde, 0xdf)
+}
+
+func (d *msgpackDecoder) arrayLen() (int, error) {
+	return d.length(0x90, 0x0f, 0, 0xdc, 0xdd)
+}
+
+func (d *msgpackDecoder) str() (string, error) {
+	length, err := d.length(0xa0, 0x1f, 0xd9, 0xda, 0xdb)
+	if err != nil {
+		return "", err
+	}
+	b, err := d.take(length)
+	return string(b), err
+}
+
+// Binary values, also accepting strings for clients that can't easily send binary.
+func (d *msgpackDecoder) bin() ([]byte, error) {
+	if d.pos < len(d.buf) {
+		if b := d.buf[d.pos]; b&0xe0 == 0xa0 || b == 0xd9 || b == 0xda || b == 0xdb {
+			s, err := d.str()
+			return []byte(s), err
+		}
+	}
+	length, err := d.length(0, 0, 0xc4, 0xc5, 0xc6)
+	if err != nil {
+		return nil, err
+	}
+	b, err := d.take(length)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// Read any number. Integers are returned as negative or not, floats separately.
+func (d *msgpackDecoder) number() (u uint64, i int64, negative bool, f float64, isFloat bool, err error) {
+	b, err := d.next()
+	if err != nil {
+		return
+	}
+	switch {
+	case b <= 0x7f:
+		u = uint64(b)
+	case b >= 0xe0:
+		i, negative = int64(int8(b)), true
+	case b == 0xcc || b == 0xcd || b == 0xce || b == 0xcf:
+		u, err = d.uintN(1 << (b - 0xcc))
+	case b == 0xd0 || b == 0xd1 || b == 0xd2 || b == 0xd3:
+		var v uint64
+		size := 1 << (b - 0xd0)
+		if v, err = d.uintN(size); err == nil {
+			// Sign extend from the encoded size.
+			shift := 64 - 8*size
+			i = int64(v<<shift) >> shift
+			if i >= 0 {
+				u, i = uint64(i), 0
+			} else {
+				negative = true
+			}
+		}
+	case b == 0xca:
+		var v uint64
+		if v, err = d.uintN(4); err == nil {
+			f, isFloat = float64(math.Float32frombits(uint32(v))), true
+		}
+	case b == 0xcb:
+		var v uint64
+		if v, err = d.uintN(8); err == nil {
+			f, isFloat = math.Float64frombits(v), true
+		}
+	default:
+		err = ErrMsgpackUnsupported
+	}
+	return
+}
+
+func (d *msgpackDecoder) int(min, max int64) (int64, error) {
+	u, i, negative, f, isFloat, err := d.number()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case isFloat:
+		// Only whole numbers within range. The upper bound is exclusive since max may not be exact as a float.
+		if f != math.Trunc(f) || f < float64(min) || f >= float64(max)+1 {
+			return 0, ErrMsgpackRange
+		}
+		return int64(f), nil
+	case negative:
+		if i < min {
+			return 0, ErrMsgpackRange
+		}
+		return i, nil
+	default:
+		if u > uint64(max) {
+			return 0, ErrMsgpackRange
+		}
+		return int64(u), nil
+	}
+}
+
+func (d *msgpackDecoder) uint(max uint64) (uint64, error) {
+	u, _, negative, f, isFloat, err := d.number()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case isFloat:
+		if f != math.Trunc(f) || f < 0 || f >= float64(max)+1 {
+			return 0, ErrMsgpackRange
+		}
+		return uint64(f), nil
+	case negative || u > max:
+		return 0, ErrMsgpackRange
+	default:
+		return u, nil
+	}
+}
+
+func (d *msgpackDecoder) float() (float64, error) {
+	u, i, negative, f, isFloat, err := d.number()
+	switch {
+	case err != nil:
+		return 0, err
+	case isFloat:
+		return f, nil
+	case negative:
+		return float64(i), nil
+	default:
+		return float64(u), nil
+	}
+}
+
+func (d *msgpackDecoder) timestamp() (seconds int64, nanos uint32, err error) {
+	b, err := d.next()
+	if err != nil {
+		return 0, 0, err
+	}
+	var size int
+	switch b {
+	case 0xd6:
+		size = 4
+	case 0xd7:
+		size = 8
+	case 0xc7:
+		length, err := d.uintN(1)
+		if err != nil {
+			return 0, 0, err
+		}
+		if length != 12 {
+			return 0, 0, ErrMsgpackUnsupported
+		}
+		size = 12
+	default:
+		return 0, 0, ErrMsgpackUnsupported
+	}
+	if extType, err := d.next(); err != nil {
+		return 0, 0, err
+	} else if extType != msgpackTimestampExtType {
+		return 0, 0, ErrMsgpackUnsupported
+	}
+
+	data, err := d.take(size)
+	if err != nil {
+		return 0, 0, err
+	}
+	switch size {
+	case 4:
+		return int64(binary.BigEndian.Uint32(data)), 0, nil
+	case 8:
+		v := binary.BigEndian.Uint64(data)
+		return int64(v & (1<<34 - 1)), uint32(v >> 34), nil
+	default:
+		return int64(binary.BigEndian.Uint64(data[4:])), binary.BigEndian.Uint32(data[:4]), nil
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// should encode envelopes as MessagePack maps keyed by proto field name
+func TestMsgpackMarshal(t *testing.T) {
+	payload, err := msgpackMarshal(&rtapi.Envelope{Cid: "1"})
+	if err != nil {
+		t.Fatalf("error marshalling: %v", err)
+	}
+	// A map of one entry, from the string "cid" to the string "1".
+	if expected := []byte{0x81, 0xa3, 'c', 'i', 'd', 0xa1, '1'}; !bytes.Equal(payload, expected) {
+		t.Fatalf("expected %x, got %x", expected, payload)
+	}
+
+	payload, err = msgpackMarshal(&api.ChannelMessage{CreateTime: &timestamppb.Timestamp{Seconds: 1}, Persistent: &wrapperspb.BoolValue{Value: true}})
+	if err != nil {
+		t.Fatalf("error marshalling: %v", err)
+	}
+	// Timestamps use the 32 bit timestamp extension, and wrappers are plain values.
+	if !bytes.Contains(payload, []byte{0xd6, 0xff, 0, 0, 0, 1}) || !bytes.Contains(payload, []byte{0xaa, 'p', 'e', 'r', 's', 'i', 's', 't', 'e', 'n', 't', 0xc3}) {
+		t.Fatalf("unexpected encoding %x", payload)
+	}
+}
+
+// should decode every envelope it encodes to an identical envelope
+func TestMsgpackRoundTrip(t *testing.T) {
+	for _, envelope := range []*rtapi.Envelope{
+		{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}},
+		{Message: &rtapi.Envelope_MatchData{MatchData: &rtapi.MatchData{
+			MatchId:  "match.node",
+			Presence: &rtapi.UserPresence{UserId: "user", SessionId: "session", Username: "alice"},
+			OpCode:   -1 << 40,
+			Data:     bytes.Repeat([]byte{0, 1, 2}, 100),
+			Reliable: true,
+		}}},
+		{Cid: strings.Repeat("a", 70000), Message: &rtapi.Envelope_MatchmakerAdd{MatchmakerAdd: &rtapi.MatchmakerAdd{
+			MinCount:          2,
+			MaxCount:          math.MaxInt32,
+			Query:             "*",
+			StringProperties:  map[string]string{"region": "eu", "mode": "ranked"},
+			NumericProperties: map[string]float64{"skill": 12.5, "level": -3},
+		}}},
+		{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: &api.ChannelMessage{
+			ChannelId:  "channel",
+			Code:       &wrapperspb.Int32Value{Value: -200},
+			Content:    `{"message":"hello"}`,
+			CreateTime: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 123456789},
+			UpdateTime: &timestamppb.Timestamp{Seconds: -1},
+			Persistent: &wrapperspb.BoolValue{Value: false},
+		}}},
+		{Message: &rtapi.Envelope_StatusPresenceEvent{StatusPresenceEvent: &rtapi.StatusPresenceEvent{
+			Joins:  []*rtapi.UserPresence{{UserId: "1", Status: &wrapperspb.StringValue{Value: "online"}}, {UserId: "2"}},
+			Leaves: []*rtapi.UserPresence{{UserId: "3", Persistence: true}},
+		}}},
+		{Message: &rtapi.Envelope_Error{Error: &rtapi.Error{Code: int32(rtapi.Error_BAD_INPUT), Context: map[string]string{"key": "value"}}}},
+	} {
+		payload, err := msgpackMarshal(envelope)
+		if err != nil {
+			t.Fatalf("error marshalling %T: %v", envelope.Message, err)
+		}
+		decoded := &rtapi.Envelope{}
+		if err := msgpackUnmarshal(payload, decoded); err != nil {
+			t.Fatalf("error unmarshalling %T: %v", envelope.Message, err)
+		}
+		if !proto.Equal(envelope, decoded) {
+			t.Fatalf("expected %v, got %v", envelope, decoded)
+		}
+	}
+}
+
+// should reject malformed data rather than decode part of it
+func TestMsgpackUnmarshalInvalid(t *testing.T) {
+	payload, err := msgpackMarshal(&rtapi.Envelope{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+	if err != nil {
+		t.Fatalf("error marshalling: %v", err)
+	}
+	for _, invalid := range [][]byte{
+		payload[:len(payload)-1],
+		append(payload, 0xc0),
+		// Unknown field.
+		{0x81, 0xa3, 'f', 'o', 'o', 0x01},
+		// Wrong type for the field.
+		{0x81, 0xa3, 'c', 'i', 'd', 0x01},
+		// A map claiming more entries than there is data for.
+		{0xdf, 0xff, 0xff, 0xff, 0xff},
+	} {
+		if err := msgpackUnmarshal(invalid, &rtapi.Envelope{}); err == nil {
+			t.Fatalf("expected error unmarshalling %x", invalid)
+		}
+	}
+}
+
+// should accept WebSocket sessions using the msgpack format
+func TestSocketWsMsgpack(t *testing.T) {
+	server := newSocketTestServer(t, &testMetrics{})
+
+	httpServer := httptest.NewServer(http.HandlerFunc(NewSocketWsAcceptor(server.logger, server.config, server.sessionRegistry, server.sessionCache, server.statusRegistry, server.matchmaker, server.tracker, server.metrics, server.runtime, protojsonMarshaler, protojsonUnmarshaler, server.pipeline)))
+	defer httpServer.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+"?format=msgpack&token="+server.newToken(), nil)
+	if err != nil {
+		t.Fatalf("error dialling: %v", err)
+	}
+	defer conn.Close()
+
+	payload, err := msgpackMarshal(&rtapi.Envelope{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+	if err != nil {
+		t.Fatalf("error marshalling ping: %v", err)
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, payload); err != nil {
+		t.Fatalf("error sending ping: %v", err)
+	}
+	messageType, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("error reading pong: %v", err)
+	}
+	envelope := &rtapi.Envelope{}
+	if err := msgpackUnmarshal(data, envelope); err != nil {
+		t.Fatalf("error unmarshalling pong: %v", err)
+	}
+	if messageType != websocket.BinaryMessage || envelope.Cid != "1" || envelope.GetPong() == nil {
+		t.Fatalf("expected binary pong, got %v %v", messageType, envelope)
+	}
+}
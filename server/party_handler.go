@@ -33,6 +33,8 @@ type PartyHandler struct {
 	tracker       Tracker
 	streamManager StreamManager
 	router        MessageRouter
+	// Only set if block-aware parties are enabled.
+	blockGraph BlockGraph
 
 	ID      uuid.UUID
 	Node    string
@@ -54,7 +56,7 @@ type PartyHandler struct {
 	joinRequestUserPresences []*rtapi.UserPresence
 }
 
-func NewPartyHandler(logger *zap.Logger, partyRegistry PartyRegistry, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter, id uuid.UUID, node string, open bool, maxSize int, presence *rtapi.UserPresence) *PartyHandler {
+func NewPartyHandler(logger *zap.Logger, partyRegistry PartyRegistry, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter, blockGraph BlockGraph, id uuid.UUID, node string, open bool, maxSize int, presence *rtapi.UserPresence) *PartyHandler {
 	idStr := fmt.Sprintf("%v.%v", id.String(), node)
 	ctx, ctxCancelFn := context.WithCancel(context.Background())
 	return &PartyHandler{
@@ -64,6 +66,7 @@ func NewPartyHandler(logger *zap.Logger, partyRegistry PartyRegistry, matchmaker
 		tracker:       tracker,
 		streamManager: streamManager,
 		router:        router,
+		blockGraph:    blockGraph,
 
 		ID:      id,
 		Node:    node,
@@ -94,12 +97,29 @@ func (p *PartyHandler) stop() {
 }
 
 func (p *PartyHandler) JoinRequest(presence *Presence) (bool, error) {
+	// Find users who have blocked, or been blocked by, the user requesting to join.
+	var blockedUsers map[string]struct{}
+	if p.blockGraph != nil {
+		var err error
+		if blockedUsers, err = p.blockGraph.Blocked(p.ctx, []string{presence.GetUserId()}); err != nil {
+			return false, err
+		}
+	}
+
 	p.Lock()
 	if p.stopped {
 		p.Unlock()
 		return false, runtime.ErrPartyClosed
 	}
 
+	// Check if the user has a block relationship with any party member.
+	for _, memberUserPresence := range p.memberUserPresences {
+		if _, found := blockedUsers[memberUserPresence.UserId]; found {
+			p.Unlock()
+			return false, ErrPartyJoinBlocked
+		}
+	}
+
 	// Check if party is full.
 	if len(p.members)+len(p.joinsInProgress) >= p.MaxSize {
 		p.Unlock()
@@ -537,7 +557,7 @@ func (p *PartyHandler) JoinRequestList(sessionID, node string) ([]*rtapi.UserPre
 	return joinRequestUserPresences, nil
 }
 
-func (p *PartyHandler) MatchmakerAdd(sessionID, node, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*PresenceID, error) {
+func (p *PartyHandler) MatchmakerAdd(sessionID, node, queue, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*PresenceID, error) {
 	p.RLock()
 	if p.stopped {
 		p.RUnlock()
@@ -570,7 +590,7 @@ func (p *PartyHandler) MatchmakerAdd(sessionID, node, query string, minCount, ma
 
 	p.RUnlock()
 
-	ticket, _, err := p.matchmaker.Add(p.ctx, presences, "", p.IDStr, query, minCount, maxCount, countMultiple, stringProperties, numericProperties)
+	ticket, _, err := p.matchmaker.Add(p.ctx, queue, presences, "", p.IDStr, query, minCount, maxCount, countMultiple, stringProperties, numericProperties)
 	if err != nil {
 		return "", nil, err
 	}
@@ -44,7 +44,7 @@ func TestPartyMatchmakerAddAndRemove(t *testing.T) {
 		},
 	}})
 
-	ticket, _, err := partyHandler.MatchmakerAdd(sessionID.String(), node, "", 1, 1, 1, nil, nil)
+	ticket, _, err := partyHandler.MatchmakerAdd(sessionID.String(), node, MatchmakerDefaultQueue, "", 1, 1, 1, nil, nil)
 	if err != nil {
 		t.Fatalf("MatchmakerAdd error %s", err)
 	}
@@ -64,7 +64,46 @@ func createTestPartyHandler(t *testing.T, logger *zap.Logger) (*PartyHandler, fu
 	tsm := testStreamManager{}
 	dmr := DummyMessageRouter{}
 
-	pr := NewLocalPartyRegistry(logger, mm, &tt, &tsm, &dmr, node)
-	ph := NewPartyHandler(logger, pr, mm, &tt, &tsm, &dmr, uuid.UUID{}, node, true, 10, nil)
+	pr := NewLocalPartyRegistry(logger, mm, &tt, &tsm, &dmr, nil, node)
+	ph := NewPartyHandler(logger, pr, mm, &tt, &tsm, &dmr, nil, uuid.UUID{}, node, true, 10, nil)
 	return ph, cleanup
 }
+
+// should reject join requests from users with a block relationship with a party member
+func TestPartyJoinRequestBlocked(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	partyHandler, cleanup := createTestPartyHandler(t, consoleLogger)
+	defer cleanup()
+
+	memberUserID, _ := uuid.NewV4()
+	blockedUserID, _ := uuid.NewV4()
+	otherUserID, _ := uuid.NewV4()
+	node := "node1"
+
+	partyHandler.blockGraph = newTestBlockGraph([2]string{memberUserID.String(), blockedUserID.String()})
+
+	presence := func(userID uuid.UUID) *Presence {
+		sessionID, _ := uuid.NewV4()
+		return &Presence{
+			ID: PresenceID{
+				Node:      node,
+				SessionID: sessionID,
+			},
+			// Presence stream not needed.
+			UserID: userID,
+			Meta: PresenceMeta{
+				Username: userID.String(),
+				// Other meta fields not needed.
+			},
+		}
+	}
+
+	partyHandler.Join([]*Presence{presence(memberUserID)})
+
+	if _, err := partyHandler.JoinRequest(presence(blockedUserID)); err != ErrPartyJoinBlocked {
+		t.Fatalf("expected blocked join request error, got %v", err)
+	}
+	if _, err := partyHandler.JoinRequest(presence(otherUserID)); err != nil {
+		t.Fatalf("JoinRequest error %s", err)
+	}
+}
@@ -23,7 +23,10 @@ import (
 	"go.uber.org/zap"
 )
 
-var ErrPartyNotFound = errors.New("party not found")
+var (
+	ErrPartyNotFound    = errors.New("party not found")
+	ErrPartyJoinBlocked = errors.New("party join request blocked")
+)
 
 type PartyRegistry interface {
 	Create(open bool, maxSize int, leader *rtapi.UserPresence) *PartyHandler
@@ -38,7 +41,7 @@ type PartyRegistry interface {
 	PartyRemove(ctx context.Context, id uuid.UUID, node, sessionID, fromNode string, presence *rtapi.UserPresence) error
 	PartyClose(ctx context.Context, id uuid.UUID, node, sessionID, fromNode string) error
 	PartyJoinRequestList(ctx context.Context, id uuid.UUID, node, sessionID, fromNode string) ([]*rtapi.UserPresence, error)
-	PartyMatchmakerAdd(ctx context.Context, id uuid.UUID, node, sessionID, fromNode, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*PresenceID, error)
+	PartyMatchmakerAdd(ctx context.Context, id uuid.UUID, node, sessionID, fromNode, queue, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*PresenceID, error)
 	PartyMatchmakerRemove(ctx context.Context, id uuid.UUID, node, sessionID, fromNode, ticket string) error
 	PartyDataSend(ctx context.Context, id uuid.UUID, node, sessionID, fromNode string, opCode int64, data []byte) error
 }
@@ -49,18 +52,20 @@ type LocalPartyRegistry struct {
 	tracker       Tracker
 	streamManager StreamManager
 	router        MessageRouter
+	blockGraph    BlockGraph
 	node          string
 
 	parties *MapOf[uuid.UUID, *PartyHandler]
 }
 
-func NewLocalPartyRegistry(logger *zap.Logger, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter, node string) PartyRegistry {
+func NewLocalPartyRegistry(logger *zap.Logger, matchmaker Matchmaker, tracker Tracker, streamManager StreamManager, router MessageRouter, blockGraph BlockGraph, node string) PartyRegistry {
 	return &LocalPartyRegistry{
 		logger:        logger,
 		matchmaker:    matchmaker,
 		tracker:       tracker,
 		streamManager: streamManager,
 		router:        router,
+		blockGraph:    blockGraph,
 		node:          node,
 
 		parties: &MapOf[uuid.UUID, *PartyHandler]{},
@@ -69,7 +74,7 @@ func NewLocalPartyRegistry(logger *zap.Logger, matchmaker Matchmaker, tracker Tr
 
 func (p *LocalPartyRegistry) Create(open bool, maxSize int, presence *rtapi.UserPresence) *PartyHandler {
 	id := uuid.Must(uuid.NewV4())
-	partyHandler := NewPartyHandler(p.logger, p, p.matchmaker, p.tracker, p.streamManager, p.router, id, p.node, open, maxSize, presence)
+	partyHandler := NewPartyHandler(p.logger, p, p.matchmaker, p.tracker, p.streamManager, p.router, p.blockGraph, id, p.node, open, maxSize, presence)
 
 	p.parties.Store(id, partyHandler)
 
@@ -174,7 +179,7 @@ func (p *LocalPartyRegistry) PartyJoinRequestList(ctx context.Context, id uuid.U
 	return ph.JoinRequestList(sessionID, fromNode)
 }
 
-func (p *LocalPartyRegistry) PartyMatchmakerAdd(ctx context.Context, id uuid.UUID, node, sessionID, fromNode, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*PresenceID, error) {
+func (p *LocalPartyRegistry) PartyMatchmakerAdd(ctx context.Context, id uuid.UUID, node, sessionID, fromNode, queue, query string, minCount, maxCount, countMultiple int, stringProperties map[string]string, numericProperties map[string]float64) (string, []*PresenceID, error) {
 	if node != p.node {
 		return "", nil, ErrPartyNotFound
 	}
@@ -184,7 +189,7 @@ func (p *LocalPartyRegistry) PartyMatchmakerAdd(ctx context.Context, id uuid.UUI
 		return "", nil, ErrPartyNotFound
 	}
 
-	return ph.MatchmakerAdd(sessionID, fromNode, query, minCount, maxCount, countMultiple, stringProperties, numericProperties)
+	return ph.MatchmakerAdd(sessionID, fromNode, queue, query, minCount, maxCount, countMultiple, stringProperties, numericProperties)
 }
 
 func (p *LocalPartyRegistry) PartyMatchmakerRemove(ctx context.Context, id uuid.UUID, node, sessionID, fromNode, ticket string) error {
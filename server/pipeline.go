@@ -18,8 +18,10 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -33,15 +35,18 @@ type Pipeline struct {
 	sessionRegistry      SessionRegistry
 	statusRegistry       *StatusRegistry
 	matchRegistry        MatchRegistry
+	matchHostRegistry    MatchHostRegistry
 	partyRegistry        PartyRegistry
 	matchmaker           Matchmaker
 	tracker              Tracker
 	router               MessageRouter
+	metrics              Metrics
 	runtime              *Runtime
 	node                 string
+	messageLimiter       *messageRateLimiter
 }
 
-func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, partyRegistry PartyRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, runtime *Runtime) *Pipeline {
+func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, matchHostRegistry MatchHostRegistry, partyRegistry PartyRegistry, matchmaker Matchmaker, tracker Tracker, router MessageRouter, metrics Metrics, runtime *Runtime) *Pipeline {
 	return &Pipeline{
 		logger:               logger,
 		config:               config,
@@ -51,12 +56,15 @@ func NewPipeline(logger *zap.Logger, config Config, db *sql.DB, protojsonMarshal
 		sessionRegistry:      sessionRegistry,
 		statusRegistry:       statusRegistry,
 		matchRegistry:        matchRegistry,
+		matchHostRegistry:    matchHostRegistry,
 		partyRegistry:        partyRegistry,
 		matchmaker:           matchmaker,
 		tracker:              tracker,
 		router:               router,
+		metrics:              metrics,
 		runtime:              runtime,
 		node:                 config.GetName(),
+		messageLimiter:       newMessageRateLimiter(config.GetSocket()),
 	}
 }
 
@@ -73,6 +81,43 @@ func (p *Pipeline) ProcessRequest(logger *zap.Logger, session Session, in *rtapi
 		return false
 	}
 
+	if p.messageLimiter != nil {
+		messageName := envelopeMessageName(in)
+		result, delay := p.messageLimiter.Allow(session, messageName)
+		if result != messageRateLimitAllowed {
+			p.metrics.MessageRateLimited(messageName, p.messageLimiter.action.String())
+		}
+		switch result {
+		case messageRateLimitThrottled:
+			// Hold up the session's message processing until the limit allows this message, keeping its messages in order.
+			timer := time.NewTimer(delay)
+			select {
+			case <-session.Context().Done():
+				timer.Stop()
+				return false
+			case <-timer.C:
+			}
+		case messageRateLimitRejected, messageRateLimitExhausted:
+			errEnvelope := &rtapi.Envelope{Cid: in.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+				Code:    int32(rtapi.Error_BAD_INPUT),
+				Message: "Message rate limit exceeded",
+				Context: map[string]string{"message": messageName},
+			}}}
+			if result == messageRateLimitExhausted {
+				// Sessions are only closed once they have exceeded their limits too many times.
+				logger.Debug("Closing session that exceeded message rate limits too many times", zap.String("message", messageName))
+				session.Close("message rate limit exceeded", runtime.PresenceReasonDisconnect, errEnvelope)
+				return false
+			}
+			session.Send(errEnvelope, true)
+			return true
+		}
+	}
+
+	return p.processRequest(logger, session, in)
+}
+
+func (p *Pipeline) processRequest(logger *zap.Logger, session Session, in *rtapi.Envelope) bool {
 	var pipelineFn func(*zap.Logger, Session, *rtapi.Envelope) (bool, *rtapi.Envelope)
 
 	switch in.Message.(type) {
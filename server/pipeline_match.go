@@ -98,6 +98,7 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 	var matchID uuid.UUID
 	var node string
 	var matchIDString string
+	var reconnectToken string
 	allowEmpty := false
 
 	switch incoming.Id.(type) {
@@ -163,6 +164,17 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		}
 		node = matchIDComponents[1]
 		allowEmpty = true
+		if _, ok := claims["rid"]; ok && node != "" {
+			// Reconnect tokens are issued by authoritative matches to their participants.
+			if uid, _ := claims["uid"].(string); uid != session.UserID().String() {
+				session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+					Code:    int32(rtapi.Error_BAD_INPUT),
+					Message: "Invalid match token",
+				}}}, true)
+				return false, nil
+			}
+			reconnectToken = incoming.GetToken()
+		}
 	case nil:
 		session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
 			Code:    int32(rtapi.Error_BAD_INPUT),
@@ -230,7 +242,14 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		// Authoritative match.
 		mode = StreamModeMatchAuthoritative
 
-		found, allow, isNew, reason, l, ps := p.matchRegistry.JoinAttempt(session.Context(), matchID, node, session.UserID(), session.ID(), username, session.Expiry(), session.Vars(), session.ClientIP(), session.ClientPort(), p.node, incoming.Metadata)
+		var found, allow, isNew bool
+		var reason, l string
+		var ps []*MatchPresence
+		if reconnectToken != "" {
+			found, allow, isNew, reason, l, ps = p.matchRegistry.Reconnect(session.Context(), matchID, node, reconnectToken, session.UserID(), session.ID(), username, p.node)
+		} else {
+			found, allow, isNew, reason, l, ps = p.matchRegistry.JoinAttempt(session.Context(), matchID, node, session.UserID(), session.ID(), username, session.Expiry(), session.Vars(), session.ClientIP(), session.ClientPort(), p.node, incoming.Metadata)
+		}
 		if !found {
 			// Match did not exist.
 			session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
@@ -255,8 +274,9 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 		if isNew {
 			stream := PresenceStream{Mode: mode, Subject: matchID, Label: node}
 			m := PresenceMeta{
-				Username: session.Username(),
-				Format:   session.Format(),
+				Username:  session.Username(),
+				Format:    session.Format(),
+				Spectator: matchJoinSpectator(incoming.Metadata),
 			}
 			if success, _ := p.tracker.Track(session.Context(), session.ID(), stream, session.UserID(), m, false); success {
 				if p.config.GetSession().SingleMatch {
@@ -274,6 +294,10 @@ func (p *Pipeline) matchJoin(logger *zap.Logger, session Session, envelope *rtap
 				// Only for new joins, not if the user is joining a match they're already part of.
 				continue
 			}
+			if p.Spectator {
+				// Spectators do not appear in match presence lists.
+				continue
+			}
 			presences = append(presences, &rtapi.UserPresence{
 				UserId:    p.UserID.String(),
 				SessionId: p.SessionID.String(),
@@ -358,10 +382,15 @@ func (p *Pipeline) matchDataSend(logger *zap.Logger, session Session, envelope *
 
 	// If it's an authoritative match pass the data to the match handler.
 	if matchIDComponents[1] != "" {
-		if p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: matchID, Label: matchIDComponents[1]}, session.UserID()) == nil {
+		meta := p.tracker.GetLocalBySessionIDStreamUserID(session.ID(), PresenceStream{Mode: StreamModeMatchAuthoritative, Subject: matchID, Label: matchIDComponents[1]}, session.UserID())
+		if meta == nil {
 			// User is not part of the match.
 			return false, nil
 		}
+		if meta.Spectator {
+			// Spectators cannot send match data.
+			return false, nil
+		}
 
 		p.matchRegistry.SendData(matchID, matchIDComponents[1], session.UserID(), session.ID(), session.Username(), p.node, incoming.OpCode, incoming.Data, incoming.Reliable, time.Now().UTC().UnixNano()/int64(time.Millisecond))
 		return true, nil
@@ -372,6 +401,15 @@ func (p *Pipeline) matchDataSend(logger *zap.Logger, session Session, envelope *
 	if len(incoming.Presences) != 0 {
 		filters = make([]*matchDataFilter, len(incoming.Presences))
 		for i := 0; i < len(incoming.Presences); i++ {
+			if incoming.Presences[i].SessionId == MatchDataTargetHost && p.matchHostRegistry != nil {
+				// Addressed to the current match host, whoever that is.
+				host := p.matchHostRegistry.Host(matchID)
+				if host == nil {
+					return false, nil
+				}
+				filters[i] = &matchDataFilter{sessionID: host.SessionID}
+				continue
+			}
 			userID, err := uuid.FromString(incoming.Presences[i].UserId)
 			if err != nil {
 				return false, nil
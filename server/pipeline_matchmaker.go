@@ -84,8 +84,15 @@ func (p *Pipeline) matchmakerAdd(logger *zap.Logger, session Session, envelope *
 	}}
 
 	// Run matchmaker add.
-	ticket, _, err := p.matchmaker.Add(session.Context(), presences, session.ID().String(), "", query, minCount, maxCount, countMultiple, incoming.StringProperties, incoming.NumericProperties)
-	if err != nil {
+	queue, stringProperties := matchmakerQueue(p.config, incoming.StringProperties)
+	ticket, _, err := p.matchmaker.Add(session.Context(), queue, presences, session.ID().String(), "", query, minCount, maxCount, countMultiple, stringProperties, incoming.NumericProperties)
+	if err == ErrMatchmakerQueueNotFound {
+		session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
+			Code:    int32(rtapi.Error_BAD_INPUT),
+			Message: "Matchmaker queue not found",
+		}}}, true)
+		return false, nil
+	} else if err != nil {
 		logger.Error("Error adding to matchmaker", zap.Error(err))
 		session.Send(&rtapi.Envelope{Cid: envelope.Cid, Message: &rtapi.Envelope_Error{Error: &rtapi.Error{
 			Code:    int32(rtapi.Error_RUNTIME_EXCEPTION),
@@ -28,6 +28,7 @@ import (
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
 	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"github.com/heroiclabs/nakama/v3/social"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -76,7 +77,9 @@ type (
 	RuntimeBeforeAuthenticateGameCenterFunction            func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AuthenticateGameCenterRequest) (*api.AuthenticateGameCenterRequest, error, codes.Code)
 	RuntimeAfterAuthenticateGameCenterFunction             func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateGameCenterRequest) error
 	RuntimeBeforeAuthenticateGoogleFunction                func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AuthenticateGoogleRequest) (*api.AuthenticateGoogleRequest, error, codes.Code)
+	RuntimeBeforeAuthenticateOidcFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AuthenticateOidcRequest) (*apigrpc.AuthenticateOidcRequest, error, codes.Code)
 	RuntimeAfterAuthenticateGoogleFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateGoogleRequest) error
+	RuntimeAfterAuthenticateOidcFunction                   func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *apigrpc.AuthenticateOidcRequest) error
 	RuntimeBeforeAuthenticateSteamFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AuthenticateSteamRequest) (*api.AuthenticateSteamRequest, error, codes.Code)
 	RuntimeAfterAuthenticateSteamFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateSteamRequest) error
 	RuntimeBeforeListChannelMessagesFunction               func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.ListChannelMessagesRequest) (*api.ListChannelMessagesRequest, error, codes.Code)
@@ -169,6 +172,8 @@ type (
 	RuntimeAfterWriteTournamentRecordFunction              func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.LeaderboardRecord, in *api.WriteTournamentRecordRequest) error
 	RuntimeBeforeListTournamentRecordsAroundOwnerFunction  func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.ListTournamentRecordsAroundOwnerRequest) (*api.ListTournamentRecordsAroundOwnerRequest, error, codes.Code)
 	RuntimeAfterListTournamentRecordsAroundOwnerFunction   func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.TournamentRecordList, in *api.ListTournamentRecordsAroundOwnerRequest) error
+	RuntimeBeforeTransferMatchHostFunction                 func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.TransferMatchHostRequest) (*apigrpc.TransferMatchHostRequest, error, codes.Code)
+	RuntimeAfterTransferMatchHostFunction                  func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.TransferMatchHostRequest) error
 	RuntimeBeforeUnlinkAppleFunction                       func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountApple) (*api.AccountApple, error, codes.Code)
 	RuntimeAfterUnlinkAppleFunction                        func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountApple) error
 	RuntimeBeforeUnlinkCustomFunction                      func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountCustom) (*api.AccountCustom, error, codes.Code)
@@ -207,8 +212,9 @@ type (
 	RuntimeAfterGetSubscriptionFunction                    func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.ValidatedSubscription, in *api.GetSubscriptionRequest) error
 
 	RuntimeMatchmakerMatchedFunction func(ctx context.Context, entries []*MatchmakerEntry) (string, bool, error)
+	RuntimeMatchmakerScoreFunction   func(ctx context.Context, candidates [][]*MatchmakerEntry) ([]float64, error)
 
-	RuntimeMatchCreateFunction       func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, name string) (RuntimeMatchCore, error)
+	RuntimeMatchCreateFunction       func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error)
 	RuntimeMatchDeferMessageFunction func(msg *DeferredMessage) error
 
 	RuntimeTournamentEndFunction   func(ctx context.Context, tournament *api.Tournament, end, reset int64) error
@@ -248,6 +254,7 @@ const (
 	RuntimeExecutionModeSubscriptionNotificationApple
 	RuntimeExecutionModePurchaseNotificationGoogle
 	RuntimeExecutionModeSubscriptionNotificationGoogle
+	RuntimeExecutionModeMatchmakerScore
 )
 
 func (e RuntimeExecutionMode) String() string {
@@ -282,6 +289,8 @@ func (e RuntimeExecutionMode) String() string {
 		return "purchase_notification_google"
 	case RuntimeExecutionModeSubscriptionNotificationGoogle:
 		return "subscription_notification_google"
+	case RuntimeExecutionModeMatchmakerScore:
+		return "matchmaker_score"
 	}
 
 	return ""
@@ -295,11 +304,17 @@ type RuntimeMatchCore interface {
 	MatchLoop(tick int64, state interface{}, inputCh <-chan *MatchDataMessage) (interface{}, error)
 	MatchTerminate(tick int64, state interface{}, graceSeconds int) (interface{}, error)
 	MatchSignal(tick int64, state interface{}, data string) (interface{}, string, error)
+	// Serialize the match state to persist across a server restart, empty if the match handler does not keep its state.
+	MatchSnapshot(tick int64, state interface{}) (string, error)
+	// Replace the state from match init with one deserialized from a snapshot, when a match is re-created after a restart.
+	MatchRestore(tick int64, state interface{}, data string) (interface{}, error)
 	GetState(state interface{}) (string, error)
 	Label() string
 	TickRate() int
 	HandlerName() string
 	CreateTime() int64
+	// Match data rate limit overrides returned at init, nil if there are none.
+	DataRateLimits() map[string]interface{}
 	Cancel()
 	Cleanup()
 }
@@ -338,6 +353,7 @@ type RuntimeBeforeReqFunctions struct {
 	beforeAuthenticateFacebookInstantGameFunction   RuntimeBeforeAuthenticateFacebookInstantGameFunction
 	beforeAuthenticateGameCenterFunction            RuntimeBeforeAuthenticateGameCenterFunction
 	beforeAuthenticateGoogleFunction                RuntimeBeforeAuthenticateGoogleFunction
+	beforeAuthenticateOidcFunction                  RuntimeBeforeAuthenticateOidcFunction
 	beforeAuthenticateSteamFunction                 RuntimeBeforeAuthenticateSteamFunction
 	beforeListChannelMessagesFunction               RuntimeBeforeListChannelMessagesFunction
 	beforeListFriendsFunction                       RuntimeBeforeListFriendsFunction
@@ -384,6 +400,7 @@ type RuntimeBeforeReqFunctions struct {
 	beforeListTournamentsFunction                   RuntimeBeforeListTournamentsFunction
 	beforeWriteTournamentRecordFunction             RuntimeBeforeWriteTournamentRecordFunction
 	beforeListTournamentRecordsAroundOwnerFunction  RuntimeBeforeListTournamentRecordsAroundOwnerFunction
+	beforeTransferMatchHostFunction                 RuntimeBeforeTransferMatchHostFunction
 	beforeUnlinkAppleFunction                       RuntimeBeforeUnlinkAppleFunction
 	beforeUnlinkCustomFunction                      RuntimeBeforeUnlinkCustomFunction
 	beforeUnlinkDeviceFunction                      RuntimeBeforeUnlinkDeviceFunction
@@ -418,6 +435,7 @@ type RuntimeAfterReqFunctions struct {
 	afterAuthenticateFacebookInstantGameFunction   RuntimeAfterAuthenticateFacebookInstantGameFunction
 	afterAuthenticateGameCenterFunction            RuntimeAfterAuthenticateGameCenterFunction
 	afterAuthenticateGoogleFunction                RuntimeAfterAuthenticateGoogleFunction
+	afterAuthenticateOidcFunction                  RuntimeAfterAuthenticateOidcFunction
 	afterAuthenticateSteamFunction                 RuntimeAfterAuthenticateSteamFunction
 	afterListChannelMessagesFunction               RuntimeAfterListChannelMessagesFunction
 	afterListFriendsFunction                       RuntimeAfterListFriendsFunction
@@ -464,6 +482,7 @@ type RuntimeAfterReqFunctions struct {
 	afterListTournamentsFunction                   RuntimeAfterListTournamentsFunction
 	afterWriteTournamentRecordFunction             RuntimeAfterWriteTournamentRecordFunction
 	afterListTournamentRecordsAroundOwnerFunction  RuntimeAfterListTournamentRecordsAroundOwnerFunction
+	afterTransferMatchHostFunction                 RuntimeAfterTransferMatchHostFunction
 	afterUnlinkAppleFunction                       RuntimeAfterUnlinkAppleFunction
 	afterUnlinkCustomFunction                      RuntimeAfterUnlinkCustomFunction
 	afterUnlinkDeviceFunction                      RuntimeAfterUnlinkDeviceFunction
@@ -496,6 +515,7 @@ type Runtime struct {
 	afterReqFunctions  *RuntimeAfterReqFunctions
 
 	matchmakerMatchedFunction RuntimeMatchmakerMatchedFunction
+	matchmakerScoreFunction   RuntimeMatchmakerScoreFunction
 
 	tournamentEndFunction                  RuntimeTournamentEndFunction
 	tournamentResetFunction                RuntimeTournamentResetFunction
@@ -535,12 +555,12 @@ func (mp *MatchProvider) RegisterCreateFn(name string, fn RuntimeMatchCreateFunc
 	mp.Unlock()
 }
 
-func (mp *MatchProvider) CreateMatch(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, name string) (RuntimeMatchCore, error) {
+func (mp *MatchProvider) CreateMatch(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error) {
 	mp.RLock()
 	providers := mp.providers
 	mp.RUnlock()
 	for _, p := range providers {
-		core, err := p(ctx, logger, id, node, stopped, name)
+		core, err := p(ctx, logger, id, node, stopped, router, name)
 		if err != nil {
 			return nil, err
 		}
@@ -611,7 +631,7 @@ func CheckRuntime(logger *zap.Logger, config Config, version string) error {
 	return nil
 }
 
-func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter) (*Runtime, *RuntimeInfo, error) {
+func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter) (*Runtime, *RuntimeInfo, error) {
 	runtimeConfig := config.GetRuntime()
 	startupLogger.Info("Initialising runtime", zap.String("path", runtimeConfig.Path))
 
@@ -626,19 +646,19 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 
 	matchProvider := NewMatchProvider()
 
-	goModules, goRPCFns, goBeforeRtFns, goAfterRtFns, goBeforeReqFns, goAfterReqFns, goMatchmakerMatchedFn, goTournamentEndFn, goTournamentResetFn, goLeaderboardResetFn, goPurchaseNotificationAppleFn, goSubscriptionNotificationAppleFn, goPurchaseNotificationGoogleFn, goSubscriptionNotificationGoogleFn, allEventFns, goMatchNamesListFn, err := NewRuntimeProviderGo(ctx, logger, startupLogger, db, protojsonMarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, runtimeConfig.Path, paths, eventQueue, matchProvider)
+	goModules, goRPCFns, goBeforeRtFns, goAfterRtFns, goBeforeReqFns, goAfterReqFns, goMatchmakerMatchedFn, goMatchmakerScoreFn, goTournamentEndFn, goTournamentResetFn, goLeaderboardResetFn, goPurchaseNotificationAppleFn, goSubscriptionNotificationAppleFn, goPurchaseNotificationGoogleFn, goSubscriptionNotificationGoogleFn, allEventFns, goMatchNamesListFn, err := NewRuntimeProviderGo(ctx, logger, startupLogger, db, protojsonMarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, runtimeConfig.Path, paths, eventQueue, matchProvider)
 	if err != nil {
 		startupLogger.Error("Error initialising Go runtime provider", zap.Error(err))
 		return nil, nil, err
 	}
 
-	luaModules, luaRPCFns, luaBeforeRtFns, luaAfterRtFns, luaBeforeReqFns, luaAfterReqFns, luaMatchmakerMatchedFn, luaTournamentEndFn, luaTournamentResetFn, luaLeaderboardResetFn, luaPurchaseNotificationAppleFn, luaSubscriptionNotificationAppleFn, luaPurchaseNotificationGoogleFn, luaSubscriptionNotificationGoogleFn, luaModuleHotfixFn, err := NewRuntimeProviderLua(logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, allEventFns.eventFunction, runtimeConfig.Path, paths, matchProvider)
+	luaModules, luaRPCFns, luaBeforeRtFns, luaAfterRtFns, luaBeforeReqFns, luaAfterReqFns, luaMatchmakerMatchedFn, luaMatchmakerScoreFn, luaTournamentEndFn, luaTournamentResetFn, luaLeaderboardResetFn, luaPurchaseNotificationAppleFn, luaSubscriptionNotificationAppleFn, luaPurchaseNotificationGoogleFn, luaSubscriptionNotificationGoogleFn, luaModuleHotfixFn, err := NewRuntimeProviderLua(logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, allEventFns.eventFunction, runtimeConfig.Path, paths, matchProvider)
 	if err != nil {
 		startupLogger.Error("Error initialising Lua runtime provider", zap.Error(err))
 		return nil, nil, err
 	}
 
-	jsModules, jsRPCFns, jsBeforeRtFns, jsAfterRtFns, jsBeforeReqFns, jsAfterReqFns, jsMatchmakerMatchedFn, jsTournamentEndFn, jsTournamentResetFn, jsLeaderboardResetFn, jsPurchaseNotificationAppleFn, jsSubscriptionNotificationAppleFn, jsPurchaseNotificationGoogleFn, jsSubscriptionNotificationGoogleFn, err := NewRuntimeProviderJS(logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, allEventFns.eventFunction, runtimeConfig.Path, runtimeConfig.JsEntrypoint, matchProvider)
+	jsModules, jsRPCFns, jsBeforeRtFns, jsAfterRtFns, jsBeforeReqFns, jsAfterReqFns, jsMatchmakerMatchedFn, jsMatchmakerScoreFn, jsTournamentEndFn, jsTournamentResetFn, jsLeaderboardResetFn, jsPurchaseNotificationAppleFn, jsSubscriptionNotificationAppleFn, jsPurchaseNotificationGoogleFn, jsSubscriptionNotificationGoogleFn, err := NewRuntimeProviderJS(logger, startupLogger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, leaderboardRankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, allEventFns.eventFunction, runtimeConfig.Path, runtimeConfig.JsEntrypoint, matchProvider)
 	if err != nil {
 		startupLogger.Error("Error initialising JavaScript runtime provider", zap.Error(err))
 		return nil, nil, err
@@ -758,6 +778,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allBeforeReqFunctions.beforeAuthenticateGoogleFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if allBeforeReqFunctions.beforeAuthenticateOidcFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if allBeforeReqFunctions.beforeAuthenticateSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "authenticatesteam"))
 	}
@@ -896,6 +919,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allBeforeReqFunctions.beforeListTournamentRecordsAroundOwnerFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "listtournamentrecordsaroundowner"))
 	}
+	if allBeforeReqFunctions.beforeTransferMatchHostFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "transfermatchhost"))
+	}
 	if allBeforeReqFunctions.beforeUnlinkAppleFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime Before function invocation", zap.String("id", "unlinkapple"))
 	}
@@ -1004,6 +1030,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeAuthenticateGoogleFunction = luaBeforeReqFns.beforeAuthenticateGoogleFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if luaBeforeReqFns.beforeAuthenticateOidcFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateOidcFunction = luaBeforeReqFns.beforeAuthenticateOidcFunction
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if luaBeforeReqFns.beforeAuthenticateSteamFunction != nil {
 		allBeforeReqFunctions.beforeAuthenticateSteamFunction = luaBeforeReqFns.beforeAuthenticateSteamFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "authenticatesteam"))
@@ -1184,6 +1214,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeListTournamentRecordsAroundOwnerFunction = luaBeforeReqFns.beforeListTournamentRecordsAroundOwnerFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "listtournamentrecordsaroundowner"))
 	}
+	if luaBeforeReqFns.beforeTransferMatchHostFunction != nil {
+		allBeforeReqFunctions.beforeTransferMatchHostFunction = luaBeforeReqFns.beforeTransferMatchHostFunction
+		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "transfermatchhost"))
+	}
 	if luaBeforeReqFns.beforeUnlinkAppleFunction != nil {
 		allBeforeReqFunctions.beforeUnlinkAppleFunction = luaBeforeReqFns.beforeUnlinkAppleFunction
 		startupLogger.Info("Registered Lua runtime Before function invocation", zap.String("id", "unlinkapple"))
@@ -1310,6 +1344,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeAuthenticateGoogleFunction = goBeforeReqFns.beforeAuthenticateGoogleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if goBeforeReqFns.beforeAuthenticateOidcFunction != nil {
+		allBeforeReqFunctions.beforeAuthenticateOidcFunction = goBeforeReqFns.beforeAuthenticateOidcFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if goBeforeReqFns.beforeAuthenticateSteamFunction != nil {
 		allBeforeReqFunctions.beforeAuthenticateSteamFunction = goBeforeReqFns.beforeAuthenticateSteamFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "authenticatesteam"))
@@ -1494,6 +1532,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allBeforeReqFunctions.beforeListTournamentRecordsAroundOwnerFunction = goBeforeReqFns.beforeListTournamentRecordsAroundOwnerFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "listtournamentrecordsaroundowner"))
 	}
+	if goBeforeReqFns.beforeTransferMatchHostFunction != nil {
+		allBeforeReqFunctions.beforeTransferMatchHostFunction = goBeforeReqFns.beforeTransferMatchHostFunction
+		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "transfermatchhost"))
+	}
 	if goBeforeReqFns.beforeUnlinkAppleFunction != nil {
 		allBeforeReqFunctions.beforeUnlinkAppleFunction = goBeforeReqFns.beforeUnlinkAppleFunction
 		startupLogger.Info("Registered Go runtime Before function invocation", zap.String("id", "unlinkapple"))
@@ -1608,6 +1650,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allAfterReqFunctions.afterAuthenticateGoogleFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if allAfterReqFunctions.afterAuthenticateOidcFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if allAfterReqFunctions.afterAuthenticateSteamFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "authenticatesteam"))
 	}
@@ -1746,6 +1791,9 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 	if allAfterReqFunctions.afterListTournamentRecordsAroundOwnerFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "listtournamentrecordsaroundowner"))
 	}
+	if allAfterReqFunctions.afterTransferMatchHostFunction != nil {
+		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "transfermatchhost"))
+	}
 	if allAfterReqFunctions.afterUnlinkAppleFunction != nil {
 		startupLogger.Info("Registered JavaScript runtime After function invocation", zap.String("id", "unlinkapple"))
 	}
@@ -1854,6 +1902,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterAuthenticateGoogleFunction = luaAfterReqFns.afterAuthenticateGoogleFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if luaAfterReqFns.afterAuthenticateOidcFunction != nil {
+		allAfterReqFunctions.afterAuthenticateOidcFunction = luaAfterReqFns.afterAuthenticateOidcFunction
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if luaAfterReqFns.afterAuthenticateSteamFunction != nil {
 		allAfterReqFunctions.afterAuthenticateSteamFunction = luaAfterReqFns.afterAuthenticateSteamFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "authenticatesteam"))
@@ -2034,6 +2086,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterListTournamentRecordsAroundOwnerFunction = luaAfterReqFns.afterListTournamentRecordsAroundOwnerFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "listtournamentrecordsaroundowner"))
 	}
+	if luaAfterReqFns.afterTransferMatchHostFunction != nil {
+		allAfterReqFunctions.afterTransferMatchHostFunction = luaAfterReqFns.afterTransferMatchHostFunction
+		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "transfermatchhost"))
+	}
 	if luaAfterReqFns.afterUnlinkAppleFunction != nil {
 		allAfterReqFunctions.afterUnlinkAppleFunction = luaAfterReqFns.afterUnlinkAppleFunction
 		startupLogger.Info("Registered Lua runtime After function invocation", zap.String("id", "unlinkapple"))
@@ -2160,6 +2216,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterAuthenticateGoogleFunction = goAfterReqFns.afterAuthenticateGoogleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticategoogle"))
 	}
+	if goAfterReqFns.afterAuthenticateOidcFunction != nil {
+		allAfterReqFunctions.afterAuthenticateOidcFunction = goAfterReqFns.afterAuthenticateOidcFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticateoidc"))
+	}
 	if goAfterReqFns.afterAuthenticateSteamFunction != nil {
 		allAfterReqFunctions.afterAuthenticateSteamFunction = goAfterReqFns.afterAuthenticateSteamFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "authenticatesteam"))
@@ -2344,6 +2404,10 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		allAfterReqFunctions.afterListTournamentRecordsAroundOwnerFunction = goAfterReqFns.afterListTournamentRecordsAroundOwnerFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "listtournamentrecordsaroundowner"))
 	}
+	if goAfterReqFns.afterTransferMatchHostFunction != nil {
+		allAfterReqFunctions.afterTransferMatchHostFunction = goAfterReqFns.afterTransferMatchHostFunction
+		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "transfermatchhost"))
+	}
 	if goAfterReqFns.afterUnlinkAppleFunction != nil {
 		allAfterReqFunctions.afterUnlinkAppleFunction = goAfterReqFns.afterUnlinkAppleFunction
 		startupLogger.Info("Registered Go runtime After function invocation", zap.String("id", "unlinkapple"))
@@ -2430,6 +2494,19 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		startupLogger.Info("Registered JavaScript runtime Matchmaker Matched function invocation")
 	}
 
+	var allMatchmakerScoreFunction RuntimeMatchmakerScoreFunction
+	switch {
+	case goMatchmakerScoreFn != nil:
+		allMatchmakerScoreFunction = goMatchmakerScoreFn
+		startupLogger.Info("Registered Go runtime Matchmaker Score function invocation")
+	case luaMatchmakerScoreFn != nil:
+		allMatchmakerScoreFunction = luaMatchmakerScoreFn
+		startupLogger.Info("Registered Lua runtime Matchmaker Score function invocation")
+	case jsMatchmakerScoreFn != nil:
+		allMatchmakerScoreFunction = jsMatchmakerScoreFn
+		startupLogger.Info("Registered JavaScript runtime Matchmaker Score function invocation")
+	}
+
 	var allTournamentEndFunction RuntimeTournamentEndFunction
 	switch {
 	case goTournamentEndFn != nil:
@@ -2548,6 +2625,7 @@ func NewRuntime(ctx context.Context, logger, startupLogger *zap.Logger, db *sql.
 		beforeReqFunctions:                     allBeforeReqFunctions,
 		afterReqFunctions:                      allAfterReqFunctions,
 		matchmakerMatchedFunction:              allMatchmakerMatchedFunction,
+		matchmakerScoreFunction:                allMatchmakerScoreFunction,
 		tournamentEndFunction:                  allTournamentEndFunction,
 		tournamentResetFunction:                allTournamentResetFunction,
 		leaderboardResetFunction:               allLeaderboardResetFunction,
@@ -2743,10 +2821,18 @@ func (r *Runtime) BeforeAuthenticateGoogle() RuntimeBeforeAuthenticateGoogleFunc
 	return r.beforeReqFunctions.beforeAuthenticateGoogleFunction
 }
 
+func (r *Runtime) BeforeAuthenticateOidc() RuntimeBeforeAuthenticateOidcFunction {
+	return r.beforeReqFunctions.beforeAuthenticateOidcFunction
+}
+
 func (r *Runtime) AfterAuthenticateGoogle() RuntimeAfterAuthenticateGoogleFunction {
 	return r.afterReqFunctions.afterAuthenticateGoogleFunction
 }
 
+func (r *Runtime) AfterAuthenticateOidc() RuntimeAfterAuthenticateOidcFunction {
+	return r.afterReqFunctions.afterAuthenticateOidcFunction
+}
+
 func (r *Runtime) BeforeAuthenticateSteam() RuntimeBeforeAuthenticateSteamFunction {
 	return r.beforeReqFunctions.beforeAuthenticateSteamFunction
 }
@@ -3115,6 +3201,14 @@ func (r *Runtime) AfterListTournamentRecordsAroundOwner() RuntimeAfterListTourna
 	return r.afterReqFunctions.afterListTournamentRecordsAroundOwnerFunction
 }
 
+func (r *Runtime) BeforeTransferMatchHost() RuntimeBeforeTransferMatchHostFunction {
+	return r.beforeReqFunctions.beforeTransferMatchHostFunction
+}
+
+func (r *Runtime) AfterTransferMatchHost() RuntimeAfterTransferMatchHostFunction {
+	return r.afterReqFunctions.afterTransferMatchHostFunction
+}
+
 func (r *Runtime) BeforeUnlinkApple() RuntimeBeforeUnlinkAppleFunction {
 	return r.beforeReqFunctions.beforeUnlinkAppleFunction
 }
@@ -3263,6 +3357,10 @@ func (r *Runtime) MatchmakerMatched() RuntimeMatchmakerMatchedFunction {
 	return r.matchmakerMatchedFunction
 }
 
+func (r *Runtime) MatchmakerScore() RuntimeMatchmakerScoreFunction {
+	return r.matchmakerScoreFunction
+}
+
 func (r *Runtime) TournamentEnd() RuntimeTournamentEndFunction {
 	return r.tournamentEndFunction
 }
@@ -20,6 +20,10 @@ import (
 	"github.com/heroiclabs/nakama-common/runtime"
 )
 
+// RUNTIME_CTX_MATCHMAKER_REGION is the context key holding the region the matchmaker chose for a group of matched
+// players, set when running the matchmaker matched hook.
+const RUNTIME_CTX_MATCHMAKER_REGION = "matchmaker_region"
+
 func NewRuntimeGoContext(ctx context.Context, node, version string, env map[string]string, mode RuntimeExecutionMode, headers, queryParams map[string][]string, sessionExpiry int64, userID, username string, vars map[string]string, sessionID, clientIP, clientPort, lang string) context.Context {
 	ctx = context.WithValue(ctx, runtime.RUNTIME_CTX_ENV, env)
 	ctx = context.WithValue(ctx, runtime.RUNTIME_CTX_MODE, mode.String())
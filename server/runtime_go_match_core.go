@@ -30,6 +30,32 @@ import (
 
 var ErrMatchStopped = errors.New("match stopped")
 
+// RuntimeGoMatchDataRateLimiter may be implemented by Go match handlers to override the configured match data rate
+// limits. It is called once after MatchInit, and returns a map with the optional keys "rate", "burst", "action" and
+// "op_codes", which holds rate and burst maps keyed by op code.
+type RuntimeGoMatchDataRateLimiter interface {
+	MatchDataRateLimits() map[string]interface{}
+}
+
+// RuntimeGoMatchSnapshotter may be implemented by Go match handlers to keep their state across server restarts. When
+// matches are stopped at shutdown MatchSnapshot returns the serialized state, or an empty string to let the match end.
+// After the restart the match is re-created with the same ID, and MatchRestore is called after MatchInit with the
+// snapshot data to return the state the match continues with.
+type RuntimeGoMatchSnapshotter interface {
+	MatchSnapshot(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, tick int64, state interface{}) string
+	MatchRestore(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, data string) interface{}
+}
+
+// RuntimeGoMatchSpectatorDispatcher is implemented by the dispatcher passed to Go match handlers, and broadcasts to
+// spectators only. Presences that are not spectators are left out of the given presences, and all spectators receive
+// the message if presences is nil. Match handlers may use it to send spectators a delayed or filtered view of the
+// match. Modules declare an interface with the method and use a type assertion on the dispatcher.
+type RuntimeGoMatchSpectatorDispatcher interface {
+	BroadcastSpectatorMessage(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence, reliable bool) error
+}
+
+var _ RuntimeGoMatchSpectatorDispatcher = &RuntimeGoMatchCore{}
+
 type RuntimeGoMatchCore struct {
 	logger        *zap.Logger
 	matchRegistry MatchRegistry
@@ -49,6 +75,8 @@ type RuntimeGoMatchCore struct {
 	idStr      string
 	stream     PresenceStream
 	label      *atomic.String
+	// dataRateLimits set in MatchInit.
+	dataRateLimits map[string]interface{}
 
 	runtimeLogger runtime.Logger
 	db            *sql.DB
@@ -116,6 +144,10 @@ func (r *RuntimeGoMatchCore) MatchInit(presenceList *MatchPresenceList, deferMes
 	}
 	r.label.Store(label)
 
+	if limiter, ok := r.match.(RuntimeGoMatchDataRateLimiter); ok {
+		r.dataRateLimits = limiter.MatchDataRateLimits()
+	}
+
 	r.ctx = context.WithValue(r.ctx, runtime.RUNTIME_CTX_MATCH_TICK_RATE, tickRate)
 	r.ctx = context.WithValue(r.ctx, runtime.RUNTIME_CTX_MATCH_LABEL, label)
 
@@ -195,6 +227,23 @@ func (r *RuntimeGoMatchCore) MatchSignal(tick int64, state interface{}, data str
 	return newState, responseData, nil
 }
 
+func (r *RuntimeGoMatchCore) MatchSnapshot(tick int64, state interface{}) (string, error) {
+	snapshotter, ok := r.match.(RuntimeGoMatchSnapshotter)
+	if !ok {
+		return "", nil
+	}
+	return snapshotter.MatchSnapshot(r.ctx, r.runtimeLogger, r.db, r.nk, tick, state), nil
+}
+
+func (r *RuntimeGoMatchCore) MatchRestore(tick int64, state interface{}, data string) (interface{}, error) {
+	snapshotter, ok := r.match.(RuntimeGoMatchSnapshotter)
+	if !ok {
+		return nil, ErrMatchSnapshotUnsupported
+	}
+	newState := snapshotter.MatchRestore(r.ctx, r.runtimeLogger, r.db, r.nk, r, tick, state, data)
+	return newState, nil
+}
+
 func (r *RuntimeGoMatchCore) GetState(state interface{}) (string, error) {
 	return fmt.Sprintf("%+v", state), nil
 }
@@ -215,6 +264,10 @@ func (r *RuntimeGoMatchCore) CreateTime() int64 {
 	return r.createTime
 }
 
+func (r *RuntimeGoMatchCore) DataRateLimits() map[string]interface{} {
+	return r.dataRateLimits
+}
+
 func (r *RuntimeGoMatchCore) Cancel() {
 	r.ctxCancelFn()
 }
@@ -259,6 +312,29 @@ func (r *RuntimeGoMatchCore) BroadcastMessageDeferred(opCode int64, data []byte,
 	})
 }
 
+func (r *RuntimeGoMatchCore) BroadcastSpectatorMessage(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence, reliable bool) error {
+	if r.stopped.Load() {
+		return ErrMatchStopped
+	}
+
+	presenceIDs, msg, err := r.validateBroadcast(opCode, data, presences, sender, reliable)
+	if err != nil {
+		return err
+	}
+	if len(presenceIDs) == 0 {
+		return nil
+	}
+
+	presenceIDs = r.presenceList.FilterSpectatorIDs(presenceIDs)
+	if len(presenceIDs) == 0 {
+		return nil
+	}
+
+	r.router.SendToPresenceIDs(r.logger, presenceIDs, msg, reliable)
+
+	return nil
+}
+
 func (r *RuntimeGoMatchCore) validateBroadcast(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence, reliable bool) ([]*PresenceID, *rtapi.Envelope, error) {
 	var presenceIDs []*PresenceID
 	if presences != nil {
@@ -40,6 +40,42 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// RuntimeGoMatchmakerBackfillModule is implemented by the runtime.NakamaModule passed to Go modules, for functions not
+// yet part of the runtime.NakamaModule interface. Modules cannot import the server package, so they declare an
+// interface with the same methods and use a type assertion on the NakamaModule they are given.
+type RuntimeGoMatchmakerBackfillModule interface {
+	MatchmakerBackfillAdd(ctx context.Context, id, queue, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, error)
+	MatchmakerBackfillRemove(ctx context.Context, id, ticket string) error
+}
+
+var _ RuntimeGoMatchmakerBackfillModule = &RuntimeGoNakamaModule{}
+
+// RuntimeGoMatchListSortedModule is implemented by the runtime.NakamaModule passed to Go modules, for sorted and
+// paginated match listings. Modules use a type assertion, as with RuntimeGoMatchmakerBackfillModule.
+type RuntimeGoMatchListSortedModule interface {
+	MatchListSorted(ctx context.Context, limit int, label string, minSize, maxSize *int, query string, sort []string, cursor string) ([]*api.Match, string, error)
+}
+
+var _ RuntimeGoMatchListSortedModule = &RuntimeGoNakamaModule{}
+
+// RuntimeGoApiQuotaModule is implemented by the runtime.NakamaModule passed to Go modules, for reading API daily quota
+// counts. Modules use a type assertion, as with RuntimeGoMatchmakerBackfillModule.
+type RuntimeGoApiQuotaModule interface {
+	ApiQuotaGet(ctx context.Context, userID string) (map[string]int64, error)
+}
+
+var _ RuntimeGoApiQuotaModule = &RuntimeGoNakamaModule{}
+
+// RuntimeGoOidcModule is implemented by the runtime.NakamaModule passed to Go modules, for OpenID Connect accounts.
+// Modules use a type assertion, as with RuntimeGoMatchmakerBackfillModule.
+type RuntimeGoOidcModule interface {
+	AuthenticateOidc(ctx context.Context, provider, token, username string, create bool) (string, string, bool, error)
+	LinkOidc(ctx context.Context, userID, provider, token string) error
+	UnlinkOidc(ctx context.Context, userID, provider, token string) error
+}
+
+var _ RuntimeGoOidcModule = &RuntimeGoNakamaModule{}
+
 type RuntimeGoNakamaModule struct {
 	sync.RWMutex
 	logger               *zap.Logger
@@ -58,6 +94,7 @@ type RuntimeGoNakamaModule struct {
 	metrics              Metrics
 	streamManager        StreamManager
 	router               MessageRouter
+	apiLimiter           *ApiRateLimiter
 
 	eventFn RuntimeEventCustomFunction
 
@@ -66,7 +103,7 @@ type RuntimeGoNakamaModule struct {
 	matchCreateFn RuntimeMatchCreateFunction
 }
 
-func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter) *RuntimeGoNakamaModule {
+func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter) *RuntimeGoNakamaModule {
 	return &RuntimeGoNakamaModule{
 		logger:               logger,
 		db:                   db,
@@ -84,6 +121,7 @@ func NewRuntimeGoNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler
 		metrics:              metrics,
 		streamManager:        streamManager,
 		router:               router,
+		apiLimiter:           apiLimiter,
 
 		node: config.GetName(),
 	}
@@ -361,6 +399,35 @@ func (n *RuntimeGoNakamaModule) AuthenticateGoogle(ctx context.Context, token, u
 	return AuthenticateGoogle(ctx, n.logger, n.db, n.socialClient, token, username, create)
 }
 
+// @group authenticate
+// @summary Authenticate user and create a session token using an ID token from a configured OpenID Connect provider.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string) OpenID Connect ID token.
+// @param username(type=string, optional=true) The user's username. If left empty, the one mapped from the token's claims is used, or one is generated.
+// @param create(type=bool, optional=true, default=true) Create user if one didn't exist previously.
+// @return userID(string) The user ID of the authenticated user.
+// @return username(string) The username of the authenticated user.
+// @return create(bool) Value indicating if this account was just created or already existed.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) AuthenticateOidc(ctx context.Context, provider, token, username string, create bool) (string, string, bool, error) {
+	if provider == "" {
+		return "", "", false, errors.New("expects provider string")
+	}
+
+	if token == "" {
+		return "", "", false, errors.New("expects ID token string")
+	}
+
+	if invalidUsernameRegex.MatchString(username) {
+		return "", "", false, errors.New("expects username to be valid, no spaces or control characters allowed")
+	} else if len(username) > 128 {
+		return "", "", false, errors.New("expects id to be valid, must be 1-128 bytes")
+	}
+
+	return AuthenticateOidc(ctx, n.logger, n.db, n.socialClient, provider, token, username, create)
+}
+
 // @group authenticate
 // @summary Authenticate user and create a session token using a Steam account token.
 // @param ctx(type=context.Context) The context object represents information about the server and requester.
@@ -806,6 +873,22 @@ func (n *RuntimeGoNakamaModule) LinkGoogle(ctx context.Context, userID, token st
 	return LinkGoogle(ctx, n.logger, n.db, n.socialClient, id, token)
 }
 
+// @group authenticate
+// @summary Link an OpenID Connect provider's identity to a user ID.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param userId(type=string) The user ID to be linked.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string) OpenID Connect ID token.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) LinkOidc(ctx context.Context, userID, provider, token string) error {
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		return errors.New("user ID must be a valid identifier")
+	}
+
+	return LinkOidc(ctx, n.logger, n.db, n.socialClient, id, provider, token)
+}
+
 // @group authenticate
 // @summary Link Steam authentication to a user ID.
 // @param ctx(type=context.Context) The context object represents information about the server and requester.
@@ -957,6 +1040,22 @@ func (n *RuntimeGoNakamaModule) UnlinkGoogle(ctx context.Context, userID, token
 	return UnlinkGoogle(ctx, n.logger, n.db, n.socialClient, id, token)
 }
 
+// @group authenticate
+// @summary Unlink an OpenID Connect provider's identity from a user ID.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param userId(type=string) The user ID to be unlinked.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string, optional=true) OpenID Connect ID token.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) UnlinkOidc(ctx context.Context, userID, provider, token string) error {
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		return errors.New("user ID must be a valid identifier")
+	}
+
+	return UnlinkOidc(ctx, n.logger, n.db, n.socialClient, id, provider, token)
+}
+
 // @group authenticate
 // @summary Unlink Steam authentication from a user ID.
 // @param ctx(type=context.Context) The context object represents information about the server and requester.
@@ -1466,6 +1565,21 @@ func (n *RuntimeGoNakamaModule) SessionLogout(userID, token, refreshToken string
 	return SessionLogout(n.config, n.sessionCache, uid, token, refreshToken)
 }
 
+// @group sessions
+// @summary Get the number of requests a user made today to each API method or RPC with a configured daily quota. Counts reset at midnight UTC.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param userId(type=string) The ID of the user to get quota counts for.
+// @return quotas(map[string]int64) Request counts keyed by API method name, or "rpc:" followed by the RPC ID.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) ApiQuotaGet(ctx context.Context, userID string) (map[string]int64, error) {
+	uid, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, errors.New("expects valid user id")
+	}
+
+	return n.apiLimiter.Quotas(uid), nil
+}
+
 // @group matches
 // @summary Create a new authoritative realtime multiplayer match running on the given runtime module name. The given params are passed to the match's init hook.
 // @param ctx(type=context.Context) The context object represents information about the server and requester.
@@ -1525,10 +1639,44 @@ func (n *RuntimeGoNakamaModule) MatchList(ctx context.Context, limit int, author
 	if maxSize != nil {
 		maxSizeWrapper = &wrapperspb.Int32Value{Value: int32(*maxSize)}
 	}
-	matches, _, err := n.matchRegistry.ListMatches(ctx, limit, authoritativeWrapper, labelWrapper, minSizeWrapper, maxSizeWrapper, queryWrapper, nil)
+	matches, _, _, err := n.matchRegistry.ListMatches(ctx, limit, authoritativeWrapper, labelWrapper, minSizeWrapper, maxSizeWrapper, queryWrapper, nil, nil, "")
 	return matches, err
 }
 
+// @group matches
+// @summary List currently running authoritative matches in a chosen order, one page at a time, and optionally filter them by label, query, and current participant count.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param limit(type=int, optional=true, default=1) The maximum number of matches to list.
+// @param label(type=string, optional=true, default="") A label to filter authoritative matches by. Default "" means any label matches.
+// @param minSize(type=int, optional=true) Inclusive lower limit of current match participants.
+// @param maxSize(type=int, optional=true) Inclusive upper limit of current match participants.
+// @param query(type=string, optional=true) Additional query parameters to shortlist matches.
+// @param sort(type=[]string, optional=true) Fields to sort by, "create_time", "tick_rate", "handler_name" or "label.<field>", prefixed with "-" for descending order. Default is newest first, or best query score first.
+// @param cursor(type=string, optional=true, default="") Pagination cursor from a previous listing with the same filters and sort order.
+// @return match(*api.Match) A list of matches matching the parameters criteria.
+// @return cursor(string) A cursor to fetch the next page of matches, empty if there are no more.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) MatchListSorted(ctx context.Context, limit int, label string, minSize, maxSize *int, query string, sort []string, cursor string) ([]*api.Match, string, error) {
+	var labelWrapper *wrapperspb.StringValue
+	if label != "" {
+		labelWrapper = &wrapperspb.StringValue{Value: label}
+	}
+	var queryWrapper *wrapperspb.StringValue
+	if query != "" {
+		queryWrapper = &wrapperspb.StringValue{Value: query}
+	}
+	var minSizeWrapper *wrapperspb.Int32Value
+	if minSize != nil {
+		minSizeWrapper = &wrapperspb.Int32Value{Value: int32(*minSize)}
+	}
+	var maxSizeWrapper *wrapperspb.Int32Value
+	if maxSize != nil {
+		maxSizeWrapper = &wrapperspb.Int32Value{Value: int32(*maxSize)}
+	}
+	matches, _, nextCursor, err := n.matchRegistry.ListMatches(ctx, limit, &wrapperspb.BoolValue{Value: true}, labelWrapper, minSizeWrapper, maxSizeWrapper, queryWrapper, nil, sort, cursor)
+	return matches, nextCursor, err
+}
+
 // @group matches
 // @summary Allow the match handler to be sent a reservation signal to mark a user ID or session ID into the match state ahead of their join attempt and eventual join flow. Called when the match handler receives a runtime signal.
 // @param ctx(type=context.Context) Context object represents information about the match and server for information purposes.
@@ -1541,6 +1689,39 @@ func (n *RuntimeGoNakamaModule) MatchSignal(ctx context.Context, id string, data
 	return n.matchRegistry.Signal(ctx, id, data)
 }
 
+// @group matches
+// @summary Ask the matchmaker for players to fill open slots in a running authoritative match. Matched players are sent the match ID directly instead of forming a new matchmaker group. The request is withdrawn automatically when the match ends.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param id(type=string) The ID of the authoritative match to fill. Must be running on this node.
+// @param queue(type=string) The named matchmaker queue to take players from. An empty string selects the default queue.
+// @param query(type=string) The matchmaker query players must satisfy to fill the open slots.
+// @param count(type=int) The number of open slots to fill.
+// @param stringProperties(type=map[string]string, optional=true) String properties describing the match, used when checking player queries.
+// @param numericProperties(type=map[string]float64, optional=true) Numeric properties describing the match, used when checking player queries.
+// @return ticket(string) The backfill ticket, used to withdraw the request.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) MatchmakerBackfillAdd(ctx context.Context, id, queue, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, error) {
+	if count < 1 {
+		return "", errors.New("expects count to be at least 1")
+	}
+
+	return n.matchRegistry.MatchmakerBackfillAdd(ctx, id, queue, query, count, stringProperties, numericProperties)
+}
+
+// @group matches
+// @summary Withdraw a backfill request made by a running authoritative match.
+// @param ctx(type=context.Context) The context object represents information about the server and requester.
+// @param id(type=string) The ID of the authoritative match that made the request.
+// @param ticket(type=string) The backfill ticket to withdraw.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeGoNakamaModule) MatchmakerBackfillRemove(ctx context.Context, id, ticket string) error {
+	if ticket == "" {
+		return errors.New("expects ticket to be a non-empty string")
+	}
+
+	return n.matchRegistry.MatchmakerBackfillRemove(ctx, id, ticket)
+}
+
 // @group notifications
 // @summary Send one in-app notification to a user.
 // @param ctx(type=context.Context) The context object represents information about the server and requester.
@@ -0,0 +1,158 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blugelabs/bluge"
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/runtime"
+)
+
+// should expose matchmaker backfill to Go modules through a type assertion on the NakamaModule they are given
+func TestRuntimeGoMatchmakerBackfillModule(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+	matchMaker, cleanup, err := createTestMatchmaker(t, consoleLogger, false, nil)
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	defer cleanup()
+	matchRegistry.SetMatchmaker(matchMaker)
+
+	matchID, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("error creating match: %v", err)
+	}
+
+	var nk runtime.NakamaModule = NewRuntimeGoNakamaModule(consoleLogger, nil, nil, NewConfig(consoleLogger), nil, nil, nil, nil, nil, nil, nil, matchRegistry, nil, nil, nil, nil, nil)
+
+	// Modules cannot import the server package, so they declare the functions they need.
+	backfillNk, ok := nk.(interface {
+		MatchmakerBackfillAdd(ctx context.Context, id, queue, query string, count int, stringProperties map[string]string, numericProperties map[string]float64) (string, error)
+		MatchmakerBackfillRemove(ctx context.Context, id, ticket string) error
+	})
+	if !ok {
+		t.Fatal("expected NakamaModule to implement matchmaker backfill functions")
+	}
+
+	ticket, err := backfillNk.MatchmakerBackfillAdd(context.Background(), matchID, "", "*", 2, map[string]string{"mode": "ranked"}, nil)
+	if err != nil {
+		t.Fatalf("error adding matchmaker backfill: %v", err)
+	}
+	if _, found := matchMaker.backfills[ticket]; !found {
+		t.Fatal("expected backfill request in the matchmaker")
+	}
+
+	if err := backfillNk.MatchmakerBackfillRemove(context.Background(), matchID, ticket); err != nil {
+		t.Fatalf("error removing matchmaker backfill: %v", err)
+	}
+	if len(matchMaker.backfills) != 0 {
+		t.Fatalf("expected backfill request to be withdrawn, got %d", len(matchMaker.backfills))
+	}
+}
+
+// should expose sorted match listings to Go modules through a type assertion on the NakamaModule they are given
+func TestRuntimeGoMatchListSortedModule(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	matchRegistry, runtimeMatchCreateFunc, err := createTestMatchRegistry(t, consoleLogger)
+	if err != nil {
+		t.Fatalf("error creating test match registry: %v", err)
+	}
+	defer matchRegistry.Stop(0)
+
+	labels := []string{`{"skill":20}`, `{"skill":10}`, `{"skill":30}`}
+	for _, label := range labels {
+		if _, err := matchRegistry.CreateMatch(context.Background(), runtimeMatchCreateFunc, "match", map[string]interface{}{"label": label}); err != nil {
+			t.Fatalf("error creating match: %v", err)
+		}
+	}
+	matchRegistry.processLabelUpdates(bluge.NewBatch())
+
+	var nk runtime.NakamaModule = NewRuntimeGoNakamaModule(consoleLogger, nil, nil, NewConfig(consoleLogger), nil, nil, nil, nil, nil, nil, nil, matchRegistry, nil, nil, nil, nil, nil)
+
+	// Modules cannot import the server package, so they declare the functions they need.
+	listNk, ok := nk.(interface {
+		MatchListSorted(ctx context.Context, limit int, label string, minSize, maxSize *int, query string, sort []string, cursor string) ([]*api.Match, string, error)
+	})
+	if !ok {
+		t.Fatal("expected NakamaModule to implement sorted match listing")
+	}
+
+	var listed []string
+	var cursor string
+	for page := 0; page <= len(labels); page++ {
+		matches, nextCursor, err := listNk.MatchListSorted(context.Background(), 2, "", nil, nil, "", []string{"label.skill"}, cursor)
+		if err != nil {
+			t.Fatalf("error listing matches: %v", err)
+		}
+		for _, match := range matches {
+			listed = append(listed, match.Label.Value)
+		}
+		if cursor = nextCursor; cursor == "" {
+			break
+		}
+	}
+
+	expected := []string{labels[1], labels[0], labels[2]}
+	if !reflect.DeepEqual(listed, expected) {
+		t.Fatalf("unexpected order:\n  got: %v\n  expected: %v", listed, expected)
+	}
+}
+
+// should expose API daily quota counts to Go modules through a type assertion on the NakamaModule they are given
+func TestRuntimeGoApiQuotaModule(t *testing.T) {
+	consoleLogger := loggerForTest(t)
+	limiter := newApiRateLimiterTest(t, map[string]*ApiRateLimitConfig{
+		"rpc:reward": {DailyQuota: 5},
+	})
+	userID := uuid.Must(uuid.NewV4())
+	names := limiter.Limits("", "reward")
+	for i := 0; i < 2; i++ {
+		if _, _, err := limiter.Allow(names, &apiRateLimitCaller{userID: userID, clientIP: "10.0.0.1"}, time.Now()); err != nil {
+			t.Fatalf("expected request to be allowed, got %v", err)
+		}
+	}
+
+	var nk runtime.NakamaModule = NewRuntimeGoNakamaModule(consoleLogger, nil, nil, NewConfig(consoleLogger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, limiter)
+
+	// Modules cannot import the server package, so they declare the functions they need.
+	quotaNk, ok := nk.(interface {
+		ApiQuotaGet(ctx context.Context, userID string) (map[string]int64, error)
+	})
+	if !ok {
+		t.Fatal("expected NakamaModule to implement API quota functions")
+	}
+
+	quotas, err := quotaNk.ApiQuotaGet(context.Background(), userID.String())
+	if err != nil {
+		t.Fatalf("error getting API quotas: %v", err)
+	}
+	if quotas["rpc:reward"] != 2 {
+		t.Fatalf("expected 2 requests counted, got %v", quotas)
+	}
+	if _, err := quotaNk.ApiQuotaGet(context.Background(), "invalid"); err == nil {
+		t.Fatal("expected invalid user ID to be refused")
+	}
+}
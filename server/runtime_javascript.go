@@ -30,6 +30,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
 	"github.com/heroiclabs/nakama/v3/social"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -66,6 +67,8 @@ func (r *RuntimeJS) GetCallback(e RuntimeExecutionMode, key string) string {
 		return r.callbacks.After[key]
 	case RuntimeExecutionModeMatchmaker:
 		return r.callbacks.Matchmaker
+	case RuntimeExecutionModeMatchmakerScore:
+		return r.callbacks.MatchmakerScore
 	case RuntimeExecutionModeTournamentEnd:
 		return r.callbacks.TournamentEnd
 	case RuntimeExecutionModeTournamentReset:
@@ -140,6 +143,7 @@ type RuntimeProviderJS struct {
 	tracker              Tracker
 	streamManager        StreamManager
 	router               MessageRouter
+	apiLimiter           *ApiRateLimiter
 	eventFn              RuntimeEventCustomFunction
 	matchCreateFn        RuntimeMatchCreateFunction
 	poolCh               chan *RuntimeJS
@@ -608,7 +612,7 @@ func (rp *RuntimeProviderJS) Put(r *RuntimeJS) {
 	}
 }
 
-func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, eventFn RuntimeEventCustomFunction, path, entrypoint string, matchProvider *MatchProvider) ([]string, map[string]RuntimeRpcFunction, map[string]RuntimeBeforeRtFunction, map[string]RuntimeAfterRtFunction, *RuntimeBeforeReqFunctions, *RuntimeAfterReqFunctions, RuntimeMatchmakerMatchedFunction, RuntimeTournamentEndFunction, RuntimeTournamentResetFunction, RuntimeLeaderboardResetFunction, RuntimePurchaseNotificationAppleFunction, RuntimeSubscriptionNotificationAppleFunction, RuntimePurchaseNotificationGoogleFunction, RuntimeSubscriptionNotificationGoogleFunction, error) {
+func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, leaderboardRankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter, eventFn RuntimeEventCustomFunction, path, entrypoint string, matchProvider *MatchProvider) ([]string, map[string]RuntimeRpcFunction, map[string]RuntimeBeforeRtFunction, map[string]RuntimeAfterRtFunction, *RuntimeBeforeReqFunctions, *RuntimeAfterReqFunctions, RuntimeMatchmakerMatchedFunction, RuntimeMatchmakerScoreFunction, RuntimeTournamentEndFunction, RuntimeTournamentResetFunction, RuntimeLeaderboardResetFunction, RuntimePurchaseNotificationAppleFunction, RuntimeSubscriptionNotificationAppleFunction, RuntimePurchaseNotificationGoogleFunction, RuntimeSubscriptionNotificationGoogleFunction, error) {
 	startupLogger.Info("Initialising JavaScript runtime provider", zap.String("path", path), zap.String("entrypoint", entrypoint))
 
 	modCache, err := cacheJavascriptModules(startupLogger, path, entrypoint)
@@ -644,6 +648,7 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 		tracker:              tracker,
 		streamManager:        streamManager,
 		router:               router,
+		apiLimiter:           apiLimiter,
 		metrics:              metrics,
 		poolCh:               make(chan *RuntimeJS, config.GetRuntime().JsMaxCount),
 		maxCount:             uint32(config.GetRuntime().JsMaxCount),
@@ -656,6 +661,7 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 	beforeReqFunctions := &RuntimeBeforeReqFunctions{}
 	afterReqFunctions := &RuntimeAfterReqFunctions{}
 	var matchmakerMatchedFunction RuntimeMatchmakerMatchedFunction
+	var matchmakerScoreFunction RuntimeMatchmakerScoreFunction
 	var tournamentEndFunction RuntimeTournamentEndFunction
 	var tournamentResetFunction RuntimeTournamentResetFunction
 	var leaderboardResetFunction RuntimeLeaderboardResetFunction
@@ -669,13 +675,13 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 	}
 
 	matchProvider.RegisterCreateFn("javascript",
-		func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, name string) (RuntimeMatchCore, error) {
+		func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error) {
 			mc := matchHandlers.Get(name)
 			if mc == nil {
 				return nil, nil
 			}
 
-			return NewRuntimeJavascriptMatchCore(logger, name, db, protojsonMarshaler, protojsonUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, localCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, matchProvider.CreateMatch, eventFn, id, node, version, stopped, mc, modCache)
+			return NewRuntimeJavascriptMatchCore(logger, name, db, protojsonMarshaler, protojsonUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, localCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, matchProvider.CreateMatch, eventFn, id, node, version, stopped, mc, modCache)
 		})
 
 	callbacks, err := evalRuntimeModules(runtimeProviderJS, modCache, matchHandlers, matchProvider, leaderboardScheduler, localCache, func(mode RuntimeExecutionMode, id string) {
@@ -796,6 +802,14 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 						}
 						return result.(*api.AuthenticateGoogleRequest), nil, 0
 					}
+				case "authenticateoidc":
+					beforeReqFunctions.beforeAuthenticateOidcFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.AuthenticateOidcRequest) (*apigrpc.AuthenticateOidcRequest, error, codes.Code) {
+						result, err, code := runtimeProviderJS.BeforeReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, in)
+						if result == nil || err != nil {
+							return nil, err, code
+						}
+						return result.(*apigrpc.AuthenticateOidcRequest), nil, 0
+					}
 				case "authenticatesteam":
 					beforeReqFunctions.beforeAuthenticateSteamFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AuthenticateSteamRequest) (*api.AuthenticateSteamRequest, error, codes.Code) {
 						result, err, code := runtimeProviderJS.BeforeReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, in)
@@ -1156,6 +1170,14 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 						}
 						return result.(*api.ListTournamentRecordsAroundOwnerRequest), nil, 0
 					}
+				case "transfermatchhost":
+					beforeReqFunctions.beforeTransferMatchHostFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.TransferMatchHostRequest) (*apigrpc.TransferMatchHostRequest, error, codes.Code) {
+						result, err, code := runtimeProviderJS.BeforeReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, in)
+						if result == nil || err != nil {
+							return nil, err, code
+						}
+						return result.(*apigrpc.TransferMatchHostRequest), nil, 0
+					}
 				case "unlinkapple":
 					beforeReqFunctions.beforeUnlinkAppleFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountApple) (*api.AccountApple, error, codes.Code) {
 						result, err, code := runtimeProviderJS.BeforeReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, in)
@@ -1362,6 +1384,10 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 					afterReqFunctions.afterAuthenticateGoogleFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateGoogleRequest) error {
 						return runtimeProviderJS.AfterReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, out, in)
 					}
+				case "authenticateoidc":
+					afterReqFunctions.afterAuthenticateOidcFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *apigrpc.AuthenticateOidcRequest) error {
+						return runtimeProviderJS.AfterReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, out, in)
+					}
 				case "authenticatesteam":
 					afterReqFunctions.afterAuthenticateSteamFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.Session, in *api.AuthenticateSteamRequest) error {
 						return runtimeProviderJS.AfterReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, out, in)
@@ -1542,6 +1568,10 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 					afterReqFunctions.afterListTournamentRecordsAroundOwnerFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, out *api.TournamentRecordList, in *api.ListTournamentRecordsAroundOwnerRequest) error {
 						return runtimeProviderJS.AfterReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, out, in)
 					}
+				case "transfermatchhost":
+					afterReqFunctions.afterTransferMatchHostFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *apigrpc.TransferMatchHostRequest) error {
+						return runtimeProviderJS.AfterReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, nil, in)
+					}
 				case "unlinkapple":
 					afterReqFunctions.afterUnlinkAppleFunction = func(ctx context.Context, logger *zap.Logger, userID, username string, vars map[string]string, expiry int64, clientIP, clientPort string, in *api.AccountApple) error {
 						return runtimeProviderJS.AfterReq(ctx, id, logger, userID, username, vars, expiry, clientIP, clientPort, nil, in)
@@ -1620,6 +1650,10 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 			matchmakerMatchedFunction = func(ctx context.Context, entries []*MatchmakerEntry) (string, bool, error) {
 				return runtimeProviderJS.MatchmakerMatched(ctx, entries)
 			}
+		case RuntimeExecutionModeMatchmakerScore:
+			matchmakerScoreFunction = func(ctx context.Context, candidates [][]*MatchmakerEntry) ([]float64, error) {
+				return runtimeProviderJS.MatchmakerScore(ctx, candidates)
+			}
 		case RuntimeExecutionModeTournamentEnd:
 			tournamentEndFunction = func(ctx context.Context, tournament *api.Tournament, end, reset int64) error {
 				return runtimeProviderJS.TournamentEnd(ctx, tournament, end, reset)
@@ -1652,7 +1686,7 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 	}, false)
 	if err != nil {
 		logger.Error("Failed to eval JavaScript modules.", zap.Error(err))
-		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	runtimeProviderJS.newFn = func() *RuntimeJS {
@@ -1666,7 +1700,7 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 			logger.Fatal("Failed to initialize JavaScript runtime", zap.Error(err))
 		}
 
-		nakamaModule := NewRuntimeJavascriptNakamaModule(logger, db, protojsonMarshaler, protojsonUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, localCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, eventFn, matchProvider.CreateMatch)
+		nakamaModule := NewRuntimeJavascriptNakamaModule(logger, db, protojsonMarshaler, protojsonUnmarshaler, config, socialClient, leaderboardCache, leaderboardRankCache, localCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, eventFn, matchProvider.CreateMatch)
 		nk := runtime.ToValue(nakamaModule.Constructor(runtime))
 		nkInst, err := runtime.New(nk)
 		if err != nil {
@@ -1699,7 +1733,7 @@ func NewRuntimeProviderJS(logger, startupLogger *zap.Logger, db *sql.DB, protojs
 	}
 	startupLogger.Info("Allocated minimum JavaScript runtime pool")
 
-	return modCache.Names, rpcFunctions, beforeRtFunctions, afterRtFunctions, beforeReqFunctions, afterReqFunctions, matchmakerMatchedFunction, tournamentEndFunction, tournamentResetFunction, leaderboardResetFunction, purchaseNotificationAppleFunction, subscriptionNotificationAppleFunction, purchaseNotificationGoogleFunction, subscriptionNotificationGoogleFunction, nil
+	return modCache.Names, rpcFunctions, beforeRtFunctions, afterRtFunctions, beforeReqFunctions, afterReqFunctions, matchmakerMatchedFunction, matchmakerScoreFunction, tournamentEndFunction, tournamentResetFunction, leaderboardResetFunction, purchaseNotificationAppleFunction, subscriptionNotificationAppleFunction, purchaseNotificationGoogleFunction, subscriptionNotificationGoogleFunction, nil
 }
 
 func CheckRuntimeProviderJavascript(logger *zap.Logger, config Config, version string) error {
@@ -1782,32 +1816,7 @@ func (rp *RuntimeProviderJS) MatchmakerMatched(ctx context.Context, entries []*M
 		return "", false, errors.New("Runtime Matchmaker Matched function not found.")
 	}
 
-	entriesSlice := make([]interface{}, 0, len(entries))
-	for _, e := range entries {
-		presenceObj := r.vm.NewObject()
-		presenceObj.Set("userId", e.Presence.UserId)
-		presenceObj.Set("sessionId", e.Presence.SessionId)
-		presenceObj.Set("username", e.Presence.Username)
-		presenceObj.Set("node", e.Presence.Node)
-
-		propertiesObj := r.vm.NewObject()
-		for k, v := range e.StringProperties {
-			propertiesObj.Set(k, v)
-		}
-		for k, v := range e.NumericProperties {
-			propertiesObj.Set(k, v)
-		}
-
-		entry := r.vm.NewObject()
-		entry.Set("presence", presenceObj)
-		entry.Set("properties", propertiesObj)
-
-		if e.PartyId != "" {
-			entry.Set("partyId", e.PartyId)
-		}
-
-		entriesSlice = append(entriesSlice, entry)
-	}
+	entriesSlice := matchmakerEntriesToJsSlice(r.vm, entries)
 
 	fn, ok := goja.AssertFunction(r.vm.Get(jsFn))
 	if !ok {
@@ -1823,14 +1832,24 @@ func (rp *RuntimeProviderJS) MatchmakerMatched(ctx context.Context, entries []*M
 		return "", false, errors.New("Could not run matchmaker matched hook.")
 	}
 
+	jsCtx := NewRuntimeJsContext(r.vm, r.node, r.version, r.env, RuntimeExecutionModeMatchmaker, nil, nil, 0, "", "", nil, "", "", "", "")
+	if region, ok := ctx.Value(RUNTIME_CTX_MATCHMAKER_REGION).(string); ok && region != "" {
+		jsCtx.Set(__RUNTIME_JAVASCRIPT_CTX_MATCHMAKER_REGION, region)
+	}
+
 	r.SetContext(ctx)
-	retValue, err, _ := r.InvokeFunction(RuntimeExecutionModeMatchmaker, "matchmakerMatched", fn, jsLogger, nil, nil, "", "", nil, 0, "", "", "", "", r.vm.ToValue(entriesSlice))
+	retVal, err, _ := r.invokeFunction(RuntimeExecutionModeMatchmaker, "matchmakerMatched", fn, jsCtx, jsLogger, r.nkInst, r.vm.ToValue(entriesSlice))
 	r.SetContext(context.Background())
 	rp.Put(r)
 	if err != nil {
 		return "", false, fmt.Errorf("Error running runtime Matchmaker Matched hook: %v", err.Error())
 	}
 
+	var retValue interface{}
+	if retVal != nil {
+		retValue = retVal.Export()
+	}
+
 	if retValue == nil {
 		// No return value or hook decided not to return an authoritative match ID.
 		return "", false, nil
@@ -1853,6 +1872,95 @@ func (rp *RuntimeProviderJS) MatchmakerMatched(ctx context.Context, entries []*M
 	return "", false, errors.New("Unexpected return type from runtime Matchmaker Matched hook, must be string, null or undefined.")
 }
 
+func (rp *RuntimeProviderJS) MatchmakerScore(ctx context.Context, candidates [][]*MatchmakerEntry) ([]float64, error) {
+	r, err := rp.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jsFn := r.GetCallback(RuntimeExecutionModeMatchmakerScore, "")
+	if jsFn == "" {
+		rp.Put(r)
+		return nil, errors.New("Runtime Matchmaker Score function not found.")
+	}
+
+	candidatesSlice := make([]interface{}, 0, len(candidates))
+	for _, entries := range candidates {
+		candidatesSlice = append(candidatesSlice, matchmakerEntriesToJsSlice(r.vm, entries))
+	}
+
+	fn, ok := goja.AssertFunction(r.vm.Get(jsFn))
+	if !ok {
+		rp.Put(r)
+		rp.logger.Error("JavaScript runtime function invalid.", zap.String("key", jsFn), zap.Error(err))
+		return nil, errors.New("Could not run matchmaker score hook.")
+	}
+
+	jsLogger, err := NewJsLogger(r.vm, r.logger, zap.String("mode", RuntimeExecutionModeMatchmakerScore.String()))
+	if err != nil {
+		rp.Put(r)
+		rp.logger.Error("Could not instantiate js logger.", zap.Error(err))
+		return nil, errors.New("Could not run matchmaker score hook.")
+	}
+
+	r.SetContext(ctx)
+	retValue, err, _ := r.InvokeFunction(RuntimeExecutionModeMatchmakerScore, "matchmakerScore", fn, jsLogger, nil, nil, "", "", nil, 0, "", "", "", "", r.vm.ToValue(candidatesSlice))
+	r.SetContext(context.Background())
+	rp.Put(r)
+	if err != nil {
+		return nil, fmt.Errorf("Error running runtime Matchmaker Score hook: %v", err.Error())
+	}
+
+	retSlice, ok := retValue.([]interface{})
+	if !ok {
+		return nil, errors.New("Unexpected return type from runtime Matchmaker Score hook, must be an array of numbers.")
+	}
+
+	scores := make([]float64, 0, len(retSlice))
+	for _, v := range retSlice {
+		switch score := v.(type) {
+		case int64:
+			scores = append(scores, float64(score))
+		case float64:
+			scores = append(scores, score)
+		default:
+			return nil, errors.New("Unexpected return type from runtime Matchmaker Score hook, must be an array of numbers.")
+		}
+	}
+
+	return scores, nil
+}
+
+func matchmakerEntriesToJsSlice(vm *goja.Runtime, entries []*MatchmakerEntry) []interface{} {
+	entriesSlice := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		presenceObj := vm.NewObject()
+		presenceObj.Set("userId", e.Presence.UserId)
+		presenceObj.Set("sessionId", e.Presence.SessionId)
+		presenceObj.Set("username", e.Presence.Username)
+		presenceObj.Set("node", e.Presence.Node)
+
+		propertiesObj := vm.NewObject()
+		for k, v := range e.StringProperties {
+			propertiesObj.Set(k, v)
+		}
+		for k, v := range e.NumericProperties {
+			propertiesObj.Set(k, v)
+		}
+
+		entry := vm.NewObject()
+		entry.Set("presence", presenceObj)
+		entry.Set("properties", propertiesObj)
+
+		if e.PartyId != "" {
+			entry.Set("partyId", e.PartyId)
+		}
+
+		entriesSlice = append(entriesSlice, entry)
+	}
+
+	return entriesSlice
+}
+
 func (rp *RuntimeProviderJS) TournamentEnd(ctx context.Context, tournament *api.Tournament, end, reset int64) error {
 	r, err := rp.Get(ctx)
 	if err != nil {
@@ -2271,7 +2379,7 @@ func evalRuntimeModules(rp *RuntimeProviderJS, modCache *RuntimeJSModuleCache, m
 		return nil, err
 	}
 
-	nakamaModule := NewRuntimeJavascriptNakamaModule(rp.logger, rp.db, rp.protojsonMarshaler, rp.protojsonUnmarshaler, rp.config, rp.socialClient, rp.leaderboardCache, rp.leaderboardRankCache, localCache, leaderboardScheduler, rp.sessionRegistry, rp.sessionCache, rp.statusRegistry, rp.matchRegistry, rp.tracker, rp.metrics, rp.streamManager, rp.router, rp.eventFn, matchProvider.CreateMatch)
+	nakamaModule := NewRuntimeJavascriptNakamaModule(rp.logger, rp.db, rp.protojsonMarshaler, rp.protojsonUnmarshaler, rp.config, rp.socialClient, rp.leaderboardCache, rp.leaderboardRankCache, localCache, leaderboardScheduler, rp.sessionRegistry, rp.sessionCache, rp.statusRegistry, rp.matchRegistry, rp.tracker, rp.metrics, rp.streamManager, rp.router, rp.apiLimiter, rp.eventFn, matchProvider.CreateMatch)
 	nk := r.ToValue(nakamaModule.Constructor(r))
 	nkInst, err := r.New(nk)
 	if err != nil {
@@ -21,24 +21,25 @@ import (
 )
 
 const (
-	__RUNTIME_JAVASCRIPT_CTX_ENV              = "env"
-	__RUNTIME_JAVASCRIPT_CTX_MODE             = "executionMode"
-	__RUNTIME_JAVASCRIPT_CTX_NODE             = "node"
-	__RUNTIME_JAVASCRIPT_CTX_VERSION          = "version"
-	__RUNTIME_JAVASCRIPT_CTX_QUERY_PARAMS     = "queryParams"
-	__RUNTIME_JAVASCRIPT_CTX_USER_ID          = "userId"
-	__RUNTIME_JAVASCRIPT_CTX_USERNAME         = "username"
-	__RUNTIME_JAVASCRIPT_CTX_VARS             = "vars"
-	__RUNTIME_JAVASCRIPT_CTX_USER_SESSION_EXP = "userSessionExp"
-	__RUNTIME_JAVASCRIPT_CTX_SESSION_ID       = "sessionId"
-	__RUNTIME_JAVASCRIPT_CTX_LANG             = "lang"
-	__RUNTIME_JAVASCRIPT_CTX_CLIENT_IP        = "clientIp"
-	__RUNTIME_JAVASCRIPT_CTX_CLIENT_PORT      = "clientPort"
-	__RUNTIME_JAVASCRIPT_CTX_HTTP_HEADERS     = "headers"
-	__RUNTIME_JAVASCRIPT_CTX_MATCH_ID         = "matchId"
-	__RUNTIME_JAVASCRIPT_CTX_MATCH_NODE       = "matchNode"
-	__RUNTIME_JAVASCRIPT_CTX_MATCH_LABEL      = "matchLabel"
-	__RUNTIME_JAVASCRIPT_CTX_MATCH_TICK_RATE  = "matchTickRate"
+	__RUNTIME_JAVASCRIPT_CTX_ENV               = "env"
+	__RUNTIME_JAVASCRIPT_CTX_MODE              = "executionMode"
+	__RUNTIME_JAVASCRIPT_CTX_NODE              = "node"
+	__RUNTIME_JAVASCRIPT_CTX_VERSION           = "version"
+	__RUNTIME_JAVASCRIPT_CTX_QUERY_PARAMS      = "queryParams"
+	__RUNTIME_JAVASCRIPT_CTX_USER_ID           = "userId"
+	__RUNTIME_JAVASCRIPT_CTX_USERNAME          = "username"
+	__RUNTIME_JAVASCRIPT_CTX_VARS              = "vars"
+	__RUNTIME_JAVASCRIPT_CTX_USER_SESSION_EXP  = "userSessionExp"
+	__RUNTIME_JAVASCRIPT_CTX_SESSION_ID        = "sessionId"
+	__RUNTIME_JAVASCRIPT_CTX_LANG              = "lang"
+	__RUNTIME_JAVASCRIPT_CTX_CLIENT_IP         = "clientIp"
+	__RUNTIME_JAVASCRIPT_CTX_CLIENT_PORT       = "clientPort"
+	__RUNTIME_JAVASCRIPT_CTX_HTTP_HEADERS      = "headers"
+	__RUNTIME_JAVASCRIPT_CTX_MATCH_ID          = "matchId"
+	__RUNTIME_JAVASCRIPT_CTX_MATCH_NODE        = "matchNode"
+	__RUNTIME_JAVASCRIPT_CTX_MATCH_LABEL       = "matchLabel"
+	__RUNTIME_JAVASCRIPT_CTX_MATCH_TICK_RATE   = "matchTickRate"
+	__RUNTIME_JAVASCRIPT_CTX_MATCHMAKER_REGION = "matchmakerRegion"
 )
 
 func NewRuntimeJsContext(r *goja.Runtime, node, version string, env goja.Value, mode RuntimeExecutionMode, httpHeaders, queryParams map[string][]string, sessionExpiry int64, userID, username string, vars map[string]string, sessionID, clientIP, clientPort, lang string) *goja.Object {
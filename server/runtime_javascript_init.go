@@ -57,6 +57,9 @@ type jsMatchHandlers struct {
 	loopFn        string
 	terminateFn   string
 	signalFn      string
+	// Optional, empty if the match handler does not keep its state across restarts.
+	snapshotFn string
+	restoreFn  string
 }
 
 type RuntimeJavascriptCallbacks struct {
@@ -64,6 +67,7 @@ type RuntimeJavascriptCallbacks struct {
 	Before                         map[string]string
 	After                          map[string]string
 	Matchmaker                     string
+	MatchmakerScore                string
 	TournamentEnd                  string
 	TournamentReset                string
 	LeaderboardReset               string
@@ -97,6 +101,7 @@ func (im *RuntimeJavascriptInitModule) mappings(r *goja.Runtime) map[string]func
 		"registerRtBefore":                                im.registerRtBefore(r),
 		"registerRtAfter":                                 im.registerRtAfter(r),
 		"registerMatchmakerMatched":                       im.registerMatchmakerMatched(r),
+		"registerMatchmakerScore":                         im.registerMatchmakerScore(r),
 		"registerTournamentEnd":                           im.registerTournamentEnd(r),
 		"registerTournamentReset":                         im.registerTournamentReset(r),
 		"registerLeaderboardReset":                        im.registerLeaderboardReset(r),
@@ -127,6 +132,8 @@ func (im *RuntimeJavascriptInitModule) mappings(r *goja.Runtime) map[string]func
 		"registerAfterAuthenticateGameCenter":             im.registerAfterAuthenticateGameCenter(r),
 		"registerBeforeAuthenticateGoogle":                im.registerBeforeAuthenticateGoogle(r),
 		"registerAfterAuthenticateGoogle":                 im.registerAfterAuthenticateGoogle(r),
+		"registerBeforeAuthenticateOidc":                  im.registerBeforeAuthenticateOidc(r),
+		"registerAfterAuthenticateOidc":                   im.registerAfterAuthenticateOidc(r),
 		"registerBeforeAuthenticateSteam":                 im.registerBeforeAuthenticateSteam(r),
 		"registerAfterAuthenticateSteam":                  im.registerAfterAuthenticateSteam(r),
 		"registerBeforeListChannelMessages":               im.registerBeforeListChannelMessages(r),
@@ -219,6 +226,8 @@ func (im *RuntimeJavascriptInitModule) mappings(r *goja.Runtime) map[string]func
 		"registerAfterWriteTournamentRecord":              im.registerAfterWriteTournamentRecord(r),
 		"registerBeforeListTournamentRecordsAroundOwner":  im.registerBeforeListTournamentRecordsAroundOwner(r),
 		"registerAfterListTournamentRecordsAroundOwner":   im.registerAfterListTournamentRecordsAroundOwner(r),
+		"registerBeforeTransferMatchHost":                 im.registerBeforeTransferMatchHost(r),
+		"registerAfterTransferMatchHost":                  im.registerAfterTransferMatchHost(r),
 		"registerBeforeUnlinkApple":                       im.registerBeforeUnlinkApple(r),
 		"registerAfterUnlinkApple":                        im.registerAfterUnlinkApple(r),
 		"registerBeforeUnlinkCustom":                      im.registerBeforeUnlinkCustom(r),
@@ -443,6 +452,14 @@ func (im *RuntimeJavascriptInitModule) registerAfterAuthenticateGoogle(r *goja.R
 	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterAuthenticateGoogle", "authenticategoogle")
 }
 
+func (im *RuntimeJavascriptInitModule) registerBeforeAuthenticateOidc(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeAuthenticateOidc", "authenticateoidc")
+}
+
+func (im *RuntimeJavascriptInitModule) registerAfterAuthenticateOidc(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterAuthenticateOidc", "authenticateoidc")
+}
+
 func (im *RuntimeJavascriptInitModule) registerBeforeAuthenticateSteam(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeAuthenticateSteam", "authenticatesteam")
 }
@@ -811,6 +828,14 @@ func (im *RuntimeJavascriptInitModule) registerAfterListTournamentRecordsAroundO
 	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterListTournamentRecordsAroundOwner", "listtournamentrecordsaroundowner")
 }
 
+func (im *RuntimeJavascriptInitModule) registerBeforeTransferMatchHost(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeTransferMatchHost", "transfermatchhost")
+}
+
+func (im *RuntimeJavascriptInitModule) registerAfterTransferMatchHost(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return im.registerHook(r, RuntimeExecutionModeAfter, "registerAfterTransferMatchHost", "transfermatchhost")
+}
+
 func (im *RuntimeJavascriptInitModule) registerBeforeUnlinkApple(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return im.registerHook(r, RuntimeExecutionModeBefore, "registerBeforeUnlinkApple", "unlinkapple")
 }
@@ -1183,6 +1208,25 @@ func (im *RuntimeJavascriptInitModule) registerMatchmakerMatched(r *goja.Runtime
 	}
 }
 
+func (im *RuntimeJavascriptInitModule) registerMatchmakerScore(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		fn := f.Argument(0)
+		_, ok := goja.AssertFunction(fn)
+		if !ok {
+			panic(r.NewTypeError("expects a function"))
+		}
+
+		fnKey, err := im.extractHookFn("registerMatchmakerScore")
+		if err != nil {
+			panic(r.NewGoError(err))
+		}
+		im.registerCallbackFn(RuntimeExecutionModeMatchmakerScore, "", fnKey)
+		im.announceCallbackFn(RuntimeExecutionModeMatchmakerScore, "")
+
+		return goja.Undefined()
+	}
+}
+
 func (im *RuntimeJavascriptInitModule) registerTournamentEnd(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
 	return func(f goja.FunctionCall) goja.Value {
 		fn := f.Argument(0)
@@ -1430,6 +1474,30 @@ func (im *RuntimeJavascriptInitModule) registerMatch(r *goja.Runtime) func(goja.
 		}
 		functions.signalFn = fnKey
 
+		_, hasSnapshot := funcMap[string(MatchSnapshot)]
+		_, hasRestore := funcMap[string(MatchRestore)]
+		if hasSnapshot || hasRestore {
+			for _, fnId := range []MatchFnId{MatchSnapshot, MatchRestore} {
+				fnValue, ok = funcMap[string(fnId)]
+				if !ok {
+					panic(r.NewTypeError(string(fnId) + " not found, " + string(MatchSnapshot) + " and " + string(MatchRestore) + " must be set together"))
+				}
+				_, ok = goja.AssertFunction(r.ToValue(fnValue))
+				if !ok {
+					panic(r.NewTypeError(string(fnId) + " value not a valid function"))
+				}
+				fnKey, err = im.extractMatchFnKey(r, name, fnId)
+				if err != nil {
+					panic(r.NewGoError(err))
+				}
+				if fnId == MatchSnapshot {
+					functions.snapshotFn = fnKey
+				} else {
+					functions.restoreFn = fnKey
+				}
+			}
+		}
+
 		im.MatchCallbacks.Add(name, functions)
 
 		return goja.Undefined()
@@ -1446,6 +1514,8 @@ const (
 	MatchLoop        MatchFnId = "matchLoop"
 	MatchTerminate   MatchFnId = "matchTerminate"
 	MatchSignal      MatchFnId = "matchSignal"
+	MatchSnapshot    MatchFnId = "matchSnapshot"
+	MatchRestore     MatchFnId = "matchRestore"
 )
 
 func (im *RuntimeJavascriptInitModule) extractMatchFnKey(r *goja.Runtime, modName string, matchFnId MatchFnId) (string, error) {
@@ -1534,6 +1604,8 @@ func (im *RuntimeJavascriptInitModule) registerCallbackFn(mode RuntimeExecutionM
 		im.Callbacks.After[key] = fn
 	case RuntimeExecutionModeMatchmaker:
 		im.Callbacks.Matchmaker = fn
+	case RuntimeExecutionModeMatchmakerScore:
+		im.Callbacks.MatchmakerScore = fn
 	case RuntimeExecutionModeTournamentEnd:
 		im.Callbacks.TournamentEnd = fn
 	case RuntimeExecutionModeTournamentReset:
@@ -50,6 +50,8 @@ type RuntimeJavaScriptMatchCore struct {
 	idStr      string
 	stream     PresenceStream
 	label      *atomic.String
+	// dataRateLimits set in MatchInit.
+	dataRateLimits map[string]interface{}
 
 	vm            *goja.Runtime
 	initFn        goja.Callable
@@ -59,6 +61,8 @@ type RuntimeJavaScriptMatchCore struct {
 	loopFn        goja.Callable
 	terminateFn   goja.Callable
 	signalFn      goja.Callable
+	snapshotFn    goja.Callable
+	restoreFn     goja.Callable
 	ctx           *goja.Object
 	dispatcher    goja.Value
 	nakamaModule  goja.Value
@@ -68,7 +72,7 @@ type RuntimeJavaScriptMatchCore struct {
 	ctxCancelFn context.CancelFunc
 }
 
-func NewRuntimeJavascriptMatchCore(logger *zap.Logger, module string, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, localCache *RuntimeJavascriptLocalCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, matchCreateFn RuntimeMatchCreateFunction, eventFn RuntimeEventCustomFunction, id uuid.UUID, node, version string, stopped *atomic.Bool, matchHandlers *jsMatchHandlers, modCache *RuntimeJSModuleCache) (RuntimeMatchCore, error) {
+func NewRuntimeJavascriptMatchCore(logger *zap.Logger, module string, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, localCache *RuntimeJavascriptLocalCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter, matchCreateFn RuntimeMatchCreateFunction, eventFn RuntimeEventCustomFunction, id uuid.UUID, node, version string, stopped *atomic.Bool, matchHandlers *jsMatchHandlers, modCache *RuntimeJSModuleCache) (RuntimeMatchCore, error) {
 	runtime := goja.New()
 
 	jsLoggerInst, err := NewJsLogger(runtime, logger)
@@ -76,7 +80,7 @@ func NewRuntimeJavascriptMatchCore(logger *zap.Logger, module string, db *sql.DB
 		logger.Fatal("Failed to initialize JavaScript runtime", zap.Error(err))
 	}
 
-	nakamaModule := NewRuntimeJavascriptNakamaModule(logger, db, protojsonMarshaler, protojsonUnmarshaler, config, socialClient, leaderboardCache, rankCache, localCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, eventFn, matchCreateFn)
+	nakamaModule := NewRuntimeJavascriptNakamaModule(logger, db, protojsonMarshaler, protojsonUnmarshaler, config, socialClient, leaderboardCache, rankCache, localCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, eventFn, matchCreateFn)
 	nk := runtime.ToValue(nakamaModule.Constructor(runtime))
 	nkInst, err := runtime.New(nk)
 	if err != nil {
@@ -132,6 +136,19 @@ func NewRuntimeJavascriptMatchCore(logger *zap.Logger, module string, db *sql.DB
 		ctxCancelFn()
 		logger.Fatal("Failed to get JavaScript match loop function reference.", zap.String("fn", string(MatchSignal)), zap.String("key", matchHandlers.signalFn))
 	}
+	var snapshotFn, restoreFn goja.Callable
+	if matchHandlers.snapshotFn != "" {
+		snapshotFn, ok = goja.AssertFunction(runtime.Get(matchHandlers.snapshotFn))
+		if !ok {
+			ctxCancelFn()
+			logger.Fatal("Failed to get JavaScript match loop function reference.", zap.String("fn", string(MatchSnapshot)), zap.String("key", matchHandlers.snapshotFn))
+		}
+		restoreFn, ok = goja.AssertFunction(runtime.Get(matchHandlers.restoreFn))
+		if !ok {
+			ctxCancelFn()
+			logger.Fatal("Failed to get JavaScript match loop function reference.", zap.String("fn", string(MatchRestore)), zap.String("key", matchHandlers.restoreFn))
+		}
+	}
 
 	core := &RuntimeJavaScriptMatchCore{
 		logger:        logger,
@@ -162,6 +179,8 @@ func NewRuntimeJavascriptMatchCore(logger *zap.Logger, module string, db *sql.DB
 		loopFn:        loopFn,
 		terminateFn:   terminateFn,
 		signalFn:      signalFn,
+		snapshotFn:    snapshotFn,
+		restoreFn:     restoreFn,
 		ctx:           ctx,
 
 		loggerModule: jsLoggerInst,
@@ -173,6 +192,7 @@ func NewRuntimeJavascriptMatchCore(logger *zap.Logger, module string, db *sql.DB
 		func(call goja.ConstructorCall) *goja.Object {
 			call.This.Set("broadcastMessage", core.broadcastMessage(runtime))
 			call.This.Set("broadcastMessageDeferred", core.broadcastMessageDeferred(runtime))
+			call.This.Set("broadcastSpectatorMessage", core.broadcastSpectatorMessage(runtime))
 			call.This.Set("matchKick", core.matchKick(runtime))
 			call.This.Set("matchLabelUpdate", core.matchLabelUpdate(runtime))
 
@@ -234,10 +254,23 @@ func (rm *RuntimeJavaScriptMatchCore) MatchInit(presenceList *MatchPresenceList,
 		return nil, 0, ErrMatchInitStateNil
 	}
 
+	var dataRateLimits map[string]interface{}
+	if dataRateLimitsRet, ok := retMap["dataRateLimits"]; ok && dataRateLimitsRet != nil {
+		dataRateLimits, ok = dataRateLimitsRet.(map[string]interface{})
+		if !ok {
+			return nil, 0, errors.New("matchInit 'dataRateLimits' value must be an object")
+		}
+		if opCodes, found := dataRateLimits["opCodes"]; found {
+			delete(dataRateLimits, "opCodes")
+			dataRateLimits["op_codes"] = opCodes
+		}
+	}
+
 	if err := rm.matchRegistry.UpdateMatchLabel(rm.id, rm.tickRate, rm.module, label, rm.createTime); err != nil {
 		return nil, 0, err
 	}
 	rm.label.Store(label)
+	rm.dataRateLimits = dataRateLimits
 
 	rm.ctx.Set(__RUNTIME_JAVASCRIPT_CTX_MATCH_LABEL, label)
 	rm.ctx.Set(__RUNTIME_JAVASCRIPT_CTX_MATCH_TICK_RATE, rate)
@@ -322,12 +355,14 @@ func (rm *RuntimeJavaScriptMatchCore) MatchJoinAttempt(tick int64, state interfa
 func (rm *RuntimeJavaScriptMatchCore) MatchJoin(tick int64, state interface{}, joins []*MatchPresence) (interface{}, error) {
 	presences := make([]interface{}, 0, len(joins))
 	for _, p := range joins {
-		presenceMap := make(map[string]interface{}, 5)
+		presenceMap := make(map[string]interface{}, 7)
 		presenceMap["userId"] = p.UserID.String()
 		presenceMap["sessionId"] = p.SessionID.String()
 		presenceMap["username"] = p.Username
 		presenceMap["node"] = p.Node
 		presenceMap["reason"] = p.Reason
+		presenceMap["spectator"] = p.Spectator
+		presenceMap["suspended"] = p.Suspended
 
 		presences = append(presences, presenceMap)
 	}
@@ -359,12 +394,14 @@ func (rm *RuntimeJavaScriptMatchCore) MatchJoin(tick int64, state interface{}, j
 func (rm *RuntimeJavaScriptMatchCore) MatchLeave(tick int64, state interface{}, leaves []*MatchPresence) (interface{}, error) {
 	presences := make([]interface{}, 0, len(leaves))
 	for _, p := range leaves {
-		presenceMap := make(map[string]interface{}, 5)
+		presenceMap := make(map[string]interface{}, 7)
 		presenceMap["userId"] = p.UserID.String()
 		presenceMap["sessionId"] = p.SessionID.String()
 		presenceMap["username"] = p.Username
 		presenceMap["node"] = p.Node
 		presenceMap["reason"] = p.Reason
+		presenceMap["spectator"] = p.Spectator
+		presenceMap["suspended"] = p.Suspended
 
 		presences = append(presences, presenceMap)
 	}
@@ -502,6 +539,59 @@ func (rm *RuntimeJavaScriptMatchCore) MatchSignal(tick int64, state interface{},
 	return newState, responseData, nil
 }
 
+func (rm *RuntimeJavaScriptMatchCore) MatchSnapshot(tick int64, state interface{}) (string, error) {
+	if rm.snapshotFn == nil {
+		return "", nil
+	}
+
+	pointerizeSlices(state)
+	args := []goja.Value{rm.ctx, rm.loggerModule, rm.nakamaModule, rm.dispatcher, rm.vm.ToValue(tick), rm.vm.ToValue(state)}
+	retVal, err := rm.snapshotFn(goja.Null(), args...)
+	if err != nil {
+		return "", err
+	}
+
+	if goja.IsNull(retVal) || goja.IsUndefined(retVal) {
+		return "", nil
+	}
+
+	data, ok := retVal.Export().(string)
+	if !ok {
+		return "", errors.New("matchSnapshot is expected to return a string")
+	}
+
+	return data, nil
+}
+
+func (rm *RuntimeJavaScriptMatchCore) MatchRestore(tick int64, state interface{}, data string) (interface{}, error) {
+	if rm.restoreFn == nil {
+		return nil, ErrMatchSnapshotUnsupported
+	}
+
+	pointerizeSlices(state)
+	args := []goja.Value{rm.ctx, rm.loggerModule, rm.nakamaModule, rm.dispatcher, rm.vm.ToValue(tick), rm.vm.ToValue(state), rm.vm.ToValue(data)}
+	retVal, err := rm.restoreFn(goja.Null(), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if goja.IsNull(retVal) || goja.IsUndefined(retVal) {
+		return nil, nil
+	}
+
+	retMap, ok := retVal.Export().(map[string]interface{})
+	if !ok {
+		return nil, errors.New("matchRestore is expected to return an object with 'state' property")
+	}
+
+	newState, ok := retMap["state"]
+	if !ok {
+		return nil, errors.New("matchRestore is expected to return an object with 'state' property")
+	}
+
+	return newState, nil
+}
+
 func (rm *RuntimeJavaScriptMatchCore) GetState(state interface{}) (string, error) {
 	stateBytes, err := json.Marshal(RuntimeJsConvertJsValue(state))
 	if err != nil {
@@ -518,6 +608,10 @@ func (rm *RuntimeJavaScriptMatchCore) TickRate() int {
 	return rm.tickRate
 }
 
+func (rm *RuntimeJavaScriptMatchCore) DataRateLimits() map[string]interface{} {
+	return rm.dataRateLimits
+}
+
 func (rm *RuntimeJavaScriptMatchCore) HandlerName() string {
 	return rm.module
 }
@@ -568,6 +662,24 @@ func (rm *RuntimeJavaScriptMatchCore) broadcastMessageDeferred(r *goja.Runtime)
 	}
 }
 
+// Broadcast to spectators only, either all of them or those among the given presences.
+func (rm *RuntimeJavaScriptMatchCore) broadcastSpectatorMessage(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		if rm.stopped.Load() {
+			panic(r.NewGoError(matchStoppedError))
+		}
+
+		presenceIDs, msg, reliable := rm.validateBroadcast(r, f)
+		if len(presenceIDs) != 0 {
+			if presenceIDs = rm.presenceList.FilterSpectatorIDs(presenceIDs); len(presenceIDs) != 0 {
+				rm.router.SendToPresenceIDs(rm.logger, presenceIDs, msg, reliable)
+			}
+		}
+
+		return goja.Undefined()
+	}
+}
+
 func (rm *RuntimeJavaScriptMatchCore) validateBroadcast(r *goja.Runtime, f goja.FunctionCall) ([]*PresenceID, *rtapi.Envelope, bool) {
 	opCode := getJsInt(r, f.Argument(0))
 
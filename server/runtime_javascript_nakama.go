@@ -76,13 +76,14 @@ type runtimeJavascriptNakamaModule struct {
 	matchRegistry        MatchRegistry
 	streamManager        StreamManager
 	router               MessageRouter
+	apiLimiter           *ApiRateLimiter
 
 	node          string
 	matchCreateFn RuntimeMatchCreateFunction
 	eventFn       RuntimeEventCustomFunction
 }
 
-func NewRuntimeJavascriptNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, localCache *RuntimeJavascriptLocalCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, eventFn RuntimeEventCustomFunction, matchCreateFn RuntimeMatchCreateFunction) *runtimeJavascriptNakamaModule {
+func NewRuntimeJavascriptNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, localCache *RuntimeJavascriptLocalCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter, eventFn RuntimeEventCustomFunction, matchCreateFn RuntimeMatchCreateFunction) *runtimeJavascriptNakamaModule {
 	return &runtimeJavascriptNakamaModule{
 		ctx:                  context.Background(),
 		logger:               logger,
@@ -96,6 +97,7 @@ func NewRuntimeJavascriptNakamaModule(logger *zap.Logger, db *sql.DB, protojsonM
 		statusRegistry:       statusRegistry,
 		matchRegistry:        matchRegistry,
 		router:               router,
+		apiLimiter:           apiLimiter,
 		tracker:              tracker,
 		metrics:              metrics,
 		socialClient:         socialClient,
@@ -158,6 +160,7 @@ func (n *runtimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"authenticateFacebookInstantGame": n.authenticateFacebookInstantGame(r),
 		"authenticateGameCenter":          n.authenticateGameCenter(r),
 		"authenticateGoogle":              n.authenticateGoogle(r),
+		"authenticateOidc":                n.authenticateOidc(r),
 		"authenticateSteam":               n.authenticateSteam(r),
 		"authenticateTokenGenerate":       n.authenticateTokenGenerate(r),
 		"accountGetId":                    n.accountGetId(r),
@@ -178,6 +181,7 @@ func (n *runtimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"linkFacebookInstantGame":         n.linkFacebookInstantGame(r),
 		"linkGameCenter":                  n.linkGameCenter(r),
 		"linkGoogle":                      n.linkGoogle(r),
+		"linkOidc":                        n.linkOidc(r),
 		"linkSteam":                       n.linkSteam(r),
 		"unlinkApple":                     n.unlinkApple(r),
 		"unlinkCustom":                    n.unlinkCustom(r),
@@ -187,6 +191,7 @@ func (n *runtimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"unlinkFacebookInstantGame":       n.unlinkFacebookInstantGame(r),
 		"unlinkGameCenter":                n.unlinkGameCenter(r),
 		"unlinkGoogle":                    n.unlinkGoogle(r),
+		"unlinkOidc":                      n.unlinkOidc(r),
 		"unlinkSteam":                     n.unlinkSteam(r),
 		"streamUserList":                  n.streamUserList(r),
 		"streamUserGet":                   n.streamUserGet(r),
@@ -200,10 +205,14 @@ func (n *runtimeJavascriptNakamaModule) mappings(r *goja.Runtime) map[string]fun
 		"streamSendRaw":                   n.streamSendRaw(r),
 		"sessionDisconnect":               n.sessionDisconnect(r),
 		"sessionLogout":                   n.sessionLogout(r),
+		"apiQuotaGet":                     n.apiQuotaGet(r),
 		"matchCreate":                     n.matchCreate(r),
 		"matchGet":                        n.matchGet(r),
 		"matchList":                       n.matchList(r),
+		"matchListSorted":                 n.matchListSorted(r),
 		"matchSignal":                     n.matchSignal(r),
+		"matchmakerBackfillAdd":           n.matchmakerBackfillAdd(r),
+		"matchmakerBackfillRemove":        n.matchmakerBackfillRemove(r),
 		"notificationSend":                n.notificationSend(r),
 		"notificationsSend":               n.notificationsSend(r),
 		"notificationSendAll":             n.notificationSendAll(r),
@@ -1608,6 +1617,57 @@ func (n *runtimeJavascriptNakamaModule) authenticateGoogle(r *goja.Runtime) func
 	}
 }
 
+// @group authenticate
+// @summary Authenticate user and create a session token using an ID token from a configured OpenID Connect provider.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string) OpenID Connect ID token.
+// @param username(type=string, optional=true) The user's username. If left empty, the one mapped from the token's claims is used, or one is generated.
+// @param create(type=bool, optional=true, default=true) Create user if one didn't exist previously.
+// @return userID(string) The user ID of the authenticated user.
+// @return username(string) The username of the authenticated user.
+// @return create(bool) Value indicating if this account was just created or already existed.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) authenticateOidc(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		provider := getJsString(r, f.Argument(0))
+		if provider == "" {
+			panic(r.NewTypeError("expects provider string"))
+		}
+
+		token := getJsString(r, f.Argument(1))
+		if token == "" {
+			panic(r.NewTypeError("expects ID token string"))
+		}
+
+		username := ""
+		if f.Argument(2) != goja.Undefined() {
+			username = getJsString(r, f.Argument(2))
+		}
+
+		if invalidUsernameRegex.MatchString(username) {
+			panic(r.NewTypeError("expects username to be valid, no spaces or control characters allowed"))
+		} else if len(username) > 128 {
+			panic(r.NewTypeError("expects id to be valid, must be 1-128 bytes"))
+		}
+
+		create := true
+		if f.Argument(3) != goja.Undefined() {
+			create = getJsBool(r, f.Argument(3))
+		}
+
+		dbUserID, dbUsername, created, err := AuthenticateOidc(n.ctx, n.logger, n.db, n.socialClient, provider, token, username, create)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("error authenticating: %v", err.Error())))
+		}
+
+		return r.ToValue(map[string]interface{}{
+			"userId":   dbUserID,
+			"username": dbUsername,
+			"created":  created,
+		})
+	}
+}
+
 // @group authenticate
 // @summary Authenticate user and create a session token using a Steam account token.
 // @param token(type=string) Steam token.
@@ -2396,6 +2456,38 @@ func (n *runtimeJavascriptNakamaModule) linkGoogle(r *goja.Runtime) func(goja.Fu
 	}
 }
 
+// @group authenticate
+// @summary Link an OpenID Connect provider's identity to a user ID.
+// @param userId(type=string) The user ID to be linked.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string) OpenID Connect ID token.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) linkOidc(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userID := getJsString(r, f.Argument(0))
+		id, err := uuid.FromString(userID)
+		if err != nil {
+			panic(r.NewTypeError("invalid user id"))
+		}
+
+		provider := getJsString(r, f.Argument(1))
+		if provider == "" {
+			panic(r.NewTypeError("expects provider string"))
+		}
+
+		token := getJsString(r, f.Argument(2))
+		if token == "" {
+			panic(r.NewTypeError("expects token string"))
+		}
+
+		if err := LinkOidc(n.ctx, n.logger, n.db, n.socialClient, id, provider, token); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error linking: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 // @group authenticate
 // @summary Link Steam authentication to a user ID.
 // @param userId(type=string) The user ID to be linked.
@@ -2665,6 +2757,38 @@ func (n *runtimeJavascriptNakamaModule) unlinkGoogle(r *goja.Runtime) func(goja.
 	}
 }
 
+// @group authenticate
+// @summary Unlink an OpenID Connect provider's identity from a user ID.
+// @param userId(type=string) The user ID to be unlinked.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string, optional=true) OpenID Connect ID token.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) unlinkOidc(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userID := getJsString(r, f.Argument(0))
+		id, err := uuid.FromString(userID)
+		if err != nil {
+			panic(r.NewTypeError("invalid user id"))
+		}
+
+		provider := getJsString(r, f.Argument(1))
+		if provider == "" {
+			panic(r.NewTypeError("expects provider string"))
+		}
+
+		token := ""
+		if f.Argument(2) != goja.Undefined() {
+			token = getJsString(r, f.Argument(2))
+		}
+
+		if err := UnlinkOidc(n.ctx, n.logger, n.db, n.socialClient, id, provider, token); err != nil {
+			panic(r.NewGoError(fmt.Errorf("error unlinking: %v", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 // @group authenticate
 // @summary Unlink Steam authentication from a user ID.
 // @param userId(type=string) The user ID to be unlinked.
@@ -3376,6 +3500,32 @@ func (n *runtimeJavascriptNakamaModule) sessionLogout(r *goja.Runtime) func(goja
 	}
 }
 
+// @group sessions
+// @summary Get the number of requests a user made today to each API method or RPC with a configured daily quota. Counts reset at midnight UTC.
+// @param userId(type=string) The ID of the user to get quota counts for.
+// @return quotas({[key:string]:number}) Request counts keyed by API method name, or "rpc:" followed by the RPC ID.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) apiQuotaGet(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		userIDString := getJsString(r, f.Argument(0))
+		if userIDString == "" {
+			panic(r.NewTypeError("expects a user id"))
+		}
+		userID, err := uuid.FromString(userIDString)
+		if err != nil {
+			panic(r.NewTypeError("expects a valid user id"))
+		}
+
+		quotas := n.apiLimiter.Quotas(userID)
+		quotasMap := make(map[string]interface{}, len(quotas))
+		for name, count := range quotas {
+			quotasMap[name] = count
+		}
+
+		return r.ToValue(quotasMap)
+	}
+}
+
 // @group matches
 // @summary Create a new authoritative realtime multiplayer match running on the given runtime module name. The given params are passed to the match's init hook.
 // @param module(type=string) The name of an available runtime module that will be responsible for the match. This was registered in InitModule.
@@ -3485,31 +3635,111 @@ func (n *runtimeJavascriptNakamaModule) matchList(r *goja.Runtime) func(goja.Fun
 			query = &wrapperspb.StringValue{Value: getJsString(r, f.Argument(5))}
 		}
 
-		results, _, err := n.matchRegistry.ListMatches(n.ctx, limit, authoritative, label, minSize, maxSize, query, nil)
+		results, _, _, err := n.matchRegistry.ListMatches(n.ctx, limit, authoritative, label, minSize, maxSize, query, nil, nil, "")
 		if err != nil {
 			panic(r.NewGoError(fmt.Errorf("failed to list matches: %s", err.Error())))
 		}
 
-		matches := make([]interface{}, 0, len(results))
-		for _, match := range results {
-			matchData := map[string]interface{}{
-				"matchId":       match.MatchId,
-				"authoritative": match.Authoritative,
-				"size":          match.Size,
+		return r.ToValue(jsMatchList(results))
+	}
+}
+
+// @group matches
+// @summary List currently running authoritative matches in a chosen order, one page at a time, and optionally filter them by label, query, and current participant count.
+// @param limit(type=number, optional=true, default=1) The maximum number of matches to list.
+// @param label(type=string, optional=true) A label to filter authoritative matches by. Default "" meaning any label matches.
+// @param minSize(type=number, optional=true) Inclusive lower limit of current match participants.
+// @param maxSize(type=number, optional=true) Inclusive upper limit of current match participants.
+// @param query(type=string, optional=true) Additional query parameters to shortlist matches.
+// @param sort(type=string[], optional=true) Fields to sort by, "create_time", "tick_rate", "handler_name" or "label.<field>", prefixed with "-" for descending order. Default is newest first, or best query score first.
+// @param cursor(type=string, optional=true) Pagination cursor from a previous listing with the same filters and sort order.
+// @return matches(nkruntime.MatchListSorted) A list of matches matching the parameters criteria, and a cursor to fetch the next page if there is one.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) matchListSorted(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		limit := 1
+		if f.Argument(0) != goja.Undefined() {
+			limit = int(getJsInt(r, f.Argument(0)))
+		}
+
+		var label *wrapperspb.StringValue
+		if f.Argument(1) != goja.Undefined() && f.Argument(1) != goja.Null() {
+			label = &wrapperspb.StringValue{Value: getJsString(r, f.Argument(1))}
+		}
+
+		var minSize *wrapperspb.Int32Value
+		if f.Argument(2) != goja.Undefined() && f.Argument(2) != goja.Null() {
+			minSize = &wrapperspb.Int32Value{Value: int32(getJsInt(r, f.Argument(2)))}
+		}
+
+		var maxSize *wrapperspb.Int32Value
+		if f.Argument(3) != goja.Undefined() && f.Argument(3) != goja.Null() {
+			maxSize = &wrapperspb.Int32Value{Value: int32(getJsInt(r, f.Argument(3)))}
+		}
+
+		var query *wrapperspb.StringValue
+		if f.Argument(4) != goja.Undefined() && f.Argument(4) != goja.Null() {
+			query = &wrapperspb.StringValue{Value: getJsString(r, f.Argument(4))}
+		}
+
+		var sort []string
+		if f.Argument(5) != goja.Undefined() && f.Argument(5) != goja.Null() {
+			sortIn, ok := f.Argument(5).Export().([]interface{})
+			if !ok {
+				panic(r.NewTypeError("Invalid argument - sort must be an array."))
 			}
-			if match.Label == nil {
-				matchData["label"] = nil
-			} else {
-				matchData["label"] = match.Label.Value
+			sort = make([]string, 0, len(sortIn))
+			for _, field := range sortIn {
+				fieldStr, ok := field.(string)
+				if !ok {
+					panic(r.NewTypeError(fmt.Sprintf("invalid sort field: %v - must be a string", field)))
+				}
+				sort = append(sort, fieldStr)
 			}
+		}
+
+		var cursor string
+		if f.Argument(6) != goja.Undefined() && f.Argument(6) != goja.Null() {
+			cursor = getJsString(r, f.Argument(6))
+		}
 
-			matches = append(matches, matchData)
+		results, _, nextCursor, err := n.matchRegistry.ListMatches(n.ctx, limit, &wrapperspb.BoolValue{Value: true}, label, minSize, maxSize, query, nil, sort, cursor)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to list matches: %s", err.Error())))
 		}
 
-		return r.ToValue(matches)
+		result := map[string]interface{}{
+			"matches": jsMatchList(results),
+		}
+		if nextCursor == "" {
+			result["cursor"] = nil
+		} else {
+			result["cursor"] = nextCursor
+		}
+
+		return r.ToValue(result)
 	}
 }
 
+func jsMatchList(results []*api.Match) []interface{} {
+	matches := make([]interface{}, 0, len(results))
+	for _, match := range results {
+		matchData := map[string]interface{}{
+			"matchId":       match.MatchId,
+			"authoritative": match.Authoritative,
+			"size":          match.Size,
+		}
+		if match.Label == nil {
+			matchData["label"] = nil
+		} else {
+			matchData["label"] = match.Label.Value
+		}
+
+		matches = append(matches, matchData)
+	}
+	return matches
+}
+
 // @group matches
 // @summary Allow the match handler to be sent a reservation signal to mark a user ID or session ID into the match state ahead of their join attempt and eventual join flow. Called when the match handler receives a runtime signal.
 // @param id(type=string) The user ID or session ID to send a reservation signal for.
@@ -3534,6 +3764,80 @@ func (n *runtimeJavascriptNakamaModule) matchSignal(r *goja.Runtime) func(goja.F
 	}
 }
 
+// @group matches
+// @summary Ask the matchmaker for players to fill open slots in a running authoritative match. Matched players are sent the match ID directly instead of forming a new matchmaker group. The request is withdrawn automatically when the match ends.
+// @param id(type=string) The ID of the authoritative match to fill. Must be running on this node.
+// @param query(type=string) The matchmaker query players must satisfy to fill the open slots.
+// @param count(type=number) The number of open slots to fill.
+// @param properties(type=object, optional=true) String and numeric properties describing the match, used when checking player queries.
+// @param queue(type=string, optional=true) The named matchmaker queue to take players from. Defaults to the default queue.
+// @return ticket(string) The backfill ticket, used to withdraw the request.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) matchmakerBackfillAdd(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		id := getJsString(r, f.Argument(0))
+		query := getJsString(r, f.Argument(1))
+		count := int(getJsInt(r, f.Argument(2)))
+		if count < 1 {
+			panic(r.NewTypeError("expects count to be at least 1"))
+		}
+
+		stringProperties := make(map[string]string)
+		numericProperties := make(map[string]float64)
+		if f.Argument(3) != goja.Undefined() && f.Argument(3) != goja.Null() {
+			properties, ok := f.Argument(3).Export().(map[string]interface{})
+			if !ok {
+				panic(r.NewTypeError("expects properties to be an object"))
+			}
+			for k, v := range properties {
+				switch v := v.(type) {
+				case string:
+					stringProperties[k] = v
+				case int64:
+					numericProperties[k] = float64(v)
+				case float64:
+					numericProperties[k] = v
+				default:
+					panic(r.NewTypeError("expects properties values to be strings or numbers"))
+				}
+			}
+		}
+
+		queue := MatchmakerDefaultQueue
+		if f.Argument(4) != goja.Undefined() && f.Argument(4) != goja.Null() {
+			queue = getJsString(r, f.Argument(4))
+		}
+
+		ticket, err := n.matchRegistry.MatchmakerBackfillAdd(n.ctx, id, queue, query, count, stringProperties, numericProperties)
+		if err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to add matchmaker backfill: %s", err.Error())))
+		}
+
+		return r.ToValue(ticket)
+	}
+}
+
+// @group matches
+// @summary Withdraw a backfill request made by a running authoritative match.
+// @param id(type=string) The ID of the authoritative match that made the request.
+// @param ticket(type=string) The backfill ticket to withdraw.
+// @return error(error) An optional error value if an error occurred.
+func (n *runtimeJavascriptNakamaModule) matchmakerBackfillRemove(r *goja.Runtime) func(goja.FunctionCall) goja.Value {
+	return func(f goja.FunctionCall) goja.Value {
+		id := getJsString(r, f.Argument(0))
+		ticket := getJsString(r, f.Argument(1))
+		if ticket == "" {
+			panic(r.NewTypeError("expects ticket to be a non-empty string"))
+		}
+
+		if err := n.matchRegistry.MatchmakerBackfillRemove(n.ctx, id, ticket); err != nil {
+			panic(r.NewGoError(fmt.Errorf("failed to remove matchmaker backfill: %s", err.Error())))
+		}
+
+		return goja.Undefined()
+	}
+}
+
 // @group notifications
 // @summary Send one in-app notification to a user.
 // @param userId(type=string) The user ID of the user to be sent the notification.
@@ -22,24 +22,25 @@ import (
 )
 
 const (
-	__RUNTIME_LUA_CTX_ENV              = "env"
-	__RUNTIME_LUA_CTX_MODE             = "execution_mode"
-	__RUNTIME_LUA_CTX_NODE             = "node"
-	__RUNTIME_LUA_CTX_VERSION          = "version"
-	__RUNTIME_LUA_CTX_HEADERS          = "headers"
-	__RUNTIME_LUA_CTX_QUERY_PARAMS     = "query_params"
-	__RUNTIME_LUA_CTX_USER_ID          = "user_id"
-	__RUNTIME_LUA_CTX_USERNAME         = "username"
-	__RUNTIME_LUA_CTX_VARS             = "vars"
-	__RUNTIME_LUA_CTX_USER_SESSION_EXP = "user_session_exp"
-	__RUNTIME_LUA_CTX_SESSION_ID       = "session_id"
-	__RUNTIME_LUA_CTX_LANG             = "lang"
-	__RUNTIME_LUA_CTX_CLIENT_IP        = "client_ip"
-	__RUNTIME_LUA_CTX_CLIENT_PORT      = "client_port"
-	__RUNTIME_LUA_CTX_MATCH_ID         = "match_id"
-	__RUNTIME_LUA_CTX_MATCH_NODE       = "match_node"
-	__RUNTIME_LUA_CTX_MATCH_LABEL      = "match_label"
-	__RUNTIME_LUA_CTX_MATCH_TICK_RATE  = "match_tick_rate"
+	__RUNTIME_LUA_CTX_ENV               = "env"
+	__RUNTIME_LUA_CTX_MODE              = "execution_mode"
+	__RUNTIME_LUA_CTX_NODE              = "node"
+	__RUNTIME_LUA_CTX_VERSION           = "version"
+	__RUNTIME_LUA_CTX_HEADERS           = "headers"
+	__RUNTIME_LUA_CTX_QUERY_PARAMS      = "query_params"
+	__RUNTIME_LUA_CTX_USER_ID           = "user_id"
+	__RUNTIME_LUA_CTX_USERNAME          = "username"
+	__RUNTIME_LUA_CTX_VARS              = "vars"
+	__RUNTIME_LUA_CTX_USER_SESSION_EXP  = "user_session_exp"
+	__RUNTIME_LUA_CTX_SESSION_ID        = "session_id"
+	__RUNTIME_LUA_CTX_LANG              = "lang"
+	__RUNTIME_LUA_CTX_CLIENT_IP         = "client_ip"
+	__RUNTIME_LUA_CTX_CLIENT_PORT       = "client_port"
+	__RUNTIME_LUA_CTX_MATCH_ID          = "match_id"
+	__RUNTIME_LUA_CTX_MATCH_NODE        = "match_node"
+	__RUNTIME_LUA_CTX_MATCH_LABEL       = "match_label"
+	__RUNTIME_LUA_CTX_MATCH_TICK_RATE   = "match_tick_rate"
+	__RUNTIME_LUA_CTX_MATCHMAKER_REGION = "matchmaker_region"
 )
 
 func NewRuntimeLuaContext(l *lua.LState, node, version string, env *lua.LTable, mode RuntimeExecutionMode, headers, queryParams map[string][]string, sessionExpiry int64, userID, username string, vars map[string]string, sessionID, clientIP, clientPort, lang string) *lua.LTable {
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -50,6 +51,8 @@ type RuntimeLuaMatchCore struct {
 	idStr      string
 	stream     PresenceStream
 	label      *atomic.String
+	// dataRateLimits set in MatchInit.
+	dataRateLimits map[string]interface{}
 
 	vm            *lua.LState
 	initFn        lua.LValue
@@ -59,6 +62,8 @@ type RuntimeLuaMatchCore struct {
 	loopFn        lua.LValue
 	terminateFn   lua.LValue
 	signalFn      lua.LValue
+	snapshotFn    lua.LValue
+	restoreFn     lua.LValue
 	ctx           *lua.LTable
 	dispatcher    *lua.LTable
 
@@ -68,7 +73,7 @@ type RuntimeLuaMatchCore struct {
 	ctxCancelFn context.CancelFunc
 }
 
-func NewRuntimeLuaMatchCore(logger *zap.Logger, module string, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, stdLibs map[string]lua.LGFunction, once *sync.Once, localCache *RuntimeLuaLocalCache, eventFn RuntimeEventCustomFunction, sharedReg, sharedGlobals *lua.LTable, id uuid.UUID, node string, stopped *atomic.Bool, name string, matchProvider *MatchProvider, modulePatchRegistry RuntimeLuaModulePatchRegistry) (RuntimeMatchCore, error) {
+func NewRuntimeLuaMatchCore(logger *zap.Logger, module string, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter, stdLibs map[string]lua.LGFunction, once *sync.Once, localCache *RuntimeLuaLocalCache, eventFn RuntimeEventCustomFunction, sharedReg, sharedGlobals *lua.LTable, id uuid.UUID, node string, stopped *atomic.Bool, name string, matchProvider *MatchProvider, modulePatchRegistry RuntimeLuaModulePatchRegistry) (RuntimeMatchCore, error) {
 	// Set up the Lua VM that will handle this match.
 	vm := lua.NewState(lua.Options{
 		CallStackSize:       config.GetRuntime().GetLuaCallStackSize(),
@@ -98,7 +103,7 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, module string, db *sql.DB, proto
 			vm.Call(1, 0)
 		}
 
-		nakamaModule := NewRuntimeLuaNakamaModule(logger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, rankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, once, localCache, matchProvider.CreateMatch, eventFn, nil, nil)
+		nakamaModule := NewRuntimeLuaNakamaModule(logger, db, protojsonMarshaler, protojsonUnmarshaler, config, version, socialClient, leaderboardCache, rankCache, leaderboardScheduler, sessionRegistry, sessionCache, statusRegistry, matchRegistry, tracker, metrics, streamManager, router, apiLimiter, once, localCache, matchProvider.CreateMatch, eventFn, nil, nil)
 		vm.PreloadModule("nakama", nakamaModule.Loader)
 	}
 
@@ -167,6 +172,14 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, module string, db *sql.DB, proto
 		ctxCancelFn()
 		return nil, errors.New("match_signal not found or not a function")
 	}
+	snapshotFn := tab.RawGet(lua.LString("match_snapshot"))
+	restoreFn := tab.RawGet(lua.LString("match_restore"))
+	if snapshotFn.Type() != lua.LTNil || restoreFn.Type() != lua.LTNil {
+		if snapshotFn.Type() != lua.LTFunction || restoreFn.Type() != lua.LTFunction {
+			ctxCancelFn()
+			return nil, errors.New("match_snapshot and match_restore must both be functions if either is set")
+		}
+	}
 
 	core := &RuntimeLuaMatchCore{
 		logger:        logger,
@@ -198,6 +211,8 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, module string, db *sql.DB, proto
 		loopFn:        loopFn,
 		terminateFn:   terminateFn,
 		signalFn:      signalFn,
+		snapshotFn:    snapshotFn,
+		restoreFn:     restoreFn,
 		ctx:           ctx,
 		// dispatcher set below.
 
@@ -209,11 +224,12 @@ func NewRuntimeLuaMatchCore(logger *zap.Logger, module string, db *sql.DB, proto
 	core.modulePatchCh = make(chan *RuntimeLuaModule, 8)
 	modulePatchRegistry.Subscribe(core.id, core.modulePatchCh)
 
-	core.dispatcher = vm.SetFuncs(vm.CreateTable(0, 4), map[string]lua.LGFunction{
-		"broadcast_message":          core.broadcastMessage,
-		"broadcast_message_deferred": core.broadcastMessageDeferred,
-		"match_kick":                 core.matchKick,
-		"match_label_update":         core.matchLabelUpdate,
+	core.dispatcher = vm.SetFuncs(vm.CreateTable(0, 5), map[string]lua.LGFunction{
+		"broadcast_message":           core.broadcastMessage,
+		"broadcast_message_deferred":  core.broadcastMessageDeferred,
+		"broadcast_spectator_message": core.broadcastSpectatorMessage,
+		"match_kick":                  core.matchKick,
+		"match_label_update":          core.matchLabelUpdate,
 	})
 
 	return core, nil
@@ -235,6 +251,30 @@ func (r *RuntimeLuaMatchCore) MatchInit(presenceList *MatchPresenceList, deferMe
 		return nil, 0, err
 	}
 
+	// Extract optional match data rate limits, present only if match_init returned four values.
+	var dataRateLimits map[string]interface{}
+	if r.vm.Get(-5).Type() == LTSentinel {
+		limits := r.vm.Get(-1)
+		switch limits.Type() {
+		case lua.LTNil:
+		case lua.LTTable:
+			dataRateLimits = RuntimeLuaConvertLuaTable(limits.(*lua.LTable))
+			// Tables keyed by consecutive op codes from 1 are converted as arrays.
+			if opCodes, ok := dataRateLimits["op_codes"].([]interface{}); ok {
+				opCodesMap := make(map[string]interface{}, len(opCodes))
+				for i, limit := range opCodes {
+					if limit != nil {
+						opCodesMap[strconv.Itoa(i+1)] = limit
+					}
+				}
+				dataRateLimits["op_codes"] = opCodesMap
+			}
+		default:
+			return nil, 0, errors.New("match_init returned unexpected fourth value, must be a table of data rate limits")
+		}
+		r.vm.Pop(1)
+	}
+
 	// Extract desired label.
 	label := r.vm.Get(-1)
 	if label.Type() == LTSentinel {
@@ -276,7 +316,7 @@ func (r *RuntimeLuaMatchCore) MatchInit(presenceList *MatchPresenceList, deferMe
 
 	// Drop the sentinel value from the stack.
 	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
-		return nil, 0, errors.New("match_init returned too many arguments, must be: state, tick rate number, label string")
+		return nil, 0, errors.New("match_init returned too many arguments, must be: state, tick rate number, label string, optional data rate limits table")
 	}
 	r.vm.Pop(1)
 
@@ -284,6 +324,7 @@ func (r *RuntimeLuaMatchCore) MatchInit(presenceList *MatchPresenceList, deferMe
 		return nil, 0, err
 	}
 	r.label.Store(labelStr)
+	r.dataRateLimits = dataRateLimits
 
 	// Add context values only available after match_init completes.
 	r.ctx.RawSetString(__RUNTIME_LUA_CTX_MATCH_LABEL, label)
@@ -399,12 +440,14 @@ func (r *RuntimeLuaMatchCore) MatchJoin(tick int64, state interface{}, joins []*
 
 	presences := r.vm.CreateTable(len(joins), 0)
 	for i, p := range joins {
-		presence := r.vm.CreateTable(0, 5)
+		presence := r.vm.CreateTable(0, 7)
 		presence.RawSetString("user_id", lua.LString(p.UserID.String()))
 		presence.RawSetString("session_id", lua.LString(p.SessionID.String()))
 		presence.RawSetString("username", lua.LString(p.Username))
 		presence.RawSetString("node", lua.LString(p.Node))
 		presence.RawSetString("reason", lua.LNumber(p.Reason))
+		presence.RawSetString("spectator", lua.LBool(p.Spectator))
+		presence.RawSetString("suspended", lua.LBool(p.Suspended))
 
 		presences.RawSetInt(i+1, presence)
 	}
@@ -441,12 +484,14 @@ func (r *RuntimeLuaMatchCore) MatchJoin(tick int64, state interface{}, joins []*
 func (r *RuntimeLuaMatchCore) MatchLeave(tick int64, state interface{}, leaves []*MatchPresence) (interface{}, error) {
 	presences := r.vm.CreateTable(len(leaves), 0)
 	for i, p := range leaves {
-		presence := r.vm.CreateTable(0, 5)
+		presence := r.vm.CreateTable(0, 7)
 		presence.RawSetString("user_id", lua.LString(p.UserID.String()))
 		presence.RawSetString("session_id", lua.LString(p.SessionID.String()))
 		presence.RawSetString("username", lua.LString(p.Username))
 		presence.RawSetString("node", lua.LString(p.Node))
 		presence.RawSetString("reason", lua.LNumber(p.Reason))
+		presence.RawSetString("spectator", lua.LBool(p.Spectator))
+		presence.RawSetString("suspended", lua.LBool(p.Suspended))
 
 		presences.RawSetInt(i+1, presence)
 	}
@@ -621,6 +666,78 @@ func (r *RuntimeLuaMatchCore) MatchSignal(tick int64, state interface{}, data st
 	return newState, responseDataString, nil
 }
 
+func (r *RuntimeLuaMatchCore) MatchSnapshot(tick int64, state interface{}) (string, error) {
+	if r.snapshotFn.Type() == lua.LTNil {
+		return "", nil
+	}
+
+	// Execute the match_snapshot call.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.snapshotFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(r.dispatcher)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(state.(lua.LValue))
+
+	err := r.vm.PCall(4, lua.MultRet, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Extract the resulting snapshot data.
+	data := r.vm.Get(-1)
+	var dataString string
+	if data.Type() == lua.LTString {
+		dataString = data.String()
+	} else if data.Type() != lua.LTNil && data.Type() != LTSentinel {
+		return "", errors.New("Match snapshot returned non-string result")
+	}
+	if data.Type() != LTSentinel {
+		r.vm.Pop(1)
+	}
+	// Check for and remove the sentinel value, will fail if there are any extra return values.
+	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
+		return "", errors.New("Match snapshot returned too many values")
+	}
+	r.vm.Pop(1)
+
+	return dataString, nil
+}
+
+func (r *RuntimeLuaMatchCore) MatchRestore(tick int64, state interface{}, data string) (interface{}, error) {
+	if r.restoreFn.Type() == lua.LTNil {
+		return nil, ErrMatchSnapshotUnsupported
+	}
+
+	// Execute the match_restore call.
+	r.vm.Push(LSentinel)
+	r.vm.Push(r.restoreFn)
+	r.vm.Push(r.ctx)
+	r.vm.Push(r.dispatcher)
+	r.vm.Push(lua.LNumber(tick))
+	r.vm.Push(state.(lua.LValue))
+	r.vm.Push(lua.LString(data))
+
+	err := r.vm.PCall(5, lua.MultRet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the resulting state.
+	newState := r.vm.Get(-1)
+	if newState.Type() == lua.LTNil || newState.Type() == LTSentinel {
+		return nil, nil
+	}
+	r.vm.Pop(1)
+	// Check for and remove the sentinel value, will fail if there are any extra return values.
+	if sentinel := r.vm.Get(-1); sentinel.Type() != LTSentinel {
+		return nil, errors.New("Match restore returned too many values")
+	}
+	r.vm.Pop(1)
+
+	return newState, nil
+}
+
 func (r *RuntimeLuaMatchCore) GetState(state interface{}) (string, error) {
 	stateBytes, err := json.Marshal(RuntimeLuaConvertLuaValue(state.(lua.LValue)))
 	if err != nil {
@@ -637,6 +754,10 @@ func (r *RuntimeLuaMatchCore) TickRate() int {
 	return r.tickRate
 }
 
+func (r *RuntimeLuaMatchCore) DataRateLimits() map[string]interface{} {
+	return r.dataRateLimits
+}
+
 func (r *RuntimeLuaMatchCore) HandlerName() string {
 	return r.module
 }
@@ -689,6 +810,23 @@ func (r *RuntimeLuaMatchCore) broadcastMessageDeferred(l *lua.LState) int {
 	return 0
 }
 
+// Broadcast to spectators only, either all of them or those among the given presences.
+func (r *RuntimeLuaMatchCore) broadcastSpectatorMessage(l *lua.LState) int {
+	if r.stopped.Load() {
+		l.RaiseError("match stopped")
+		return 0
+	}
+
+	presenceIDs, msg, reliable := r.validateBroadcast(l)
+	if len(presenceIDs) != 0 {
+		if presenceIDs = r.presenceList.FilterSpectatorIDs(presenceIDs); len(presenceIDs) != 0 {
+			r.router.SendToPresenceIDs(r.logger, presenceIDs, msg, reliable)
+		}
+	}
+
+	return 0
+}
+
 func (r *RuntimeLuaMatchCore) validateBroadcast(l *lua.LState) ([]*PresenceID, *rtapi.Envelope, bool) {
 	opCode := l.CheckInt64(1)
 
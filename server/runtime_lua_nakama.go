@@ -77,6 +77,7 @@ type RuntimeLuaNakamaModule struct {
 	metrics              Metrics
 	streamManager        StreamManager
 	router               MessageRouter
+	apiLimiter           *ApiRateLimiter
 	once                 *sync.Once
 	localCache           *RuntimeLuaLocalCache
 	registerCallbackFn   func(RuntimeExecutionMode, string, *lua.LFunction)
@@ -88,7 +89,7 @@ type RuntimeLuaNakamaModule struct {
 	eventFn       RuntimeEventCustomFunction
 }
 
-func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, once *sync.Once, localCache *RuntimeLuaLocalCache, matchCreateFn RuntimeMatchCreateFunction, eventFn RuntimeEventCustomFunction, registerCallbackFn func(RuntimeExecutionMode, string, *lua.LFunction), announceCallbackFn func(RuntimeExecutionMode, string)) *RuntimeLuaNakamaModule {
+func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, config Config, version string, socialClient *social.Client, leaderboardCache LeaderboardCache, rankCache LeaderboardRankCache, leaderboardScheduler LeaderboardScheduler, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchRegistry MatchRegistry, tracker Tracker, metrics Metrics, streamManager StreamManager, router MessageRouter, apiLimiter *ApiRateLimiter, once *sync.Once, localCache *RuntimeLuaLocalCache, matchCreateFn RuntimeMatchCreateFunction, eventFn RuntimeEventCustomFunction, registerCallbackFn func(RuntimeExecutionMode, string, *lua.LFunction), announceCallbackFn func(RuntimeExecutionMode, string)) *RuntimeLuaNakamaModule {
 	return &RuntimeLuaNakamaModule{
 		logger:               logger,
 		db:                   db,
@@ -108,6 +109,7 @@ func NewRuntimeLuaNakamaModule(logger *zap.Logger, db *sql.DB, protojsonMarshale
 		metrics:              metrics,
 		streamManager:        streamManager,
 		router:               router,
+		apiLimiter:           apiLimiter,
 		once:                 once,
 		localCache:           localCache,
 		registerCallbackFn:   registerCallbackFn,
@@ -128,6 +130,7 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"register_rt_before":                 n.registerRTBefore,
 		"register_rt_after":                  n.registerRTAfter,
 		"register_matchmaker_matched":        n.registerMatchmakerMatched,
+		"register_matchmaker_score":          n.registerMatchmakerScore,
 		"register_tournament_end":            n.registerTournamentEnd,
 		"register_tournament_reset":          n.registerTournamentReset,
 		"register_leaderboard_reset":         n.registerLeaderboardReset,
@@ -175,6 +178,7 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"authenticate_facebook_instant_game": n.authenticateFacebookInstantGame,
 		"authenticate_game_center":           n.authenticateGameCenter,
 		"authenticate_google":                n.authenticateGoogle,
+		"authenticate_oidc":                  n.authenticateOidc,
 		"authenticate_steam":                 n.authenticateSteam,
 		"authenticate_token_generate":        n.authenticateTokenGenerate,
 		"logger_debug":                       n.loggerDebug,
@@ -199,6 +203,7 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"link_facebook_instant_game":         n.linkFacebookInstantGame,
 		"link_gamecenter":                    n.linkGameCenter,
 		"link_google":                        n.linkGoogle,
+		"link_oidc":                          n.linkOidc,
 		"link_steam":                         n.linkSteam,
 		"unlink_apple":                       n.unlinkApple,
 		"unlink_custom":                      n.unlinkCustom,
@@ -208,6 +213,7 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"unlink_facebook_instant_game":       n.unlinkFacebookInstantGame,
 		"unlink_gamecenter":                  n.unlinkGameCenter,
 		"unlink_google":                      n.unlinkGoogle,
+		"unlink_oidc":                        n.unlinkOidc,
 		"unlink_steam":                       n.unlinkSteam,
 		"stream_user_list":                   n.streamUserList,
 		"stream_user_get":                    n.streamUserGet,
@@ -221,10 +227,13 @@ func (n *RuntimeLuaNakamaModule) Loader(l *lua.LState) int {
 		"stream_send_raw":                    n.streamSendRaw,
 		"session_disconnect":                 n.sessionDisconnect,
 		"session_logout":                     n.sessionLogout,
+		"api_quota_get":                      n.apiQuotaGet,
 		"match_create":                       n.matchCreate,
 		"match_get":                          n.matchGet,
 		"match_list":                         n.matchList,
 		"match_signal":                       n.matchSignal,
+		"matchmaker_backfill_add":            n.matchmakerBackfillAdd,
+		"matchmaker_backfill_remove":         n.matchmakerBackfillRemove,
 		"notification_send":                  n.notificationSend,
 		"notifications_send":                 n.notificationsSend,
 		"notification_send_all":              n.notificationSendAll,
@@ -423,7 +432,7 @@ func (n *RuntimeLuaNakamaModule) registerRTAfter(l *lua.LState) int {
 }
 
 // @group hooks
-// @summary Registers a function that will be called when matchmaking finds opponents.
+// @summary Registers a function that will be called when matchmaking finds opponents. If the matched tickets reported round trip times, the chosen region is available as "matchmaker_region" in the context.
 // @param fn(type=function) A function reference which will be executed on each matchmake completion.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeLuaNakamaModule) registerMatchmakerMatched(l *lua.LState) int {
@@ -438,6 +447,22 @@ func (n *RuntimeLuaNakamaModule) registerMatchmakerMatched(l *lua.LState) int {
 	return 0
 }
 
+// @group hooks
+// @summary Registers a function that scores the candidate groups the matchmaker found for a ticket in a single interval. The highest scoring candidate is matched, and any candidate given a negative score is vetoed.
+// @param fn(type=function) A function reference which receives a table of candidate groups and returns a table with one score per candidate.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) registerMatchmakerScore(l *lua.LState) int {
+	fn := l.CheckFunction(1)
+
+	if n.registerCallbackFn != nil {
+		n.registerCallbackFn(RuntimeExecutionModeMatchmakerScore, "", fn)
+	}
+	if n.announceCallbackFn != nil {
+		n.announceCallbackFn(RuntimeExecutionModeMatchmakerScore, "")
+	}
+	return 0
+}
+
 // @group hooks
 // @summary Registers a function to be run when a tournament ends.
 // @param fn(type=function) A function reference which will be executed on each tournament end.
@@ -1985,6 +2010,56 @@ func (n *RuntimeLuaNakamaModule) authenticateGoogle(l *lua.LState) int {
 	return 3
 }
 
+// @group authenticate
+// @summary Authenticate user and create a session token using an ID token from a configured OpenID Connect provider.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string) OpenID Connect ID token.
+// @param username(type=string, optional=true) The user's username. If left empty, the one mapped from the token's claims is used, or one is generated.
+// @param create(type=bool, optional=true, default=true) Create user if one didn't exist previously.
+// @return userID(string) The user ID of the authenticated user.
+// @return username(string) The username of the authenticated user.
+// @return created(bool) Value indicating if this account was just created or already existed.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) authenticateOidc(l *lua.LState) int {
+	// Parse provider.
+	provider := l.CheckString(1)
+	if provider == "" {
+		l.ArgError(1, "expects provider string")
+		return 0
+	}
+
+	// Parse ID token.
+	token := l.CheckString(2)
+	if token == "" {
+		l.ArgError(2, "expects ID token string")
+		return 0
+	}
+
+	// Parse username, if any.
+	username := l.OptString(3, "")
+	if invalidUsernameRegex.MatchString(username) {
+		l.ArgError(3, "expects username to be valid, no spaces or control characters allowed")
+		return 0
+	} else if len(username) > 128 {
+		l.ArgError(3, "expects id to be valid, must be 1-128 bytes")
+		return 0
+	}
+
+	// Parse create flag, if any.
+	create := l.OptBool(4, true)
+
+	dbUserID, dbUsername, created, err := AuthenticateOidc(l.Context(), n.logger, n.db, n.socialClient, provider, token, username, create)
+	if err != nil {
+		l.RaiseError("error authenticating: %v", err.Error())
+		return 0
+	}
+
+	l.Push(lua.LString(dbUserID))
+	l.Push(lua.LString(dbUsername))
+	l.Push(lua.LBool(created))
+	return 3
+}
+
 // @group authenticate
 // @summary Authenticate user and create a session token using a Steam account token.
 // @param token(type=string) Steam token.
@@ -3138,6 +3213,38 @@ func (n *RuntimeLuaNakamaModule) linkGoogle(l *lua.LState) int {
 	return 0
 }
 
+// @group authenticate
+// @summary Link an OpenID Connect provider's identity to a user ID.
+// @param userId(type=string) The user ID to be linked.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string) OpenID Connect ID token.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) linkOidc(l *lua.LState) int {
+	userID := l.CheckString(1)
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		l.ArgError(1, "user ID must be a valid identifier")
+		return 0
+	}
+
+	provider := l.CheckString(2)
+	if provider == "" {
+		l.ArgError(2, "expects provider string")
+		return 0
+	}
+
+	token := l.CheckString(3)
+	if token == "" {
+		l.ArgError(3, "expects token string")
+		return 0
+	}
+
+	if err := LinkOidc(l.Context(), n.logger, n.db, n.socialClient, id, provider, token); err != nil {
+		l.RaiseError("error linking: %v", err.Error())
+	}
+	return 0
+}
+
 // @group authenticate
 // @summary Link Steam authentication to a user ID.
 // @param userId(type=string) The user ID to be linked.
@@ -3377,6 +3484,34 @@ func (n *RuntimeLuaNakamaModule) unlinkGoogle(l *lua.LState) int {
 	return 0
 }
 
+// @group authenticate
+// @summary Unlink an OpenID Connect provider's identity from a user ID.
+// @param userId(type=string) The user ID to be unlinked.
+// @param provider(type=string) The name of the OpenID Connect provider in the server configuration.
+// @param token(type=string, optional=true) OpenID Connect ID token.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) unlinkOidc(l *lua.LState) int {
+	userID := l.CheckString(1)
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		l.ArgError(1, "user ID must be a valid identifier")
+		return 0
+	}
+
+	provider := l.CheckString(2)
+	if provider == "" {
+		l.ArgError(2, "expects provider string")
+		return 0
+	}
+
+	token := l.OptString(3, "")
+
+	if err := UnlinkOidc(l.Context(), n.logger, n.db, n.socialClient, id, provider, token); err != nil {
+		l.RaiseError("error unlinking: %v", err.Error())
+	}
+	return 0
+}
+
 // @group authenticate
 // @summary Unlink Steam authentication from a user ID.
 // @param userId(type=string) The user ID to be unlinked.
@@ -4570,6 +4705,32 @@ func (n *RuntimeLuaNakamaModule) sessionLogout(l *lua.LState) int {
 	return 0
 }
 
+// @group sessions
+// @summary Get the number of requests a user made today to each API method or RPC with a configured daily quota. Counts reset at midnight UTC.
+// @param userId(type=string) The ID of the user to get quota counts for.
+// @return quotas(table) Request counts keyed by API method name, or "rpc:" followed by the RPC ID.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) apiQuotaGet(l *lua.LState) int {
+	userIDString := l.CheckString(1)
+	if userIDString == "" {
+		l.ArgError(1, "expects user id")
+		return 0
+	}
+	userID, err := uuid.FromString(userIDString)
+	if err != nil {
+		l.ArgError(1, "expects valid user id")
+		return 0
+	}
+
+	quotas := n.apiLimiter.Quotas(userID)
+	quotasTable := l.CreateTable(0, len(quotas))
+	for name, count := range quotas {
+		quotasTable.RawSetString(name, lua.LNumber(count))
+	}
+	l.Push(quotasTable)
+	return 1
+}
+
 // @group matches
 // @summary Create a new authoritative realtime multiplayer match running on the given runtime module name. The given params are passed to the match's init hook.
 // @param module(type=string) The name of an available runtime module that will be responsible for the match. This was registered in InitModule.
@@ -4672,6 +4833,85 @@ func (n *RuntimeLuaNakamaModule) matchSignal(l *lua.LState) int {
 	return 1
 }
 
+// @group matches
+// @summary Ask the matchmaker for players to fill open slots in a running authoritative match. Matched players are sent the match ID directly instead of forming a new matchmaker group. The request is withdrawn automatically when the match ends.
+// @param id(type=string) The ID of the authoritative match to fill. Must be running on this node.
+// @param query(type=string) The matchmaker query players must satisfy to fill the open slots.
+// @param count(type=number) The number of open slots to fill.
+// @param properties(type=table, optional=true) String and numeric properties describing the match, used when checking player queries.
+// @param queue(type=string, optional=true) The named matchmaker queue to take players from. Defaults to the default queue.
+// @return ticket(string) The backfill ticket, used to withdraw the request.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) matchmakerBackfillAdd(l *lua.LState) int {
+	id := l.CheckString(1)
+	query := l.CheckString(2)
+	count := l.CheckInt(3)
+	if count < 1 {
+		l.ArgError(3, "expects count to be at least 1")
+		return 0
+	}
+
+	stringProperties := make(map[string]string)
+	numericProperties := make(map[string]float64)
+	if properties := l.OptTable(4, nil); properties != nil {
+		var conversionError bool
+		properties.ForEach(func(k lua.LValue, v lua.LValue) {
+			if conversionError {
+				return
+			}
+
+			if k.Type() != lua.LTString {
+				conversionError = true
+				l.ArgError(4, "expects properties keys to be strings")
+				return
+			}
+
+			switch v.Type() {
+			case lua.LTString:
+				stringProperties[k.String()] = v.String()
+			case lua.LTNumber:
+				numericProperties[k.String()] = float64(v.(lua.LNumber))
+			default:
+				conversionError = true
+				l.ArgError(4, "expects properties values to be strings or numbers")
+			}
+		})
+		if conversionError {
+			return 0
+		}
+	}
+
+	queue := l.OptString(5, MatchmakerDefaultQueue)
+
+	ticket, err := n.matchRegistry.MatchmakerBackfillAdd(l.Context(), id, queue, query, count, stringProperties, numericProperties)
+	if err != nil {
+		l.RaiseError(fmt.Sprintf("failed to add matchmaker backfill: %s", err.Error()))
+		return 0
+	}
+
+	l.Push(lua.LString(ticket))
+	return 1
+}
+
+// @group matches
+// @summary Withdraw a backfill request made by a running authoritative match.
+// @param id(type=string) The ID of the authoritative match that made the request.
+// @param ticket(type=string) The backfill ticket to withdraw.
+// @return error(error) An optional error value if an error occurred.
+func (n *RuntimeLuaNakamaModule) matchmakerBackfillRemove(l *lua.LState) int {
+	id := l.CheckString(1)
+	ticket := l.CheckString(2)
+	if ticket == "" {
+		l.ArgError(2, "expects ticket to be a non-empty string")
+		return 0
+	}
+
+	if err := n.matchRegistry.MatchmakerBackfillRemove(l.Context(), id, ticket); err != nil {
+		l.RaiseError(fmt.Sprintf("failed to remove matchmaker backfill: %s", err.Error()))
+	}
+	return 0
+}
+
 // @group matches
 // @summary List currently running realtime multiplayer matches and optionally filter them by authoritative mode, label, and current participant count.
 // @param limit(type=number, optional=true, default=1) The maximum number of matches to list.
@@ -4680,7 +4920,10 @@ func (n *RuntimeLuaNakamaModule) matchSignal(l *lua.LState) int {
 // @param minSize(type=number, optional=true) Inclusive lower limit of current match participants.
 // @param maxSize(type=number, optional=true) Inclusive upper limit of current match participants.
 // @param query(type=string, optional=true) Additional query parameters to shortlist matches.
+// @param sort(type=table, optional=true) Fields to sort authoritative matches by, "create_time", "tick_rate", "handler_name" or "label.<field>", prefixed with "-" for descending order. Relayed matches are not listed when set.
+// @param cursor(type=string, optional=true) Pagination cursor from a previous listing with the same filters and sort order. Relayed matches are not listed when set.
 // @return match(table) A table of matches matching the parameters criteria.
+// @return cursor(string) A cursor to fetch the next page of authoritative matches, or nil if there are no more.
 // @return error(error) An optional error value if an error occurred.
 func (n *RuntimeLuaNakamaModule) matchList(l *lua.LState) int {
 	// Parse limit.
@@ -4735,7 +4978,33 @@ func (n *RuntimeLuaNakamaModule) matchList(l *lua.LState) int {
 		query = &wrapperspb.StringValue{Value: lua.LVAsString(v)}
 	}
 
-	results, _, err := n.matchRegistry.ListMatches(l.Context(), limit, authoritative, label, minSize, maxSize, query, nil)
+	var sort []string
+	if v := l.Get(7); v.Type() != lua.LTNil {
+		sortTable, ok := v.(*lua.LTable)
+		if !ok {
+			l.ArgError(7, "expects sort table or nil")
+			return 0
+		}
+		var conversionError bool
+		sortTable.ForEach(func(k lua.LValue, v lua.LValue) {
+			if conversionError {
+				return
+			}
+			if v.Type() != lua.LTString {
+				l.ArgError(7, "sort fields must be strings")
+				conversionError = true
+				return
+			}
+			sort = append(sort, v.String())
+		})
+		if conversionError {
+			return 0
+		}
+	}
+
+	cursor := l.OptString(8, "")
+
+	results, _, nextCursor, err := n.matchRegistry.ListMatches(l.Context(), limit, authoritative, label, minSize, maxSize, query, nil, sort, cursor)
 	if err != nil {
 		l.RaiseError(fmt.Sprintf("failed to list matches: %s", err.Error()))
 		return 0
@@ -4765,7 +5034,14 @@ func (n *RuntimeLuaNakamaModule) matchList(l *lua.LState) int {
 		matches.RawSetInt(i+1, match)
 	}
 	l.Push(matches)
-	return 1
+
+	if nextCursor != "" {
+		l.Push(lua.LString(nextCursor))
+	} else {
+		l.Push(lua.LNil)
+	}
+
+	return 2
 }
 
 // @group notifications
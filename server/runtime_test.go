@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"io"
@@ -24,10 +25,15 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/heroiclabs/nakama-common/api"
 	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -76,7 +82,7 @@ func runtimeWithModules(t *testing.T, modules map[string]string) (*Runtime, *Run
 	cfg := NewConfig(logger)
 	cfg.Runtime.Path = dir
 
-	return NewRuntime(context.Background(), logger, logger, NewDB(t), protojsonMarshaler, protojsonUnmarshaler, cfg, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, metrics, nil, &DummyMessageRouter{})
+	return NewRuntime(context.Background(), logger, logger, NewDB(t), protojsonMarshaler, protojsonUnmarshaler, cfg, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, metrics, nil, &DummyMessageRouter{}, NewApiRateLimiter(cfg))
 }
 
 func TestRuntimeSampleScript(t *testing.T) {
@@ -353,8 +359,8 @@ nakama.register_rpc(test.printWorld, "helloworld")`,
 	}
 
 	db := NewDB(t)
-	pipeline := NewPipeline(logger, cfg, db, protojsonMarshaler, protojsonUnmarshaler, nil, nil, nil, nil, nil, nil, nil, runtime)
-	apiServer := StartApiServer(logger, logger, db, protojsonMarshaler, protojsonUnmarshaler, cfg, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, metrics, pipeline, runtime)
+	pipeline := NewPipeline(logger, cfg, db, protojsonMarshaler, protojsonUnmarshaler, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtime)
+	apiServer := StartApiServer(logger, logger, db, protojsonMarshaler, protojsonUnmarshaler, cfg, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, metrics, pipeline, NewApiRateLimiter(cfg), newServerKeyRegistryTest(cfg), runtime)
 	defer apiServer.Stop()
 
 	payload := "\"Hello World\""
@@ -1018,3 +1024,214 @@ nk.group_delete(group.id)`,
 		t.Fatal(err.Error())
 	}
 }
+
+// should register OpenID Connect authentication hooks from Lua, JavaScript and Go modules
+func TestRuntimeAuthenticateOidcHooks(t *testing.T) {
+	newRuntime := func(files map[string]string, jsEntrypoint string) *Runtime {
+		t.Helper()
+		cfg := NewConfig(logger)
+		cfg.Runtime.Path = t.TempDir()
+		cfg.Runtime.JsEntrypoint = jsEntrypoint
+		for name, data := range files {
+			if err := os.WriteFile(filepath.Join(cfg.Runtime.Path, name), []byte(data), 0644); err != nil {
+				t.Fatalf("error writing module: %v", err)
+			}
+		}
+		runtime, _, err := NewRuntime(context.Background(), logger, logger, nil, protojsonMarshaler, protojsonUnmarshaler, cfg, "",
+			nil, nil, nil, nil, &testSessionRegistry{}, nil, nil, nil, &testTracker{}, &testMetrics{}, nil, &testMessageRouter{}, nil)
+		if err != nil {
+			t.Fatalf("error creating runtime: %v", err)
+		}
+		return runtime
+	}
+	checkHooks := func(name string, runtime *Runtime) {
+		t.Helper()
+		in := &apigrpc.AuthenticateOidcRequest{Account: &apigrpc.AccountOidc{Provider: "sso", Token: "token"}}
+		result, err, _ := runtime.BeforeAuthenticateOidc()(context.Background(), logger, "", "", nil, 0, "", "", in)
+		if err != nil || result.Username != "hooked" || result.Account.Provider != "sso" {
+			t.Fatalf("expected %v before hook to set the username, got %v %v", name, result, err)
+		}
+		if runtime.AfterAuthenticateOidc() == nil {
+			t.Fatalf("expected %v after hook to be registered", name)
+		}
+	}
+
+	checkHooks("Lua", newRuntime(map[string]string{"oidc.lua": `
+local nk = require("nakama")
+nk.register_req_before(function(ctx, payload)
+	payload.username = "hooked"
+	return payload
+end, "AuthenticateOidc")
+nk.register_req_after(function(ctx, payload) end, "AuthenticateOidc")`}, ""))
+
+	checkHooks("JavaScript", newRuntime(map[string]string{"index.js": `
+function InitModule(ctx, logger, nk, initializer) {
+	initializer.registerBeforeAuthenticateOidc(beforeAuthenticateOidc);
+	initializer.registerAfterAuthenticateOidc(afterAuthenticateOidc);
+}
+function beforeAuthenticateOidc(ctx, logger, nk, data) {
+	data.username = "hooked";
+	return data;
+}
+function afterAuthenticateOidc(ctx, logger, nk, data, request) {}`}, "index.js"))
+
+	// Go modules reach the hooks with a type assertion on the initializer they are given.
+	var initializer runtime.Initializer = &RuntimeGoInitializer{logger: NewRuntimeGoLogger(logger), beforeReq: &RuntimeBeforeReqFunctions{}, afterReq: &RuntimeAfterReqFunctions{}}
+	oidcInitializer, ok := initializer.(interface {
+		RegisterBeforeAuthenticateOidc(fn func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, in *apigrpc.AuthenticateOidcRequest) (*apigrpc.AuthenticateOidcRequest, error)) error
+		RegisterAfterAuthenticateOidc(fn func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, out *api.Session, in *apigrpc.AuthenticateOidcRequest) error) error
+	})
+	if !ok {
+		t.Fatal("expected initializer to implement OIDC hook registration")
+	}
+	_ = oidcInitializer.RegisterBeforeAuthenticateOidc(func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, in *apigrpc.AuthenticateOidcRequest) (*apigrpc.AuthenticateOidcRequest, error) {
+		in.Username = "hooked"
+		return in, nil
+	})
+	_ = oidcInitializer.RegisterAfterAuthenticateOidc(func(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, out *api.Session, in *apigrpc.AuthenticateOidcRequest) error {
+		return nil
+	})
+	goInitializer := initializer.(*RuntimeGoInitializer)
+	checkHooks("Go", &Runtime{beforeReqFunctions: goInitializer.beforeReq, afterReqFunctions: goInitializer.afterReq})
+}
+
+type spectatorTestMatch struct {
+	testMatch
+}
+
+func (m *spectatorTestMatch) MatchLoop(ctx context.Context, logger runtime.Logger, db *sql.DB, nk runtime.NakamaModule, dispatcher runtime.MatchDispatcher, tick int64, state interface{}, messages []runtime.MatchData) interface{} {
+	// Modules reach the spectator broadcast with a type assertion on the dispatcher.
+	spectatorDispatcher, ok := dispatcher.(interface {
+		BroadcastSpectatorMessage(opCode int64, data []byte, presences []runtime.Presence, sender runtime.Presence, reliable bool) error
+	})
+	if !ok {
+		logger.Error("dispatcher does not support spectator broadcasts")
+		return state
+	}
+	presences := make([]runtime.Presence, 0)
+	for _, presence := range state.(*testMatchState).presences {
+		presences = append(presences, presence)
+	}
+	_ = spectatorDispatcher.BroadcastSpectatorMessage(7, []byte("all"), nil, nil, true)
+	_ = spectatorDispatcher.BroadcastSpectatorMessage(8, []byte("filtered"), presences, nil, true)
+	return state
+}
+
+// should let Lua, JavaScript and Go match handlers broadcast to spectators only
+func TestRuntimeMatchSpectatorBroadcast(t *testing.T) {
+	cfg := NewConfig(logger)
+	cfg.Runtime.Path = t.TempDir()
+	cfg.Runtime.JsEntrypoint = "index.js"
+	modules := map[string]string{
+		"spectate.lua": `
+local M = {}
+function M.match_init(context, params) return { presences = {} }, 1, "" end
+function M.match_join_attempt(context, dispatcher, tick, state, presence, metadata) return state, true end
+function M.match_join(context, dispatcher, tick, state, presences)
+	for _, presence in ipairs(presences) do table.insert(state.presences, presence) end
+	return state
+end
+function M.match_leave(context, dispatcher, tick, state, presences) return state end
+function M.match_loop(context, dispatcher, tick, state, messages)
+	dispatcher.broadcast_spectator_message(7, "all", nil, nil, true)
+	dispatcher.broadcast_spectator_message(8, "filtered", state.presences, nil, true)
+	return state
+end
+function M.match_terminate(context, dispatcher, tick, state, grace_seconds) return state end
+function M.match_signal(context, dispatcher, tick, state, data) return state, data end
+return M`,
+		"index.js": `
+function InitModule(ctx, logger, nk, initializer) {
+	initializer.registerMatch("spectatejs", {
+		matchInit: matchInit,
+		matchJoinAttempt: matchJoinAttempt,
+		matchJoin: matchJoin,
+		matchLeave: matchLeave,
+		matchLoop: matchLoop,
+		matchTerminate: matchTerminate,
+		matchSignal: matchSignal
+	});
+}
+function matchInit(ctx, logger, nk, params) { return { state: { presences: [] }, tickRate: 1, label: "" }; }
+function matchJoinAttempt(ctx, logger, nk, dispatcher, tick, state, presence, metadata) { return { state: state, accept: true }; }
+function matchJoin(ctx, logger, nk, dispatcher, tick, state, presences) {
+	state.presences = state.presences.concat(presences);
+	return { state: state };
+}
+function matchLeave(ctx, logger, nk, dispatcher, tick, state, presences) { return { state: state }; }
+function matchLoop(ctx, logger, nk, dispatcher, tick, state, messages) {
+	dispatcher.broadcastSpectatorMessage(7, "all", null, null, true);
+	dispatcher.broadcastSpectatorMessage(8, "filtered", state.presences, null, true);
+	return { state: state };
+}
+function matchTerminate(ctx, logger, nk, dispatcher, tick, state, graceSeconds) { return { state: state }; }
+function matchSignal(ctx, logger, nk, dispatcher, tick, state, data) { return { state: state, data: data }; }`,
+	}
+	for name, data := range modules {
+		if err := os.WriteFile(filepath.Join(cfg.Runtime.Path, name), []byte(data), 0644); err != nil {
+			t.Fatalf("error writing module: %v", err)
+		}
+	}
+
+	recipientsCh := make(chan string, 10)
+	router := &testMessageRouter{sendToPresence: func(presences []*PresenceID, envelope *rtapi.Envelope) {
+		if data := envelope.GetMatchData(); data != nil {
+			sessionIDs := make([]string, 0, len(presences))
+			for _, presence := range presences {
+				sessionIDs = append(sessionIDs, presence.SessionID.String())
+			}
+			recipientsCh <- fmt.Sprintf("%v %s %v", data.OpCode, data.Data, sessionIDs)
+		}
+	}}
+	matchRegistry := NewLocalMatchRegistry(logger, logger, cfg, &testSessionRegistry{}, &testTracker{}, router, nil, &testMetrics{}, "node")
+	defer matchRegistry.Stop(0)
+	runtime, _, err := NewRuntime(context.Background(), logger, logger, nil, protojsonMarshaler, protojsonUnmarshaler, cfg, "",
+		nil, nil, nil, nil, &testSessionRegistry{}, nil, nil, matchRegistry, &testTracker{}, &testMetrics{}, nil, router, nil)
+	if err != nil {
+		t.Fatalf("error creating runtime: %v", err)
+	}
+
+	goCreateFn := func(ctx context.Context, logger *zap.Logger, id uuid.UUID, node string, stopped *atomic.Bool, router MessageRouter, name string) (RuntimeMatchCore, error) {
+		return NewRuntimeGoMatchCore(logger, name, matchRegistry, router, id, node, "", stopped, nil, map[string]string{}, nil, &spectatorTestMatch{})
+	}
+
+	for _, test := range []struct {
+		module   string
+		createFn RuntimeMatchCreateFunction
+	}{
+		{"spectate", runtime.MatchCreateFunction()},
+		{"spectatejs", runtime.MatchCreateFunction()},
+		{"spectatego", goCreateFn},
+	} {
+		id := uuid.Must(uuid.NewV4())
+		stopped := atomic.NewBool(false)
+		core, err := test.createFn(context.Background(), logger, id, "node", stopped, router, test.module)
+		if err != nil || core == nil {
+			t.Fatalf("error creating %v match core: %v", test.module, err)
+		}
+		mh, err := NewSteppedMatchHandler(logger, cfg, &testSessionRegistry{}, matchRegistry, router, nil, &testMetrics{}, core, id, "node", stopped, map[string]interface{}{})
+		if err != nil {
+			t.Fatalf("error creating %v match: %v", test.module, err)
+		}
+
+		player := &MatchPresence{Node: "node", UserID: uuid.Must(uuid.NewV4()), SessionID: uuid.Must(uuid.NewV4()), Username: "player"}
+		spectator := &MatchPresence{Node: "node", UserID: uuid.Must(uuid.NewV4()), SessionID: uuid.Must(uuid.NewV4()), Username: "spectator", Spectator: true}
+		mh.QueueJoin([]*MatchPresence{player, spectator}, false)
+		mh.QueueLoop()
+
+		for _, expected := range []string{
+			fmt.Sprintf("7 all [%v]", spectator.SessionID),
+			fmt.Sprintf("8 filtered [%v]", spectator.SessionID),
+		} {
+			select {
+			case recipients := <-recipientsCh:
+				if recipients != expected {
+					t.Fatalf("expected %v broadcast %q, got %q", test.module, expected, recipients)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("expected %v broadcast %q", test.module, expected)
+			}
+		}
+		mh.Stop()
+	}
+}
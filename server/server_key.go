@@ -0,0 +1,518 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"go.uber.org/zap"
+)
+
+const (
+	// Name recorded for sessions authenticated with the socket server key from the config.
+	ServerKeyNameDefault = "default"
+	// Name recorded for RPCs called with the runtime HTTP key from the config.
+	ServerKeyNameHttp = "http"
+
+	// Session var holding the name of the server key a session was authenticated with.
+	SessionVarServerKey = "server_key_name"
+
+	serverKeyWildcard = "*"
+	serverKeyMaxLen   = 128
+
+	// How often keys are reloaded from the database, to pick up changes not made through this node.
+	serverKeyRefreshInterval = 30 * time.Second
+)
+
+var (
+	ErrServerKeyInvalid    = errors.New("server key invalid")
+	ErrServerKeyNotAllowed = errors.New("server key not allowed")
+	ErrServerKeyNotFound   = errors.New("server key not found")
+	ErrServerKeyName       = errors.New("server key name invalid")
+	ErrServerKeyReserved   = errors.New("server key name reserved")
+	ErrServerKeyInUse      = errors.New("server key already in use")
+	ErrServerKeyMethod     = errors.New("server key authenticate method invalid")
+)
+
+// API methods that are authorized by a server key rather than a session token.
+var serverKeyMethods = map[string]struct{}{
+	"SessionRefresh":                  {},
+	"AuthenticateApple":               {},
+	"AuthenticateCustom":              {},
+	"AuthenticateDevice":              {},
+	"AuthenticateEmail":               {},
+	"AuthenticateFacebook":            {},
+	"AuthenticateFacebookInstantGame": {},
+	"AuthenticateGameCenter":          {},
+	"AuthenticateGoogle":              {},
+	"AuthenticateOidc":                {},
+	"AuthenticateSteam":               {},
+}
+
+// ServerKey is a named key clients may use in place of the server key or runtime HTTP key in the config, limited to
+// some authenticate methods and RPC IDs. Only a hash of the key is stored.
+type ServerKey struct {
+	Name string
+	// Only set when the key is written or rotated, otherwise it cannot be recovered from its hash.
+	Key                 string
+	Enabled             bool
+	AuthenticateMethods []string
+	RpcIDs              []string
+	// Zero if the key never expires.
+	ExpireTime time.Time
+	CreateTime time.Time
+	UpdateTime time.Time
+
+	keyHash string
+}
+
+func (k *ServerKey) copy() *ServerKey {
+	c := *k
+	c.AuthenticateMethods = append([]string{}, k.AuthenticateMethods...)
+	c.RpcIDs = append([]string{}, k.RpcIDs...)
+	return &c
+}
+
+// Copy the key without its plain text value, as held in memory.
+func (k *ServerKey) stored() *ServerKey {
+	c := k.copy()
+	c.Key = ""
+	return c
+}
+
+func (k *ServerKey) valid(now time.Time) bool {
+	return k.Enabled && (k.ExpireTime.IsZero() || now.Before(k.ExpireTime))
+}
+
+func serverKeyAllows(allowed []string, name string) bool {
+	for _, a := range allowed {
+		if a == serverKeyWildcard || a == name {
+			return true
+		}
+	}
+	return false
+}
+
+type ServerKeyRegistry interface {
+	// ValidateServerKey checks a key sent to an authenticate or session refresh method, and returns its name.
+	ValidateServerKey(key, method string, now time.Time) (string, error)
+	// ValidateHttpKey checks a key sent to call an RPC function, and returns its name.
+	ValidateHttpKey(key, rpcID string, now time.Time) (string, error)
+
+	List() []*ServerKey
+	Write(ctx context.Context, key *ServerKey) (*ServerKey, error)
+	Rotate(ctx context.Context, name string) (*ServerKey, error)
+	Delete(ctx context.Context, name string) error
+	Refresh(ctx context.Context) error
+	Stop()
+}
+
+type LocalServerKeyRegistry struct {
+	sync.RWMutex
+	logger *zap.Logger
+	db     *sql.DB
+	config Config
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	// Serializes writes and refreshes, which update the database or read from it before the keys held in memory.
+	writeMu sync.Mutex
+	// Keyed by key hash.
+	keys  map[string]*ServerKey
+	names map[string]*ServerKey
+}
+
+func NewLocalServerKeyRegistry(logger, startupLogger *zap.Logger, db *sql.DB, config Config) ServerKeyRegistry {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	r := &LocalServerKeyRegistry{
+		logger: logger,
+		db:     db,
+		config: config,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		keys:  make(map[string]*ServerKey),
+		names: make(map[string]*ServerKey),
+	}
+
+	if err := r.Refresh(ctx); err != nil {
+		startupLogger.Fatal("Error loading server keys from database", zap.Error(err))
+	}
+
+	go func() {
+		ticker := time.NewTicker(serverKeyRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// Errors are logged, and the keys already held are kept until the next refresh.
+				_ = r.Refresh(ctx)
+			}
+		}
+	}()
+
+	return r
+}
+
+func (r *LocalServerKeyRegistry) Stop() {
+	r.ctxCancelFn()
+}
+
+func (r *LocalServerKeyRegistry) ValidateServerKey(key, method string, now time.Time) (string, error) {
+	if key == r.config.GetSocket().ServerKey {
+		return ServerKeyNameDefault, nil
+	}
+
+	keyHash := hashServerKey(key)
+	r.RLock()
+	defer r.RUnlock()
+	k, found := r.keys[keyHash]
+	if !found || !k.valid(now) {
+		return "", ErrServerKeyInvalid
+	}
+	if !serverKeyAllows(k.AuthenticateMethods, method) {
+		return "", ErrServerKeyNotAllowed
+	}
+	return k.Name, nil
+}
+
+func (r *LocalServerKeyRegistry) ValidateHttpKey(key, rpcID string, now time.Time) (string, error) {
+	if key == r.config.GetRuntime().HTTPKey {
+		return ServerKeyNameHttp, nil
+	}
+
+	keyHash := hashServerKey(key)
+	r.RLock()
+	defer r.RUnlock()
+	k, found := r.keys[keyHash]
+	if !found || !k.valid(now) {
+		return "", ErrServerKeyInvalid
+	}
+	if !serverKeyAllows(k.RpcIDs, strings.ToLower(rpcID)) {
+		return "", ErrServerKeyNotAllowed
+	}
+	return k.Name, nil
+}
+
+func (r *LocalServerKeyRegistry) List() []*ServerKey {
+	r.RLock()
+	keys := make([]*ServerKey, 0, len(r.names))
+	for _, k := range r.names {
+		keys = append(keys, k.copy())
+	}
+	r.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return keys
+}
+
+// Write creates or updates a key by name. A new key is generated if none is given on create, and the existing key is
+// kept if none is given on update. The key is only returned if it was given or generated.
+func (r *LocalServerKeyRegistry) Write(ctx context.Context, key *ServerKey) (*ServerKey, error) {
+	if key.Name == "" || len(key.Name) > serverKeyMaxLen {
+		return nil, ErrServerKeyName
+	}
+	if key.Name == ServerKeyNameDefault || key.Name == ServerKeyNameHttp {
+		return nil, ErrServerKeyReserved
+	}
+	for _, method := range key.AuthenticateMethods {
+		if _, found := serverKeyMethods[method]; !found && method != serverKeyWildcard {
+			return nil, ErrServerKeyMethod
+		}
+	}
+	k := key.copy()
+	for i, rpcID := range k.RpcIDs {
+		// RPC IDs are not case sensitive.
+		k.RpcIDs[i] = strings.ToLower(rpcID)
+	}
+
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	if k.Key == "" {
+		r.RLock()
+		existing, found := r.names[k.Name]
+		r.RUnlock()
+		if found {
+			k.keyHash = existing.keyHash
+		} else {
+			k.Key = generateServerKey()
+		}
+	}
+	if k.Key != "" {
+		if err := r.checkKey(k.Name, k.Key); err != nil {
+			return nil, err
+		}
+		k.keyHash = hashServerKey(k.Key)
+	}
+
+	if err := r.upsert(ctx, k); err != nil {
+		return nil, err
+	}
+	return k.copy(), nil
+}
+
+func (r *LocalServerKeyRegistry) Rotate(ctx context.Context, name string) (*ServerKey, error) {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	r.RLock()
+	existing, found := r.names[name]
+	r.RUnlock()
+	if !found {
+		return nil, ErrServerKeyNotFound
+	}
+
+	k := existing.copy()
+	k.Key = generateServerKey()
+	k.keyHash = hashServerKey(k.Key)
+	if err := r.upsert(ctx, k); err != nil {
+		return nil, err
+	}
+	return k.copy(), nil
+}
+
+func (r *LocalServerKeyRegistry) Delete(ctx context.Context, name string) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	r.RLock()
+	existing, found := r.names[name]
+	r.RUnlock()
+	if !found {
+		return ErrServerKeyNotFound
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM server_key WHERE name = $1", name); err != nil {
+		r.logger.Error("Error deleting server key", zap.String("name", name), zap.Error(err))
+		return err
+	}
+
+	r.Lock()
+	delete(r.keys, existing.keyHash)
+	delete(r.names, name)
+	r.Unlock()
+	return nil
+}
+
+// Refresh replaces the keys held in memory with those in the database.
+func (r *LocalServerKeyRegistry) Refresh(ctx context.Context) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	query := `
+SELECT name, key_hash, enabled, authenticate_methods, rpc_ids, expire_time, create_time, update_time
+FROM server_key`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		r.logger.Error("Error loading server keys from database", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	keys := make(map[string]*ServerKey)
+	names := make(map[string]*ServerKey)
+	for rows.Next() {
+		k := &ServerKey{}
+		var authenticateMethods, rpcIDs []byte
+		var expireTime, createTime, updateTime pgtype.Timestamptz
+		if err := rows.Scan(&k.Name, &k.keyHash, &k.Enabled, &authenticateMethods, &rpcIDs, &expireTime, &createTime, &updateTime); err != nil {
+			r.logger.Error("Error parsing server keys from database", zap.Error(err))
+			return err
+		}
+		if err := json.Unmarshal(authenticateMethods, &k.AuthenticateMethods); err != nil {
+			r.logger.Error("Error parsing server key authenticate methods", zap.String("name", k.Name), zap.Error(err))
+			return err
+		}
+		if err := json.Unmarshal(rpcIDs, &k.RpcIDs); err != nil {
+			r.logger.Error("Error parsing server key RPC IDs", zap.String("name", k.Name), zap.Error(err))
+			return err
+		}
+		if expireTime.Time.Unix() != 0 {
+			k.ExpireTime = expireTime.Time
+		}
+		k.CreateTime = createTime.Time
+		k.UpdateTime = updateTime.Time
+		keys[k.keyHash] = k
+		names[k.Name] = k
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error loading server keys from database", zap.Error(err))
+		return err
+	}
+
+	r.Lock()
+	r.keys = keys
+	r.names = names
+	r.Unlock()
+	return nil
+}
+
+// Reject keys that are invalid, or belong to another named key or the config.
+func (r *LocalServerKeyRegistry) checkKey(name, key string) error {
+	if len(key) > serverKeyMaxLen {
+		return ErrServerKeyInvalid
+	}
+	if key == r.config.GetSocket().ServerKey || key == r.config.GetRuntime().HTTPKey {
+		return ErrServerKeyInUse
+	}
+	r.RLock()
+	defer r.RUnlock()
+	if k, found := r.keys[hashServerKey(key)]; found && k.Name != name {
+		return ErrServerKeyInUse
+	}
+	return nil
+}
+
+// Store the key in the database then in memory, must be called while holding the write lock.
+func (r *LocalServerKeyRegistry) upsert(ctx context.Context, k *ServerKey) error {
+	authenticateMethods, err := json.Marshal(k.AuthenticateMethods)
+	if err != nil {
+		return err
+	}
+	rpcIDs, err := json.Marshal(k.RpcIDs)
+	if err != nil {
+		return err
+	}
+	expireTime := time.Unix(0, 0).UTC()
+	if !k.ExpireTime.IsZero() {
+		expireTime = k.ExpireTime
+	}
+
+	query := `
+INSERT INTO server_key (name, key_hash, enabled, authenticate_methods, rpc_ids, expire_time)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (name) DO UPDATE SET key_hash = $2, enabled = $3, authenticate_methods = $4, rpc_ids = $5, expire_time = $6, update_time = now()
+RETURNING create_time, update_time`
+	var createTime, updateTime pgtype.Timestamptz
+	if err := r.db.QueryRowContext(ctx, query, k.Name, k.keyHash, k.Enabled, authenticateMethods, rpcIDs, expireTime).Scan(&createTime, &updateTime); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == dbErrorUniqueViolation {
+			return ErrServerKeyInUse
+		}
+		r.logger.Error("Error writing server key", zap.String("name", k.Name), zap.Error(err))
+		return err
+	}
+	k.CreateTime = createTime.Time
+	k.UpdateTime = updateTime.Time
+
+	stored := k.stored()
+	r.Lock()
+	if existing, found := r.names[k.Name]; found {
+		delete(r.keys, existing.keyHash)
+	}
+	r.keys[stored.keyHash] = stored
+	r.names[stored.Name] = stored
+	r.Unlock()
+	return nil
+}
+
+// Keys are generated with enough entropy that a fast unsalted hash is enough to protect them, and lets them be found
+// by hash when validating.
+func hashServerKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+func generateServerKey() string {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		// Only fails if the system cannot supply randomness at all.
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Record the name of the server key used in the vars of the session being created, replacing any value the client
+// sent for it.
+func setServerKeySessionVar(req interface{}, name string) {
+	var vars *map[string]string
+	switch in := req.(type) {
+	case *api.SessionRefreshRequest:
+		if in.Vars == nil {
+			// Vars are kept from the refresh token, which already record the key it was created with.
+			return
+		}
+		vars = &in.Vars
+	case *api.AuthenticateAppleRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateCustomRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateDeviceRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateEmailRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateFacebookRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateFacebookInstantGameRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateGameCenterRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateGoogleRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *apigrpc.AuthenticateOidcRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	case *api.AuthenticateSteamRequest:
+		if in.Account != nil {
+			vars = &in.Account.Vars
+		}
+	}
+	if vars == nil {
+		return
+	}
+	if *vars == nil {
+		*vars = make(map[string]string, 1)
+	}
+	(*vars)[SessionVarServerKey] = name
+}
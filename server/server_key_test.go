@@ -0,0 +1,147 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/heroiclabs/nakama-common/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server key registry holding only the given keys, without a database.
+func newServerKeyRegistryTest(config Config, keys ...*ServerKey) *LocalServerKeyRegistry {
+	r := &LocalServerKeyRegistry{
+		logger: logger,
+		config: config,
+		keys:   make(map[string]*ServerKey, len(keys)),
+		names:  make(map[string]*ServerKey, len(keys)),
+	}
+	for _, k := range keys {
+		k = k.copy()
+		k.keyHash = hashServerKey(k.Key)
+		k = k.stored()
+		r.keys[k.keyHash] = k
+		r.names[k.Name] = k
+	}
+	return r
+}
+
+// should accept the config keys for everything, and named keys only while enabled, unexpired and in scope
+func TestServerKeyRegistryValidate(t *testing.T) {
+	config := NewConfig(logger)
+	now := time.Now()
+	r := newServerKeyRegistryTest(config,
+		&ServerKey{Name: "game", Key: "gamekey", Enabled: true, AuthenticateMethods: []string{"AuthenticateDevice", "SessionRefresh"}},
+		&ServerKey{Name: "partner", Key: "partnerkey", Enabled: true, RpcIDs: []string{"reward"}, ExpireTime: now.Add(time.Hour)},
+		&ServerKey{Name: "tools", Key: "toolskey", Enabled: true, AuthenticateMethods: []string{"*"}, RpcIDs: []string{"*"}},
+		&ServerKey{Name: "leaked", Key: "leakedkey", AuthenticateMethods: []string{"*"}},
+	)
+
+	if name, err := r.ValidateServerKey(config.GetSocket().ServerKey, "AuthenticateEmail", now); err != nil || name != ServerKeyNameDefault {
+		t.Fatalf("expected config server key to be accepted, got %v %v", name, err)
+	}
+	if name, err := r.ValidateHttpKey(config.GetRuntime().HTTPKey, "anything", now); err != nil || name != ServerKeyNameHttp {
+		t.Fatalf("expected config HTTP key to be accepted, got %v %v", name, err)
+	}
+
+	if name, err := r.ValidateServerKey("gamekey", "AuthenticateDevice", now); err != nil || name != "game" {
+		t.Fatalf("expected game key to be accepted, got %v %v", name, err)
+	}
+	if _, err := r.ValidateServerKey("gamekey", "AuthenticateEmail", now); err != ErrServerKeyNotAllowed {
+		t.Fatalf("expected game key to be refused for email, got %v", err)
+	}
+	if _, err := r.ValidateHttpKey("gamekey", "reward", now); err != ErrServerKeyNotAllowed {
+		t.Fatalf("expected game key to be refused for rpc, got %v", err)
+	}
+	if name, err := r.ValidateHttpKey("partnerkey", "Reward", now); err != nil || name != "partner" {
+		t.Fatalf("expected partner key to be accepted, got %v %v", name, err)
+	}
+	if _, err := r.ValidateHttpKey("partnerkey", "reward", now.Add(2*time.Hour)); err != ErrServerKeyInvalid {
+		t.Fatalf("expected expired partner key to be refused, got %v", err)
+	}
+	if name, err := r.ValidateServerKey("toolskey", "AuthenticateSteam", now); err != nil || name != "tools" {
+		t.Fatalf("expected tools key to be accepted, got %v %v", name, err)
+	}
+	if _, err := r.ValidateServerKey("leakedkey", "AuthenticateDevice", now); err != ErrServerKeyInvalid {
+		t.Fatalf("expected disabled key to be refused, got %v", err)
+	}
+	if _, err := r.ValidateServerKey("unknown", "AuthenticateDevice", now); err != ErrServerKeyInvalid {
+		t.Fatalf("expected unknown key to be refused, got %v", err)
+	}
+
+	// Invalid keys are refused before reaching the database.
+	if _, err := r.Write(context.Background(), &ServerKey{Name: ServerKeyNameDefault}); err != ErrServerKeyReserved {
+		t.Fatalf("expected reserved name to be refused, got %v", err)
+	}
+	if _, err := r.Write(context.Background(), &ServerKey{Name: "bad", AuthenticateMethods: []string{"ListFriends"}}); err != ErrServerKeyMethod {
+		t.Fatalf("expected non-authenticate method to be refused, got %v", err)
+	}
+	if _, err := r.Write(context.Background(), &ServerKey{Name: "copy", Key: "gamekey"}); err != ErrServerKeyInUse {
+		t.Fatalf("expected key in use to be refused, got %v", err)
+	}
+	if _, err := r.Rotate(context.Background(), "unknown"); err != ErrServerKeyNotFound {
+		t.Fatalf("expected unknown key rotation to be refused, got %v", err)
+	}
+
+	// Keys are held only as hashes, and cannot be listed.
+	for _, k := range r.List() {
+		if k.Key != "" || k.keyHash == "" {
+			t.Fatalf("expected key %v to be held only as a hash", k.Name)
+		}
+	}
+	if _, found := r.keys["gamekey"]; found {
+		t.Fatal("expected keys not to be held in plain text")
+	}
+}
+
+// should check the key sent to authenticate methods, and record its name in the session vars
+func TestServerKeySecurityInterceptor(t *testing.T) {
+	config := NewConfig(logger)
+	r := newServerKeyRegistryTest(config, &ServerKey{Name: "game", Key: "gamekey", Enabled: true, AuthenticateMethods: []string{"AuthenticateDevice"}})
+	authenticate := func(key, method string, req interface{}) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(key+":"))))
+		_, err := securityInterceptorFunc(logger, config, nil, r, ctx, req, &grpc.UnaryServerInfo{FullMethod: "/nakama.api.Nakama/" + method})
+		return err
+	}
+
+	req := &api.AuthenticateDeviceRequest{Account: &api.AccountDevice{Id: "device", Vars: map[string]string{SessionVarServerKey: "spoofed"}}}
+	if err := authenticate("gamekey", "AuthenticateDevice", req); err != nil {
+		t.Fatalf("expected game key to be accepted, got %v", err)
+	}
+	if name := req.Account.Vars[SessionVarServerKey]; name != "game" {
+		t.Fatalf("expected key name in session vars, got %v", name)
+	}
+
+	emailReq := &api.AuthenticateEmailRequest{Account: &api.AccountEmail{Email: "a@b.com"}}
+	if err := authenticate("gamekey", "AuthenticateEmail", emailReq); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	if err := authenticate("unknown", "AuthenticateEmail", emailReq); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+	if err := authenticate(config.GetSocket().ServerKey, "AuthenticateEmail", emailReq); err != nil {
+		t.Fatalf("expected config key to be accepted, got %v", err)
+	}
+	if name := emailReq.Account.Vars[SessionVarServerKey]; name != ServerKeyNameDefault {
+		t.Fatalf("expected default key name in session vars, got %v", name)
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	grpcproto "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcRawMessage is an envelope already encoded as protobuf, sent on a stream as is.
+type grpcRawMessage []byte
+
+// grpcRawCodec is the protobuf codec of the gRPC API server, which also sends messages that are already encoded.
+// Payloads encoded once to fan out to many sessions are then not decoded and encoded again for each one.
+type grpcRawCodec struct{}
+
+var grpcProtoCodec = encoding.GetCodec(grpcproto.Name)
+
+func (grpcRawCodec) Marshal(v interface{}) ([]byte, error) {
+	if raw, ok := v.(grpcRawMessage); ok {
+		return raw, nil
+	}
+	return grpcProtoCodec.Marshal(v)
+}
+
+func (grpcRawCodec) Unmarshal(data []byte, v interface{}) error {
+	return grpcProtoCodec.Unmarshal(data, v)
+}
+
+func (grpcRawCodec) Name() string {
+	return grpcProtoCodec.Name()
+}
+
+// sessionGRPC is a real-time session carried by a bidirectional gRPC stream. Envelopes are sent as stream messages,
+// and since gRPC streams have no control frames pings and pongs are exchanged as envelopes.
+type sessionGRPC struct {
+	sync.Mutex
+	logger     *zap.Logger
+	config     Config
+	id         uuid.UUID
+	userID     uuid.UUID
+	username   *atomic.String
+	vars       map[string]string
+	expiry     int64
+	clientIP   string
+	clientPort string
+	lang       string
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	pingPeriodDuration time.Duration
+	pongWaitDuration   time.Duration
+	writeWaitDuration  time.Duration
+
+	sessionRegistry SessionRegistry
+	statusRegistry  *StatusRegistry
+	matchmaker      Matchmaker
+	tracker         Tracker
+	metrics         Metrics
+	pipeline        *Pipeline
+	runtime         *Runtime
+
+	stopped                bool
+	stream                 apigrpc.NakamaRealtime_StreamServer
+	sendMu                 sync.Mutex
+	receivedMessageCounter int
+	pingTimer              *time.Timer
+	pongTimer              *time.Timer
+	pingTimerCAS           *atomic.Uint32
+	outgoingCh             chan interface{}
+	sendersWg              sync.WaitGroup
+	closedCh               chan struct{}
+}
+
+func NewSessionGRPC(logger *zap.Logger, config Config, sessionID, userID uuid.UUID, username string, vars map[string]string, expiry int64, clientIP, clientPort, lang string, stream apigrpc.NakamaRealtime_StreamServer, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, pipeline *Pipeline, runtime *Runtime) Session {
+	sessionLogger := logger.With(zap.String("uid", userID.String()), zap.String("sid", sessionID.String()))
+
+	sessionLogger.Info("New gRPC stream session connected")
+
+	// The session also ends if the stream does.
+	ctx, ctxCancelFn := context.WithCancel(stream.Context())
+
+	return &sessionGRPC{
+		logger:     sessionLogger,
+		config:     config,
+		id:         sessionID,
+		userID:     userID,
+		username:   atomic.NewString(username),
+		vars:       vars,
+		expiry:     expiry,
+		clientIP:   clientIP,
+		clientPort: clientPort,
+		lang:       lang,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		pingPeriodDuration: time.Duration(config.GetSocket().PingPeriodMs) * time.Millisecond,
+		pongWaitDuration:   time.Duration(config.GetSocket().PongWaitMs) * time.Millisecond,
+		writeWaitDuration:  time.Duration(config.GetSocket().WriteWaitMs) * time.Millisecond,
+
+		sessionRegistry: sessionRegistry,
+		statusRegistry:  statusRegistry,
+		matchmaker:      matchmaker,
+		tracker:         tracker,
+		metrics:         metrics,
+		pipeline:        pipeline,
+		runtime:         runtime,
+
+		stopped:                false,
+		stream:                 stream,
+		receivedMessageCounter: config.GetSocket().PingBackoffThreshold,
+		pingTimer:              time.NewTimer(time.Duration(config.GetSocket().PingPeriodMs) * time.Millisecond),
+		pingTimerCAS:           atomic.NewUint32(1),
+		outgoingCh:             make(chan interface{}, config.GetSocket().OutgoingQueueSize),
+		closedCh:               make(chan struct{}),
+	}
+}
+
+func (s *sessionGRPC) Logger() *zap.Logger {
+	return s.logger
+}
+
+func (s *sessionGRPC) ID() uuid.UUID {
+	return s.id
+}
+
+func (s *sessionGRPC) UserID() uuid.UUID {
+	return s.userID
+}
+
+func (s *sessionGRPC) ClientIP() string {
+	return s.clientIP
+}
+
+func (s *sessionGRPC) ClientPort() string {
+	return s.clientPort
+}
+
+func (s *sessionGRPC) Lang() string {
+	return s.lang
+}
+
+func (s *sessionGRPC) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sessionGRPC) Username() string {
+	return s.username.Load()
+}
+
+func (s *sessionGRPC) SetUsername(username string) {
+	s.username.Store(username)
+}
+
+func (s *sessionGRPC) Vars() map[string]string {
+	return s.vars
+}
+
+func (s *sessionGRPC) Expiry() int64 {
+	return s.expiry
+}
+
+// Consume blocks until the session is closed, the stream ends when the RPC handler calling it returns.
+func (s *sessionGRPC) Consume() {
+	// Fire an event for session start.
+	if fn := s.runtime.EventSessionStart(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.vars, s.expiry, s.id.String(), s.clientIP, s.clientPort, s.lang, time.Now().UTC().Unix())
+	}
+
+	// Stands in for a read deadline, the client must send a message or answer a ping before it fires.
+	s.Lock()
+	s.pongTimer = time.AfterFunc(s.pongWaitDuration, func() {
+		s.logger.Debug("Timed out waiting for client message")
+		s.Close("timed out waiting for client message", runtime.PresenceReasonDisconnect)
+	})
+	s.Unlock()
+
+	// Start a routine to process outbound messages.
+	s.sendersWg.Add(1)
+	go func() {
+		reason := s.processOutgoing()
+		// Closing waits for this routine, so mark it done first.
+		s.sendersWg.Done()
+		s.Close(reason, runtime.PresenceReasonDisconnect)
+	}()
+
+	// Read in a separate routine, a pending receive only returns once the stream itself ends.
+	go s.consume()
+
+	<-s.closedCh
+}
+
+func (s *sessionGRPC) consume() {
+	var reason string
+	var size int
+
+IncomingLoop:
+	for {
+		request, err := s.stream.Recv()
+		if err != nil {
+			// Ignore the client closing its side of the stream, and the stream ending with the session.
+			if err != io.EOF && status.Code(err) != codes.Canceled {
+				s.logger.Debug("Error reading message from client", zap.Error(err))
+				reason = err.Error()
+			}
+			break
+		}
+
+		size = proto.Size(request)
+		if size > int(s.config.GetSocket().MaxMessageSizeBytes) {
+			s.logger.Debug("Received message exceeding size limit", zap.Int("size", size))
+			reason = "received message exceeding size limit"
+			break
+		}
+
+		s.receivedMessageCounter--
+		if _, isPong := request.Message.(*rtapi.Envelope_Pong); isPong || s.receivedMessageCounter <= 0 {
+			s.receivedMessageCounter = s.config.GetSocket().PingBackoffThreshold
+			if !s.maybeResetPingTimer() {
+				// Problems resetting the ping timer indicate an error so we need to close the loop.
+				reason = "error updating ping timer"
+				break
+			}
+		}
+
+		switch request.Cid {
+		case "":
+			if !s.pipeline.ProcessRequest(s.logger, s, request) {
+				reason = "error processing message"
+				break IncomingLoop
+			}
+		default:
+			requestLogger := s.logger.With(zap.String("cid", request.Cid))
+			if !s.pipeline.ProcessRequest(requestLogger, s, request) {
+				reason = "error processing message"
+				break IncomingLoop
+			}
+		}
+
+		// Update incoming message metrics.
+		s.metrics.Message(int64(size), false)
+	}
+
+	if reason != "" {
+		// Update incoming message metrics.
+		s.metrics.Message(int64(size), true)
+	}
+
+	s.Close(reason, runtime.PresenceReasonDisconnect)
+}
+
+func (s *sessionGRPC) maybeResetPingTimer() bool {
+	// If there's already a reset in progress there's no need to wait.
+	if !s.pingTimerCAS.CompareAndSwap(1, 0) {
+		return true
+	}
+	defer s.pingTimerCAS.CompareAndSwap(0, 1)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.stopped {
+		return false
+	}
+	// CAS ensures concurrency is not a problem here.
+	if !s.pingTimer.Stop() {
+		select {
+		case <-s.pingTimer.C:
+		default:
+		}
+	}
+	s.pingTimer.Reset(s.pingPeriodDuration)
+	s.pongTimer.Reset(s.pongWaitDuration)
+	return true
+}
+
+// Send outgoing messages until the session closes or a send fails, and return the reason to close the session for.
+func (s *sessionGRPC) processOutgoing() string {
+	var reason string
+
+OutgoingLoop:
+	for {
+		select {
+		case <-s.ctx.Done():
+			// Session is closing, close the outgoing process routine.
+			break OutgoingLoop
+		case <-s.pingTimer.C:
+			// Periodically send pings.
+			if err := s.send(&rtapi.Envelope{Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}}); err != nil {
+				// If ping fails the session will be stopped, clean up the loop.
+				s.logger.Warn("Could not send ping", zap.Error(err))
+				reason = err.Error()
+				break OutgoingLoop
+			}
+		case msg := <-s.outgoingCh:
+			s.Lock()
+			stopped := s.stopped
+			s.Unlock()
+			if stopped {
+				// The session may have stopped between the envelope being queued on the outgoing channel and reaching here.
+				// If that's the case then abort outgoing processing at this point and exit.
+				break OutgoingLoop
+			}
+			// Process the outgoing message queue.
+			if err := s.send(msg); err != nil {
+				s.logger.Warn("Could not write message", zap.Error(err))
+				reason = err.Error()
+				break OutgoingLoop
+			}
+		}
+	}
+
+	return reason
+}
+
+// Write an envelope, or an already encoded one, to the stream, which allows only one sender at a time.
+func (s *sessionGRPC) send(msg interface{}) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	if err := s.stream.SendMsg(msg); err != nil {
+		return err
+	}
+
+	// Update outgoing message metrics.
+	var size int
+	switch msg := msg.(type) {
+	case *rtapi.Envelope:
+		size = proto.Size(msg)
+	case grpcRawMessage:
+		size = len(msg)
+	}
+	s.metrics.MessageBytesSent(int64(size), 0)
+	return nil
+}
+
+func (s *sessionGRPC) Format() SessionFormat {
+	return SessionFormatProtobuf
+}
+
+func (s *sessionGRPC) Send(envelope *rtapi.Envelope, reliable bool) error {
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		switch envelope.Message.(type) {
+		case *rtapi.Envelope_Error:
+			s.logger.Debug("Sending error message", zap.Any("envelope", envelope))
+		default:
+			s.logger.Debug(fmt.Sprintf("Sending %T message", envelope.Message), zap.Any("envelope", envelope))
+		}
+	}
+
+	return s.enqueue(envelope)
+}
+
+func (s *sessionGRPC) SendBytes(payload []byte, reliable bool) error {
+	// Payloads prepared for protobuf sessions are sent as they are, the stream codec does not encode them again.
+	return s.enqueue(grpcRawMessage(payload))
+}
+
+func (s *sessionGRPC) enqueue(msg interface{}) error {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return nil
+	}
+
+	// Attempt to queue messages and observe failures.
+	select {
+	case s.outgoingCh <- msg:
+		s.Unlock()
+		return nil
+	default:
+		// The outgoing queue is full, likely because the remote client can't keep up.
+		// Terminate the connection immediately because the only alternative that doesn't block the server is
+		// to start dropping messages, which might cause unexpected behaviour.
+		s.Unlock()
+		s.logger.Warn("Could not write message, session outgoing queue full")
+		s.Close(ErrSessionQueueFull.Error(), runtime.PresenceReasonDisconnect)
+		return ErrSessionQueueFull
+	}
+}
+
+func (s *sessionGRPC) Close(msg string, reason runtime.PresenceReason, envelopes ...*rtapi.Envelope) {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return
+	}
+	s.stopped = true
+	s.Unlock()
+
+	// Cancel any ongoing operations tied to this session.
+	s.ctxCancelFn()
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaning up closed client connection")
+	}
+
+	// When connection close originates internally in the session, ensure cleanup of external resources and references.
+	if err := s.matchmaker.RemoveSessionAll(s.id.String()); err != nil {
+		s.logger.Warn("Failed to remove all matchmaking tickets", zap.Error(err))
+	}
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection matchmaker")
+	}
+	s.tracker.UntrackAll(s.id, reason)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection tracker")
+	}
+	s.statusRegistry.UnfollowAll(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection status registry")
+	}
+	s.sessionRegistry.Remove(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection session registry")
+	}
+
+	// Clean up internals.
+	s.pingTimer.Stop()
+	s.Lock()
+	if s.pongTimer != nil {
+		s.pongTimer.Stop()
+	}
+	s.Unlock()
+	close(s.outgoingCh)
+
+	// Send final messages, if any are specified.
+	if len(envelopes) != 0 {
+		s.sendersWg.Add(1)
+		go func() {
+			defer s.sendersWg.Done()
+			for _, envelope := range envelopes {
+				if s.logger.Core().Enabled(zap.DebugLevel) {
+					s.logger.Debug(fmt.Sprintf("Sending %T message", envelope.Message), zap.Any("envelope", envelope))
+				}
+				if err := s.send(envelope); err != nil {
+					s.logger.Warn("Could not write message", zap.Error(err))
+					return
+				}
+			}
+		}()
+	}
+
+	// The stream must not be used once the RPC handler returns, so wait for the outgoing routine, stopped by the
+	// context cancellation, and any final messages. A send blocked by a client that stopped reading is only released
+	// when the stream ends, so give up waiting for it after the write wait.
+	sendersDone := make(chan struct{})
+	go func() {
+		s.sendersWg.Wait()
+		close(sendersDone)
+	}()
+	timer := time.NewTimer(s.writeWaitDuration)
+	select {
+	case <-sendersDone:
+	case <-timer.C:
+		s.logger.Warn("Timed out waiting for stream sends to complete")
+	}
+	timer.Stop()
+
+	// Release the RPC handler, which ends the stream.
+	close(s.closedCh)
+
+	s.logger.Info("Closed client connection")
+
+	// Fire an event for session end.
+	if fn := s.runtime.EventSessionEnd(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.vars, s.expiry, s.id.String(), s.clientIP, s.clientPort, s.lang, time.Now().UTC().Unix(), msg)
+	}
+}
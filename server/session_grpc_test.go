@@ -0,0 +1,237 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"github.com/heroiclabs/nakama/v3/apigrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Serve real-time streams for the test server, and return a client for them.
+func newRealtimeStreamTestClient(t *testing.T, server *socketTestServer) apigrpc.NakamaRealtimeClient {
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+	apiServer := &ApiServer{
+		logger:          server.logger,
+		config:          server.config,
+		sessionCache:    server.sessionCache,
+		sessionRegistry: server.sessionRegistry,
+		statusRegistry:  server.statusRegistry,
+		matchmaker:      server.matchmaker,
+		tracker:         server.tracker,
+		metrics:         server.metrics,
+		pipeline:        server.pipeline,
+		runtime:         server.runtime,
+		sessionIdGen:    uuid.NewGen(),
+		ctx:             ctx,
+		ctxCancelFn:     ctxCancelFn,
+	}
+
+	grpcServer := grpc.NewServer(grpc.ForceServerCodec(grpcRawCodec{}), grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ss, err := streamSecurityInterceptorFunc(server.logger, server.config, server.sessionCache, newServerKeyRegistryTest(server.config), srv, ss, info)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}))
+	apigrpc.RegisterNakamaRealtimeServer(grpcServer, apiServer)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %v", err)
+	}
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(func() {
+		apiServer.ctxCancelFn()
+		grpcServer.GracefulStop()
+	})
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("error dialing: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return apigrpc.NewNakamaRealtimeClient(conn)
+}
+
+// should process requests, deliver messages, ping the client and close the session once it stops answering
+func TestSessionGrpcStream(t *testing.T) {
+	server := newSocketTestServer(t, &testMetrics{})
+	server.config.GetSocket().PingPeriodMs = 100
+	server.config.GetSocket().PongWaitMs = 500
+	client := newRealtimeStreamTestClient(t, server)
+
+	stream, err := client.Stream(metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer invalid"))
+	if err != nil {
+		t.Fatalf("error opening stream: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected invalid token to be rejected, got %v", err)
+	}
+
+	stream, err = client.Stream(metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+server.newToken()))
+	if err != nil {
+		t.Fatalf("error opening stream: %v", err)
+	}
+
+	// Read envelopes until one that isn't a server ping, answering pings along the way.
+	pings := 0
+	recv := func() *rtapi.Envelope {
+		t.Helper()
+		for {
+			envelope, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("error receiving: %v", err)
+			}
+			if envelope.GetPing() == nil {
+				return envelope
+			}
+			pings++
+			if err := stream.Send(&rtapi.Envelope{Message: &rtapi.Envelope_Pong{Pong: &rtapi.Pong{}}}); err != nil {
+				t.Fatalf("error sending pong: %v", err)
+			}
+		}
+	}
+
+	if err := stream.Send(&rtapi.Envelope{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}}); err != nil {
+		t.Fatalf("error sending ping: %v", err)
+	}
+	if envelope := recv(); envelope.Cid != "1" || envelope.GetPong() == nil {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+
+	var session Session
+	server.sessionRegistry.(*LocalSessionRegistry).sessions.Range(func(_ uuid.UUID, s Session) bool {
+		session = s
+		return false
+	})
+	if session == nil || session.Format() != SessionFormatProtobuf {
+		t.Fatalf("expected protobuf session to be registered, got %v", session)
+	}
+
+	// Messages routed as protobuf bytes arrive as envelopes.
+	payload, err := proto.Marshal(&rtapi.Envelope{Message: &rtapi.Envelope_Notifications{Notifications: &rtapi.Notifications{
+		Notifications: []*api.Notification{{Id: "1", Subject: "subject"}},
+	}}})
+	if err != nil {
+		t.Fatalf("error marshalling notifications: %v", err)
+	}
+	if err := session.SendBytes(payload, true); err != nil {
+		t.Fatalf("error sending notifications: %v", err)
+	}
+	if envelope := recv(); envelope.GetNotifications().GetNotifications()[0].GetId() != "1" {
+		t.Fatalf("expected notifications, got %v", envelope)
+	}
+
+	// Stay connected for longer than the pong wait by answering pings.
+	for pings < 10 {
+		envelope, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("error receiving: %v", err)
+		}
+		if envelope.GetPing() == nil {
+			t.Fatalf("expected ping, got %v", envelope)
+		}
+		pings++
+		if err := stream.Send(&rtapi.Envelope{Message: &rtapi.Envelope_Pong{Pong: &rtapi.Pong{}}}); err != nil {
+			t.Fatalf("error sending pong: %v", err)
+		}
+	}
+	if err := stream.Send(&rtapi.Envelope{Cid: "2", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}}); err != nil {
+		t.Fatalf("error sending ping: %v", err)
+	}
+	if envelope := recv(); envelope.Cid != "2" {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+
+	// Without pongs the session is closed after the pong wait.
+	start := time.Now()
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("expected session to close after pong wait, took %v", elapsed)
+	}
+	if server.sessionRegistry.Get(session.ID()) != nil {
+		t.Fatal("expected session to be removed")
+	}
+}
+
+// should send final messages before the stream ends, and close the session once the client cancels its stream
+func TestSessionGrpcStreamClose(t *testing.T) {
+	server := newSocketTestServer(t, &testMetrics{})
+	client := newRealtimeStreamTestClient(t, server)
+
+	openSession := func(ctx context.Context) (apigrpc.NakamaRealtime_StreamClient, Session) {
+		t.Helper()
+		stream, err := client.Stream(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+server.newToken()))
+		if err != nil {
+			t.Fatalf("error opening stream: %v", err)
+		}
+		if err := stream.Send(&rtapi.Envelope{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}}); err != nil {
+			t.Fatalf("error sending ping: %v", err)
+		}
+		if envelope, err := stream.Recv(); err != nil || envelope.GetPong() == nil {
+			t.Fatalf("expected pong, got %v %v", envelope, err)
+		}
+		var session Session
+		server.sessionRegistry.(*LocalSessionRegistry).sessions.Range(func(_ uuid.UUID, s Session) bool {
+			session = s
+			return false
+		})
+		if session == nil {
+			t.Fatal("expected session to be registered")
+		}
+		return stream, session
+	}
+
+	stream, session := openSession(context.Background())
+	session.Close("", runtime.PresenceReasonDisconnect, &rtapi.Envelope{Cid: "2", Message: &rtapi.Envelope_Error{Error: &rtapi.Error{Message: "closing"}}})
+	if envelope, err := stream.Recv(); err != nil || envelope.GetError().GetMessage() != "closing" {
+		t.Fatalf("expected final message, got %v %v", envelope, err)
+	}
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("expected stream to end")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, session = openSession(ctx)
+	cancel()
+	select {
+	case <-session.Context().Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected session to close with its stream")
+	}
+	for i := 0; server.sessionRegistry.Get(session.ID()) != nil; i++ {
+		if i == 100 {
+			t.Fatal("expected session to be removed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// sessionSSEEvent is an outgoing envelope, named by its message type.
+type sessionSSEEvent struct {
+	name string
+	data []byte
+}
+
+// Name the Server-Sent Event for an envelope, or return an empty name if the message type is not streamed to
+// read-only clients. Names match the envelope JSON field names.
+func sessionSSEEventName(envelope *rtapi.Envelope) string {
+	switch envelope.Message.(type) {
+	case *rtapi.Envelope_Notifications:
+		return "notifications"
+	case *rtapi.Envelope_StatusPresenceEvent:
+		return "status_presence_event"
+	case *rtapi.Envelope_ChannelMessage:
+		return "channel_message"
+	case *rtapi.Envelope_StreamData:
+		return "stream_data"
+	default:
+		return ""
+	}
+}
+
+// sessionNamedSender is implemented by sessions that name each outgoing message. Fan-out passes the name alongside
+// the payload it has already encoded, rather than each recipient decoding the payload again to find it.
+type sessionNamedSender interface {
+	SendNamedBytes(name string, payload []byte, reliable bool) error
+}
+
+// Send a JSON payload encoded from the given envelope, naming it for sessions that need a name.
+func sessionSendJSON(session Session, envelope *rtapi.Envelope, payload []byte, reliable bool) error {
+	if named, ok := session.(sessionNamedSender); ok {
+		return named.SendNamedBytes(sessionSSEEventName(envelope), payload, reliable)
+	}
+	return session.SendBytes(payload, reliable)
+}
+
+// sessionSSE is a read-only session that streams real-time messages to the client as Server-Sent Events. Clients
+// use the HTTP API for everything else.
+type sessionSSE struct {
+	sync.Mutex
+	logger     *zap.Logger
+	config     Config
+	id         uuid.UUID
+	userID     uuid.UUID
+	username   *atomic.String
+	vars       map[string]string
+	expiry     int64
+	clientIP   string
+	clientPort string
+	lang       string
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	protojsonMarshaler   *protojson.MarshalOptions
+	protojsonUnmarshaler *protojson.UnmarshalOptions
+	pingPeriodDuration   time.Duration
+	writeWaitDuration    time.Duration
+
+	sessionRegistry SessionRegistry
+	statusRegistry  *StatusRegistry
+	matchmaker      Matchmaker
+	tracker         Tracker
+	metrics         Metrics
+	runtime         *Runtime
+
+	stopped    bool
+	conn       net.Conn
+	writer     *bufio.Writer
+	outgoingCh chan *sessionSSEEvent
+}
+
+func NewSessionSSE(logger *zap.Logger, config Config, sessionID, userID uuid.UUID, username string, vars map[string]string, expiry int64, clientIP, clientPort, lang string, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, conn net.Conn, writer *bufio.Writer, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, runtime *Runtime) Session {
+	sessionLogger := logger.With(zap.String("uid", userID.String()), zap.String("sid", sessionID.String()))
+
+	sessionLogger.Info("New SSE session connected")
+
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	return &sessionSSE{
+		logger:     sessionLogger,
+		config:     config,
+		id:         sessionID,
+		userID:     userID,
+		username:   atomic.NewString(username),
+		vars:       vars,
+		expiry:     expiry,
+		clientIP:   clientIP,
+		clientPort: clientPort,
+		lang:       lang,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		protojsonMarshaler:   protojsonMarshaler,
+		protojsonUnmarshaler: protojsonUnmarshaler,
+		pingPeriodDuration:   time.Duration(config.GetSocket().PingPeriodMs) * time.Millisecond,
+		writeWaitDuration:    time.Duration(config.GetSocket().WriteWaitMs) * time.Millisecond,
+
+		sessionRegistry: sessionRegistry,
+		statusRegistry:  statusRegistry,
+		matchmaker:      matchmaker,
+		tracker:         tracker,
+		metrics:         metrics,
+		runtime:         runtime,
+
+		stopped:    false,
+		conn:       conn,
+		writer:     writer,
+		outgoingCh: make(chan *sessionSSEEvent, config.GetSocket().OutgoingQueueSize),
+	}
+}
+
+func (s *sessionSSE) Logger() *zap.Logger {
+	return s.logger
+}
+
+func (s *sessionSSE) ID() uuid.UUID {
+	return s.id
+}
+
+func (s *sessionSSE) UserID() uuid.UUID {
+	return s.userID
+}
+
+func (s *sessionSSE) ClientIP() string {
+	return s.clientIP
+}
+
+func (s *sessionSSE) ClientPort() string {
+	return s.clientPort
+}
+
+func (s *sessionSSE) Lang() string {
+	return s.lang
+}
+
+func (s *sessionSSE) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sessionSSE) Username() string {
+	return s.username.Load()
+}
+
+func (s *sessionSSE) SetUsername(username string) {
+	s.username.Store(username)
+}
+
+func (s *sessionSSE) Vars() map[string]string {
+	return s.vars
+}
+
+func (s *sessionSSE) Expiry() int64 {
+	return s.expiry
+}
+
+func (s *sessionSSE) Consume() {
+	// Fire an event for session start.
+	if fn := s.runtime.EventSessionStart(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.vars, s.expiry, s.id.String(), s.clientIP, s.clientPort, s.lang, time.Now().UTC().Unix())
+	}
+
+	// Start a routine to write events and keep the connection alive.
+	go s.processOutgoing()
+
+	// Clients never send anything on the stream, reads only detect when the connection is closed.
+	var reason string
+	buf := make([]byte, 512)
+	for {
+		if _, err := s.conn.Read(buf); err != nil {
+			// Ignore the client going away, or the underlying connection being shut down while read is waiting.
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				s.logger.Debug("Error reading from client", zap.Error(err))
+				reason = err.Error()
+			}
+			break
+		}
+	}
+
+	s.Close(reason, runtime.PresenceReasonDisconnect)
+}
+
+func (s *sessionSSE) processOutgoing() {
+	var reason string
+
+	pingTicker := time.NewTicker(s.pingPeriodDuration)
+	defer pingTicker.Stop()
+
+OutgoingLoop:
+	for {
+		select {
+		case <-s.ctx.Done():
+			// Session is closing, close the outgoing process routine.
+			break OutgoingLoop
+		case <-pingTicker.C:
+			// Comment lines are ignored by clients, but keep proxies from timing out the stream and detect dead connections.
+			s.Lock()
+			err := s.write(":ping\n\n")
+			s.Unlock()
+			if err != nil {
+				s.logger.Debug("Could not send ping", zap.Error(err))
+				reason = err.Error()
+				break OutgoingLoop
+			}
+		case event := <-s.outgoingCh:
+			s.Lock()
+			if s.stopped {
+				// The connection may have closed between the channel read and this point.
+				s.Unlock()
+				break OutgoingLoop
+			}
+			err := s.write(fmt.Sprintf("event: %s\ndata: %s\n\n", event.name, event.data))
+			s.Unlock()
+			if err != nil {
+				s.logger.Warn("Could not write message", zap.Error(err))
+				reason = err.Error()
+				break OutgoingLoop
+			}
+
+			// Update outgoing message metrics.
+			s.metrics.MessageBytesSent(int64(len(event.data)), 0)
+		}
+	}
+
+	s.Close(reason, runtime.PresenceReasonDisconnect)
+}
+
+// Write and flush to the connection, must be called with the lock held.
+func (s *sessionSSE) write(data string) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.writeWaitDuration)); err != nil {
+		return err
+	}
+	if _, err := s.writer.WriteString(data); err != nil {
+		return err
+	}
+	return s.writer.Flush()
+}
+
+func (s *sessionSSE) Format() SessionFormat {
+	// Server-Sent Events are text, so envelopes are always sent as JSON.
+	return SessionFormatJson
+}
+
+func (s *sessionSSE) Send(envelope *rtapi.Envelope, reliable bool) error {
+	name := sessionSSEEventName(envelope)
+	if name == "" {
+		// Not streamed to read-only clients.
+		return nil
+	}
+
+	payload, err := s.protojsonMarshaler.Marshal(envelope)
+	if err != nil {
+		s.logger.Warn("Could not marshal envelope", zap.Error(err))
+		return err
+	}
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Debug(fmt.Sprintf("Sending %T message", envelope.Message), zap.Any("envelope", envelope))
+	}
+
+	return s.enqueue(&sessionSSEEvent{name: name, data: payload})
+}
+
+func (s *sessionSSE) SendBytes(payload []byte, reliable bool) error {
+	// Callers without a name to pass decode just enough to name the event.
+	envelope := &rtapi.Envelope{}
+	if err := s.protojsonUnmarshaler.Unmarshal(payload, envelope); err != nil {
+		s.logger.Warn("Could not unmarshal envelope", zap.Error(err))
+		return err
+	}
+	return s.SendNamedBytes(sessionSSEEventName(envelope), payload, reliable)
+}
+
+func (s *sessionSSE) SendNamedBytes(name string, payload []byte, reliable bool) error {
+	if name == "" {
+		// Not streamed to read-only clients.
+		return nil
+	}
+
+	return s.enqueue(&sessionSSEEvent{name: name, data: payload})
+}
+
+func (s *sessionSSE) enqueue(event *sessionSSEEvent) error {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return nil
+	}
+
+	// Attempt to queue messages and observe failures.
+	select {
+	case s.outgoingCh <- event:
+		s.Unlock()
+		return nil
+	default:
+		// The outgoing queue is full, likely because the remote client can't keep up.
+		s.Unlock()
+		s.logger.Warn("Could not write message, session outgoing queue full")
+		s.Close(ErrSessionQueueFull.Error(), runtime.PresenceReasonDisconnect)
+		return ErrSessionQueueFull
+	}
+}
+
+func (s *sessionSSE) Close(msg string, reason runtime.PresenceReason, envelopes ...*rtapi.Envelope) {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return
+	}
+	s.stopped = true
+	s.Unlock()
+
+	// Cancel any ongoing operations tied to this session.
+	s.ctxCancelFn()
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaning up closed client connection")
+	}
+
+	// When connection close originates internally in the session, ensure cleanup of external resources and references.
+	if err := s.matchmaker.RemoveSessionAll(s.id.String()); err != nil {
+		s.logger.Warn("Failed to remove all matchmaking tickets", zap.Error(err))
+	}
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection matchmaker")
+	}
+	s.tracker.UntrackAll(s.id, reason)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection tracker")
+	}
+	s.statusRegistry.UnfollowAll(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection status registry")
+	}
+	s.sessionRegistry.Remove(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection session registry")
+	}
+
+	// Send final messages, if any are specified and streamed to read-only clients.
+	s.Lock()
+	for _, envelope := range envelopes {
+		name := sessionSSEEventName(envelope)
+		if name == "" {
+			continue
+		}
+		payload, err := s.protojsonMarshaler.Marshal(envelope)
+		if err != nil {
+			s.logger.Warn("Could not marshal envelope", zap.Error(err))
+			continue
+		}
+		if err := s.write(fmt.Sprintf("event: %s\ndata: %s\n\n", name, payload)); err != nil {
+			s.logger.Debug("Could not write message", zap.Error(err))
+			break
+		}
+	}
+	s.Unlock()
+
+	// Close the connection, which ends the stream.
+	if err := s.conn.Close(); err != nil {
+		s.logger.Debug("Could not close", zap.Error(err))
+	}
+
+	s.logger.Info("Closed client connection")
+
+	// Fire an event for session end.
+	if fn := s.runtime.EventSessionEnd(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.vars, s.expiry, s.id.String(), s.clientIP, s.clientPort, s.lang, time.Now().UTC().Unix(), msg)
+	}
+}
@@ -0,0 +1,835 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"github.com/heroiclabs/nakama-common/runtime"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// UDP packet types. Every packet starts with one of these, followed by the contents described for each type.
+//
+// Packets from udpPacketAccept on are sealed: the type is followed by a big-endian uint64 packet sequence number and
+// the contents encrypted with AES-256-GCM under the session key for their direction, with the type and sequence number
+// as additional data. Each direction numbers its packets from 0, and the sequence number is the nonce, so every packet
+// is authenticated and packets that fail to open or have already been received are dropped.
+const (
+	// Client to server, in the clear: the client's X25519 public key for this handshake, then the cookie the server
+	// answered the same key with. The first hello has no cookie.
+	udpPacketHello byte = iota + 1
+	// Server to client, in the clear: the cookie the client must repeat in its hello, proving it receives packets sent
+	// to its address before the server keeps any state for the handshake.
+	udpPacketCookie
+	// Server to client, in the clear: the server's X25519 public key for this handshake.
+	udpPacketChallenge
+	// Client to server: sequence number 0 and, sealed with the handshake key, format byte, status flag byte, lang
+	// length byte, lang, then the session token without its signature. Followed by the handshake proof, see
+	// udpHandshakeProof.
+	udpPacketHandshake
+	// Server to client, in the clear: the reason the handshake was rejected.
+	udpPacketReject
+	// Server to client: the 16 byte session ID.
+	udpPacketAccept
+	// Big-endian uint32 sequence number, a byte set to 1 on the final fragment of a message, then the fragment.
+	udpPacketReliable
+	// A whole message, delivered at most once and in no particular order.
+	udpPacketUnreliable
+	// Big-endian uint32 sequence number of a received reliable packet.
+	udpPacketAck
+	udpPacketPing
+	udpPacketPong
+	// The reason the connection is closing, if any.
+	udpPacketClose
+)
+
+const (
+	udpReliableHeaderSize = 6
+	udpTickInterval       = 10 * time.Millisecond
+	udpInitialRto         = 250 * time.Millisecond
+	udpMinRto             = 50 * time.Millisecond
+	udpMaxRto             = 2 * time.Second
+	udpInitialWindow      = 4
+	udpInitialThreshold   = 64
+	// Reliable packets this far ahead of the next expected one are dropped and left for the sender to retransmit.
+	udpReceiveWindow = 256
+	// Bytes added to every sealed packet, the packet sequence number and the authentication tag.
+	udpSealOverhead = 8 + 16
+	// How far behind the newest packet received a sealed packet may arrive and still be accepted.
+	udpReplayWindow = 64
+)
+
+var (
+	ErrUdpMalformedPacket   = errors.New("received malformed packet")
+	ErrUdpMessageTooLarge   = errors.New("received message exceeds maximum size")
+	ErrUdpDeliveryTimedOut  = errors.New("reliable message delivery timed out")
+	ErrUdpConnectionTimeout = errors.New("connection timed out")
+)
+
+type udpInFlight struct {
+	seq           uint32
+	packet        []byte
+	firstSentAt   time.Time
+	sentAt        time.Time
+	retransmitted bool
+}
+
+// udpReliableSender sequences outgoing reliable packets, retransmits them until they are acknowledged, and limits
+// how many are in flight with an AIMD congestion window measured in packets.
+type udpReliableSender struct {
+	nextSeq    uint32
+	inFlight   []*udpInFlight
+	queue      [][]byte
+	queued     int
+	queueLimit int
+
+	cwnd     float64
+	ssthresh float64
+	srtt     time.Duration
+	rttvar   time.Duration
+	rto      time.Duration
+}
+
+func newUdpReliableSender(queueLimit int) *udpReliableSender {
+	return &udpReliableSender{
+		queueLimit: queueLimit,
+
+		cwnd:     udpInitialWindow,
+		ssthresh: udpInitialThreshold,
+		rto:      udpInitialRto,
+	}
+}
+
+// Split a message into sequenced packets and queue them. Returns false if too many messages are already queued.
+func (s *udpReliableSender) enqueue(payload []byte, packetSize int) bool {
+	if s.queued >= s.queueLimit {
+		return false
+	}
+	s.queued++
+
+	fragmentSize := packetSize - udpReliableHeaderSize - udpSealOverhead
+	for {
+		fragment := payload
+		if len(fragment) > fragmentSize {
+			fragment = fragment[:fragmentSize]
+		}
+		payload = payload[len(fragment):]
+
+		packet := make([]byte, udpReliableHeaderSize+len(fragment))
+		packet[0] = udpPacketReliable
+		binary.BigEndian.PutUint32(packet[1:5], s.nextSeq)
+		if len(payload) == 0 {
+			packet[5] = 1
+		}
+		copy(packet[udpReliableHeaderSize:], fragment)
+		s.nextSeq++
+		s.queue = append(s.queue, packet)
+
+		if len(payload) == 0 {
+			return true
+		}
+	}
+}
+
+// Move as many queued packets as the congestion window allows into flight, and return them to be written.
+func (s *udpReliableSender) next(now time.Time) [][]byte {
+	var packets [][]byte
+	for len(s.queue) > 0 && !s.full() {
+		packet := s.queue[0]
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		if packet[5] == 1 {
+			s.queued--
+		}
+		s.inFlight = append(s.inFlight, &udpInFlight{
+			seq:         binary.BigEndian.Uint32(packet[1:5]),
+			packet:      packet,
+			firstSentAt: now,
+			sentAt:      now,
+		})
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+func (s *udpReliableSender) full() bool {
+	return len(s.inFlight) >= int(s.cwnd)
+}
+
+func (s *udpReliableSender) ack(seq uint32, now time.Time) {
+	for i, f := range s.inFlight {
+		if f.seq != seq {
+			continue
+		}
+		copy(s.inFlight[i:], s.inFlight[i+1:])
+		s.inFlight[len(s.inFlight)-1] = nil
+		s.inFlight = s.inFlight[:len(s.inFlight)-1]
+
+		// Only packets sent once give an unambiguous round trip time sample.
+		if !f.retransmitted {
+			s.sampleRtt(now.Sub(f.sentAt))
+		}
+
+		// Additive increase, exponential while in slow start.
+		if s.cwnd < s.ssthresh {
+			s.cwnd++
+		} else {
+			s.cwnd += 1 / s.cwnd
+		}
+		return
+	}
+}
+
+// Estimate the retransmission timeout from round trip times, as described in RFC 6298.
+func (s *udpReliableSender) sampleRtt(rtt time.Duration) {
+	if s.srtt == 0 {
+		s.srtt = rtt
+		s.rttvar = rtt / 2
+	} else {
+		delta := s.srtt - rtt
+		if delta < 0 {
+			delta = -delta
+		}
+		s.rttvar = (3*s.rttvar + delta) / 4
+		s.srtt = (7*s.srtt + rtt) / 8
+	}
+	s.rto = s.srtt + 4*s.rttvar
+	if s.rto < udpMinRto {
+		s.rto = udpMinRto
+	} else if s.rto > udpMaxRto {
+		s.rto = udpMaxRto
+	}
+}
+
+// Return the in flight packets that have not been acknowledged within the retransmission timeout. Returns false if
+// any packet has gone unacknowledged for longer than the given timeout.
+func (s *udpReliableSender) expired(now time.Time, timeout time.Duration) ([][]byte, bool) {
+	var packets [][]byte
+	for _, f := range s.inFlight {
+		if now.Sub(f.firstSentAt) > timeout {
+			return nil, false
+		}
+		if now.Sub(f.sentAt) < s.rto {
+			continue
+		}
+		f.sentAt = now
+		f.retransmitted = true
+		packets = append(packets, f.packet)
+	}
+
+	if len(packets) != 0 {
+		// Multiplicative decrease, and back off the retransmission timeout until new samples arrive.
+		s.ssthresh = s.cwnd / 2
+		if s.ssthresh < 2 {
+			s.ssthresh = 2
+		}
+		s.cwnd = s.ssthresh
+		s.rto *= 2
+		if s.rto > udpMaxRto {
+			s.rto = udpMaxRto
+		}
+	}
+	return packets, true
+}
+
+// udpReliableReceiver puts incoming reliable packets back in order and reassembles fragmented messages.
+type udpReliableReceiver struct {
+	nextSeq        uint32
+	pending        map[uint32][]byte
+	message        []byte
+	maxMessageSize int64
+}
+
+func newUdpReliableReceiver(maxMessageSize int64) *udpReliableReceiver {
+	return &udpReliableReceiver{
+		pending:        make(map[uint32][]byte),
+		maxMessageSize: maxMessageSize,
+	}
+}
+
+// Accept a reliable packet, returning true if it should be acknowledged and any messages it completes, in order.
+func (r *udpReliableReceiver) receive(packet []byte) (bool, [][]byte, error) {
+	if len(packet) < udpReliableHeaderSize {
+		return false, nil, ErrUdpMalformedPacket
+	}
+	seq := binary.BigEndian.Uint32(packet[1:5])
+	switch {
+	case seq < r.nextSeq:
+		// A retransmission of a packet already received, the earlier ack was probably lost.
+		return true, nil, nil
+	case seq-r.nextSeq >= udpReceiveWindow:
+		return false, nil, nil
+	}
+	r.pending[seq] = packet
+
+	var messages [][]byte
+	for {
+		packet, found := r.pending[r.nextSeq]
+		if !found {
+			break
+		}
+		delete(r.pending, r.nextSeq)
+		r.nextSeq++
+
+		r.message = append(r.message, packet[udpReliableHeaderSize:]...)
+		if int64(len(r.message)) > r.maxMessageSize {
+			return false, nil, ErrUdpMessageTooLarge
+		}
+		if packet[5] == 1 {
+			messages = append(messages, r.message)
+			r.message = nil
+		}
+	}
+	return true, messages, nil
+}
+
+// udpCipher seals outgoing packets and opens incoming ones with the keys of a single session.
+type udpCipher struct {
+	send    cipher.AEAD
+	receive cipher.AEAD
+	sendSeq *atomic.Uint64
+
+	// The newest sequence number received, and a bitmap of which of the udpReplayWindow sequence numbers up to and
+	// including it have been received.
+	received    bool
+	receiveSeq  uint64
+	receiveMask uint64
+}
+
+func newUdpCipher(send, receive cipher.AEAD) *udpCipher {
+	return &udpCipher{
+		send:    send,
+		receive: receive,
+		sendSeq: atomic.NewUint64(0),
+	}
+}
+
+// Seal a packet, given as its type followed by its contents. Safe for concurrent use.
+func (c *udpCipher) seal(packet []byte) []byte {
+	sealed := make([]byte, 9, 9+len(packet)-1+c.send.Overhead())
+	sealed[0] = packet[0]
+	binary.BigEndian.PutUint64(sealed[1:9], c.sendSeq.Inc()-1)
+	return c.send.Seal(sealed, udpNonce(sealed[1:9]), packet[1:], sealed[:9])
+}
+
+// Open a sealed packet, returning its type followed by its contents. Returns false if the packet was not sealed with
+// this session's key, or if it has already been received or is too old to tell. Not safe for concurrent use.
+func (c *udpCipher) open(packet []byte) ([]byte, bool) {
+	if len(packet) < 1+udpSealOverhead {
+		return nil, false
+	}
+	seq := binary.BigEndian.Uint64(packet[1:9])
+	if c.received && seq <= c.receiveSeq && (c.receiveSeq-seq >= udpReplayWindow || c.receiveMask&(1<<(c.receiveSeq-seq)) != 0) {
+		return nil, false
+	}
+	contents, err := c.receive.Open(nil, udpNonce(packet[1:9]), packet[9:], packet[:9])
+	if err != nil {
+		return nil, false
+	}
+
+	// Only authentic packets move the replay window.
+	switch {
+	case !c.received:
+		c.received = true
+		c.receiveSeq = seq
+		c.receiveMask = 1
+	case seq > c.receiveSeq:
+		if shift := seq - c.receiveSeq; shift < udpReplayWindow {
+			c.receiveMask = c.receiveMask<<shift | 1
+		} else {
+			c.receiveMask = 1
+		}
+		c.receiveSeq = seq
+	default:
+		c.receiveMask |= 1 << (c.receiveSeq - seq)
+	}
+
+	return append([]byte{packet[0]}, contents...), true
+}
+
+// The AES-GCM nonce for a big-endian uint64 packet sequence number.
+func udpNonce(seq []byte) []byte {
+	nonce := make([]byte, 12)
+	copy(nonce[4:], seq)
+	return nonce
+}
+
+type sessionUDP struct {
+	sync.Mutex
+	logger     *zap.Logger
+	config     Config
+	id         uuid.UUID
+	format     SessionFormat
+	userID     uuid.UUID
+	username   *atomic.String
+	vars       map[string]string
+	expiry     int64
+	clientIP   string
+	clientPort string
+	lang       string
+
+	ctx         context.Context
+	ctxCancelFn context.CancelFunc
+
+	protojsonMarshaler   *protojson.MarshalOptions
+	protojsonUnmarshaler *protojson.UnmarshalOptions
+	pingPeriodDuration   time.Duration
+	pongWaitDuration     time.Duration
+	packetSize           int
+
+	sessionRegistry SessionRegistry
+	statusRegistry  *StatusRegistry
+	matchmaker      Matchmaker
+	tracker         Tracker
+	metrics         Metrics
+	pipeline        *Pipeline
+	runtime         *Runtime
+
+	stopped      bool
+	conn         net.PacketConn
+	addr         net.Addr
+	incomingCh   <-chan []byte
+	cipher       *udpCipher
+	handshake    []byte
+	accept       []byte
+	lastReceived *atomic.Int64
+	sender       *udpReliableSender
+	receiver     *udpReliableReceiver
+}
+
+func NewSessionUDP(logger *zap.Logger, config Config, format SessionFormat, sessionID, userID uuid.UUID, username string, vars map[string]string, expiry int64, clientIP, clientPort, lang string, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, conn net.PacketConn, addr net.Addr, incomingCh <-chan []byte, cipher *udpCipher, handshake, accept []byte, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, pipeline *Pipeline, runtime *Runtime) Session {
+	sessionLogger := logger.With(zap.String("uid", userID.String()), zap.String("sid", sessionID.String()))
+
+	sessionLogger.Info("New UDP session connected", zap.Uint8("format", uint8(format)))
+
+	ctx, ctxCancelFn := context.WithCancel(context.Background())
+
+	return &sessionUDP{
+		logger:     sessionLogger,
+		config:     config,
+		id:         sessionID,
+		format:     format,
+		userID:     userID,
+		username:   atomic.NewString(username),
+		vars:       vars,
+		expiry:     expiry,
+		clientIP:   clientIP,
+		clientPort: clientPort,
+		lang:       lang,
+
+		ctx:         ctx,
+		ctxCancelFn: ctxCancelFn,
+
+		protojsonMarshaler:   protojsonMarshaler,
+		protojsonUnmarshaler: protojsonUnmarshaler,
+		pingPeriodDuration:   time.Duration(config.GetSocket().PingPeriodMs) * time.Millisecond,
+		pongWaitDuration:     time.Duration(config.GetSocket().PongWaitMs) * time.Millisecond,
+		packetSize:           config.GetSocket().UdpPacketSizeBytes,
+
+		sessionRegistry: sessionRegistry,
+		statusRegistry:  statusRegistry,
+		matchmaker:      matchmaker,
+		tracker:         tracker,
+		metrics:         metrics,
+		pipeline:        pipeline,
+		runtime:         runtime,
+
+		stopped:      false,
+		conn:         conn,
+		addr:         addr,
+		incomingCh:   incomingCh,
+		cipher:       cipher,
+		handshake:    handshake,
+		accept:       accept,
+		lastReceived: atomic.NewInt64(time.Now().UnixNano()),
+		sender:       newUdpReliableSender(config.GetSocket().OutgoingQueueSize),
+		receiver:     newUdpReliableReceiver(config.GetSocket().MaxMessageSizeBytes),
+	}
+}
+
+func (s *sessionUDP) Logger() *zap.Logger {
+	return s.logger
+}
+
+func (s *sessionUDP) ID() uuid.UUID {
+	return s.id
+}
+
+func (s *sessionUDP) UserID() uuid.UUID {
+	return s.userID
+}
+
+func (s *sessionUDP) ClientIP() string {
+	return s.clientIP
+}
+
+func (s *sessionUDP) ClientPort() string {
+	return s.clientPort
+}
+
+func (s *sessionUDP) Lang() string {
+	return s.lang
+}
+
+func (s *sessionUDP) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sessionUDP) Username() string {
+	return s.username.Load()
+}
+
+func (s *sessionUDP) SetUsername(username string) {
+	s.username.Store(username)
+}
+
+func (s *sessionUDP) Vars() map[string]string {
+	return s.vars
+}
+
+func (s *sessionUDP) Expiry() int64 {
+	return s.expiry
+}
+
+func (s *sessionUDP) Consume() {
+	// Fire an event for session start.
+	if fn := s.runtime.EventSessionStart(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.vars, s.expiry, s.id.String(), s.clientIP, s.clientPort, s.lang, time.Now().UTC().Unix())
+	}
+
+	// Start a routine to retransmit reliable packets and keep the connection alive.
+	go s.processOutgoing()
+
+	var reason string
+
+IncomingLoop:
+	for {
+		var packet []byte
+		select {
+		case <-s.ctx.Done():
+			// Session was closed elsewhere.
+			break IncomingLoop
+		case packet = <-s.incomingCh:
+		}
+
+		if packet[0] == udpPacketHandshake {
+			// The client may repeat its handshake if our accept was lost. Only the handshake already verified is
+			// answered, with the same accept, so no new keys or sequence numbers are used.
+			if bytes.Equal(packet, s.handshake) {
+				s.writeSealed(s.accept)
+			}
+			continue
+		}
+		var ok bool
+		if packet, ok = s.cipher.open(packet); !ok {
+			// Anyone can send packets from the client's address, so drop rather than act on forged or replayed ones.
+			continue
+		}
+		s.lastReceived.Store(time.Now().UnixNano())
+
+		switch packet[0] {
+		case udpPacketReliable:
+			ack, messages, err := s.receiver.receive(packet)
+			if err != nil {
+				s.logger.Debug("Error receiving reliable packet", zap.Error(err))
+				reason = err.Error()
+				break IncomingLoop
+			}
+			if ack {
+				ackPacket := make([]byte, 5)
+				ackPacket[0] = udpPacketAck
+				copy(ackPacket[1:], packet[1:5])
+				s.writePacket(ackPacket)
+			}
+			for _, data := range messages {
+				if reason = s.processMessage(data); reason != "" {
+					break IncomingLoop
+				}
+			}
+		case udpPacketUnreliable:
+			if reason = s.processMessage(packet[1:]); reason != "" {
+				break IncomingLoop
+			}
+		case udpPacketAck:
+			if len(packet) != 5 {
+				reason = ErrUdpMalformedPacket.Error()
+				break IncomingLoop
+			}
+			now := time.Now()
+			s.Lock()
+			s.sender.ack(binary.BigEndian.Uint32(packet[1:5]), now)
+			// Acks open up the congestion window, send anything that was waiting for room.
+			s.writePackets(s.sender.next(now))
+			s.Unlock()
+		case udpPacketPing:
+			s.writePacket([]byte{udpPacketPong})
+		case udpPacketPong:
+			// Only needed to keep the connection alive.
+		case udpPacketClose:
+			// Client closed the connection normally.
+			break IncomingLoop
+		default:
+			s.logger.Debug("Received unexpected UDP packet type", zap.Uint8("type", packet[0]))
+			reason = "received unexpected UDP packet type"
+			break IncomingLoop
+		}
+	}
+
+	s.Close(reason, runtime.PresenceReasonDisconnect)
+}
+
+// Decode and process a single incoming message, returning a reason if the session should be closed.
+func (s *sessionUDP) processMessage(data []byte) string {
+	request := &rtapi.Envelope{}
+	var err error
+	switch s.format {
+	case SessionFormatProtobuf:
+		err = proto.Unmarshal(data, request)
+	case SessionFormatJson:
+		fallthrough
+	default:
+		err = s.protojsonUnmarshaler.Unmarshal(data, request)
+	}
+	if err != nil {
+		// If the payload is malformed the client is incompatible or misbehaving, either way disconnect it now.
+		s.logger.Warn("Received malformed payload", zap.Binary("data", data))
+		s.metrics.Message(int64(len(data)), true)
+		return "received malformed payload"
+	}
+
+	requestLogger := s.logger
+	if request.Cid != "" {
+		requestLogger = s.logger.With(zap.String("cid", request.Cid))
+	}
+	if !s.pipeline.ProcessRequest(requestLogger, s, request) {
+		s.metrics.Message(int64(len(data)), true)
+		return "error processing message"
+	}
+
+	// Update incoming message metrics.
+	s.metrics.Message(int64(len(data)), false)
+	return ""
+}
+
+func (s *sessionUDP) processOutgoing() {
+	var reason string
+
+	ticker := time.NewTicker(udpTickInterval)
+	defer ticker.Stop()
+	pingTicker := time.NewTicker(s.pingPeriodDuration)
+	defer pingTicker.Stop()
+
+OutgoingLoop:
+	for {
+		select {
+		case <-s.ctx.Done():
+			// Session is closing, close the outgoing process routine.
+			break OutgoingLoop
+		case <-pingTicker.C:
+			// Periodically send pings, and give up on clients that have stopped sending anything at all.
+			if time.Since(time.Unix(0, s.lastReceived.Load())) > s.pongWaitDuration {
+				reason = ErrUdpConnectionTimeout.Error()
+				break OutgoingLoop
+			}
+			s.writePacket([]byte{udpPacketPing})
+		case now := <-ticker.C:
+			s.Lock()
+			if s.stopped {
+				s.Unlock()
+				break OutgoingLoop
+			}
+			packets, ok := s.sender.expired(now, s.pongWaitDuration)
+			if !ok {
+				s.Unlock()
+				reason = ErrUdpDeliveryTimedOut.Error()
+				break OutgoingLoop
+			}
+			s.writePackets(packets)
+			s.writePackets(s.sender.next(now))
+			s.Unlock()
+		}
+	}
+
+	s.Close(reason, runtime.PresenceReasonDisconnect)
+}
+
+func (s *sessionUDP) writePackets(packets [][]byte) {
+	for _, packet := range packets {
+		s.writePacket(packet)
+	}
+}
+
+// Seal and write a packet, given as its type followed by its contents.
+func (s *sessionUDP) writePacket(packet []byte) {
+	s.writeSealed(s.cipher.seal(packet))
+}
+
+func (s *sessionUDP) writeSealed(packet []byte) {
+	// Write failures are treated the same as packets lost in transit.
+	if _, err := s.conn.WriteTo(packet, s.addr); err != nil {
+		s.logger.Debug("Could not write packet", zap.Error(err))
+	}
+}
+
+func (s *sessionUDP) Format() SessionFormat {
+	return s.format
+}
+
+func (s *sessionUDP) Send(envelope *rtapi.Envelope, reliable bool) error {
+	payload, err := s.marshal(envelope)
+	if err != nil {
+		return err
+	}
+	return s.SendBytes(payload, reliable)
+}
+
+func (s *sessionUDP) marshal(envelope *rtapi.Envelope) ([]byte, error) {
+	var payload []byte
+	var err error
+	switch s.format {
+	case SessionFormatProtobuf:
+		payload, err = proto.Marshal(envelope)
+	case SessionFormatJson:
+		fallthrough
+	default:
+		payload, err = s.protojsonMarshaler.Marshal(envelope)
+	}
+	if err != nil {
+		s.logger.Warn("Could not marshal envelope", zap.Error(err))
+		return nil, err
+	}
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		switch envelope.Message.(type) {
+		case *rtapi.Envelope_Error:
+			s.logger.Debug("Sending error message", zap.Binary("payload", payload))
+		default:
+			s.logger.Debug(fmt.Sprintf("Sending %T message", envelope.Message), zap.Any("envelope", envelope))
+		}
+	}
+
+	return payload, nil
+}
+
+func (s *sessionUDP) SendBytes(payload []byte, reliable bool) error {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return nil
+	}
+
+	// Unreliable messages too large for a single packet fall back to the reliable channel.
+	if !reliable && 1+len(payload)+udpSealOverhead <= s.packetSize {
+		// Drop rather than queue unreliable messages while the congestion window is full.
+		if s.sender.full() {
+			s.Unlock()
+			return nil
+		}
+		s.writePacket(append([]byte{udpPacketUnreliable}, payload...))
+		s.Unlock()
+
+		// Update outgoing message metrics.
+		s.metrics.MessageBytesSent(int64(len(payload)), 0)
+		return nil
+	}
+
+	if !s.sender.enqueue(payload, s.packetSize) {
+		// The outgoing queue is full, likely because the remote client can't keep up.
+		s.Unlock()
+		s.logger.Warn("Could not write message, session outgoing queue full")
+		s.Close(ErrSessionQueueFull.Error(), runtime.PresenceReasonDisconnect)
+		return ErrSessionQueueFull
+	}
+	s.writePackets(s.sender.next(time.Now()))
+	s.Unlock()
+
+	// Update outgoing message metrics.
+	s.metrics.MessageBytesSent(int64(len(payload)), 0)
+	return nil
+}
+
+func (s *sessionUDP) Close(msg string, reason runtime.PresenceReason, envelopes ...*rtapi.Envelope) {
+	s.Lock()
+	if s.stopped {
+		s.Unlock()
+		return
+	}
+	s.stopped = true
+	s.Unlock()
+
+	// Cancel any ongoing operations tied to this session.
+	s.ctxCancelFn()
+
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaning up closed client connection")
+	}
+
+	// When connection close originates internally in the session, ensure cleanup of external resources and references.
+	if err := s.matchmaker.RemoveSessionAll(s.id.String()); err != nil {
+		s.logger.Warn("Failed to remove all matchmaking tickets", zap.Error(err))
+	}
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection matchmaker")
+	}
+	s.tracker.UntrackAll(s.id, reason)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection tracker")
+	}
+	s.statusRegistry.UnfollowAll(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection status registry")
+	}
+	s.sessionRegistry.Remove(s.id)
+	if s.logger.Core().Enabled(zap.DebugLevel) {
+		s.logger.Info("Cleaned up closed connection session registry")
+	}
+
+	// Send final messages, if any are specified. There will be no retransmission so delivery is best effort.
+	s.Lock()
+	for _, envelope := range envelopes {
+		payload, err := s.marshal(envelope)
+		if err != nil {
+			continue
+		}
+		s.sender.enqueue(payload, s.packetSize)
+	}
+	s.writePackets(s.sender.queue)
+	s.sender.queue = nil
+	s.Unlock()
+
+	// Send close message. The shared UDP socket itself stays open for other sessions.
+	s.writePacket(append([]byte{udpPacketClose}, msg...))
+
+	s.logger.Info("Closed client connection")
+
+	// Fire an event for session end.
+	if fn := s.runtime.EventSessionEnd(); fn != nil {
+		fn(s.userID.String(), s.username.Load(), s.vars, s.expiry, s.id.String(), s.clientIP, s.clientPort, s.lang, time.Now().UTC().Unix(), msg)
+	}
+}
@@ -32,7 +32,17 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-var ErrSessionQueueFull = errors.New("session outgoing queue full")
+var (
+	ErrSessionQueueFull     = errors.New("session outgoing queue full")
+	ErrSessionNotSuspended  = errors.New("session is not waiting to be resumed")
+	ErrSessionResumeTooLate = errors.New("session no longer holds the messages sent after the given sequence number")
+)
+
+// sessionWSMessage is an outgoing message, numbered in send order if the session is resumable.
+type sessionWSMessage struct {
+	seq     int64
+	payload []byte
+}
 
 type sessionWS struct {
 	sync.Mutex
@@ -71,10 +81,23 @@ type sessionWS struct {
 	receivedMessageCounter int
 	pingTimer              *time.Timer
 	pingTimerCAS           *atomic.Uint32
-	outgoingCh             chan []byte
+	outgoingCh             chan *sessionWSMessage
+
+	// Set only if outgoing messages are compressed.
+	countingConn        *wsCountingConn
+	compressionMinBytes int
+
+	// Resumable sessions are suspended rather than closed when their connection is lost.
+	resumeGraceDuration time.Duration
+	resumeBufferSize    int
+	suspended           bool
+	suspendCount        int
+	outgoingSeq         int64
+	replayedSeq         int64
+	resumeBuffer        []*sessionWSMessage
 }
 
-func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, sessionID, userID uuid.UUID, username string, vars map[string]string, expiry int64, clientIP, clientPort, lang string, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, conn *websocket.Conn, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, pipeline *Pipeline, runtime *Runtime) Session {
+func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, sessionID, userID uuid.UUID, username string, vars map[string]string, expiry int64, clientIP, clientPort, lang string, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, conn *websocket.Conn, resumable bool, sessionRegistry SessionRegistry, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, pipeline *Pipeline, runtime *Runtime) Session {
 	sessionLogger := logger.With(zap.String("uid", userID.String()), zap.String("sid", sessionID.String()))
 
 	sessionLogger.Info("New WebSocket session connected", zap.Uint8("format", uint8(format)))
@@ -82,10 +105,18 @@ func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, sessi
 	ctx, ctxCancelFn := context.WithCancel(context.Background())
 
 	wsMessageType := websocket.TextMessage
-	if format == SessionFormatProtobuf {
+	if format == SessionFormatProtobuf || format == SessionFormatMsgpack {
 		wsMessageType = websocket.BinaryMessage
 	}
 
+	// Connections are only wrapped to count written bytes if compression is in use.
+	countingConn, _ := conn.UnderlyingConn().(*wsCountingConn)
+
+	var resumeGraceDuration time.Duration
+	if resumable {
+		resumeGraceDuration = time.Duration(config.GetSession().ResumeGraceSec) * time.Second
+	}
+
 	return &sessionWS{
 		logger:     sessionLogger,
 		config:     config,
@@ -122,7 +153,13 @@ func NewSessionWS(logger *zap.Logger, config Config, format SessionFormat, sessi
 		receivedMessageCounter: config.GetSocket().PingBackoffThreshold,
 		pingTimer:              time.NewTimer(time.Duration(config.GetSocket().PingPeriodMs) * time.Millisecond),
 		pingTimerCAS:           atomic.NewUint32(1),
-		outgoingCh:             make(chan []byte, config.GetSocket().OutgoingQueueSize),
+		outgoingCh:             make(chan *sessionWSMessage, config.GetSocket().OutgoingQueueSize),
+
+		countingConn:        countingConn,
+		compressionMinBytes: config.GetSocket().CompressionMinBytes,
+
+		resumeGraceDuration: resumeGraceDuration,
+		resumeBufferSize:    config.GetSession().ResumeBufferSize,
 	}
 }
 
@@ -190,15 +227,23 @@ func (s *sessionWS) Consume() {
 	// Start a routine to process outbound messages.
 	go s.processOutgoing()
 
+	s.consume(s.conn)
+}
+
+// Process incoming messages from the given connection until it fails or is closed.
+func (s *sessionWS) consume(conn *websocket.Conn) {
 	var reason string
 	var data []byte
+	var lost bool
 
 IncomingLoop:
 	for {
-		messageType, data, err := s.conn.ReadMessage()
+		messageType, data, err := conn.ReadMessage()
 		if err != nil {
 			// Ignore "normal" WebSocket errors.
 			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+				// The client did not close the connection itself, so it may want to resume the session.
+				lost = true
 				// Ignore underlying connection being shut down while read is waiting for data.
 				if e, ok := err.(*net.OpError); !ok || e.Err.Error() != "use of closed network connection" {
 					s.logger.Debug("Error reading message from client", zap.Error(err))
@@ -229,6 +274,8 @@ IncomingLoop:
 		switch s.format {
 		case SessionFormatProtobuf:
 			err = proto.Unmarshal(data, request)
+		case SessionFormatMsgpack:
+			err = msgpackUnmarshal(data, request)
 		case SessionFormatJson:
 			fallthrough
 		default:
@@ -264,9 +311,91 @@ IncomingLoop:
 		s.metrics.Message(int64(len(data)), true)
 	}
 
+	if lost && s.suspend(conn) {
+		return
+	}
 	s.Close(reason, runtime.PresenceReasonDisconnect)
 }
 
+// Suspend a resumable session that lost the given connection. Its presences are kept and outgoing messages buffered
+// until the client resumes it, or the grace period expires and it is closed. Returns false if the session was not
+// suspended and should be closed instead.
+func (s *sessionWS) suspend(conn *websocket.Conn) bool {
+	s.Lock()
+	if s.resumeGraceDuration == 0 || s.stopped {
+		s.Unlock()
+		return false
+	}
+	if s.suspended || s.conn != conn {
+		// Already suspended, or the connection was replaced by a resume.
+		s.Unlock()
+		return true
+	}
+	s.suspended = true
+	s.suspendCount++
+	suspendCount := s.suspendCount
+	s.Unlock()
+
+	if err := conn.Close(); err != nil {
+		s.logger.Debug("Could not close", zap.Error(err))
+	}
+	s.logger.Info("Suspended client connection, waiting for resume")
+
+	time.AfterFunc(s.resumeGraceDuration, func() {
+		s.Lock()
+		expired := s.suspended && s.suspendCount == suspendCount
+		s.Unlock()
+		if expired {
+			s.Close("session resume grace period expired", runtime.PresenceReasonDisconnect)
+		}
+	})
+	return true
+}
+
+// Resume a suspended session on a new connection, replaying the outgoing messages sent after the given sequence
+// number, which is the number of messages the client received before its connection was lost.
+func (s *sessionWS) resume(conn *websocket.Conn, seq int64) error {
+	s.Lock()
+	if s.stopped || !s.suspended {
+		s.Unlock()
+		return ErrSessionNotSuspended
+	}
+	if seq > s.outgoingSeq || seq < s.outgoingSeq-int64(len(s.resumeBuffer)) {
+		s.Unlock()
+		return ErrSessionResumeTooLate
+	}
+
+	s.conn = conn
+	s.countingConn, _ = conn.UnderlyingConn().(*wsCountingConn)
+	s.suspended = false
+	conn.SetReadLimit(s.config.GetSocket().MaxMessageSizeBytes)
+	conn.SetPongHandler(func(string) error {
+		s.maybeResetPingTimer()
+		return nil
+	})
+
+	for _, message := range s.resumeBuffer {
+		if message.seq <= seq {
+			continue
+		}
+		if err := s.write(message.payload); err != nil {
+			s.Unlock()
+			s.suspend(conn)
+			return err
+		}
+	}
+	// Messages still queued were buffered while suspended, and have now been replayed.
+	s.replayedSeq = s.outgoingSeq
+	s.Unlock()
+
+	s.logger.Info("Resumed client connection", zap.Int64("seq", seq))
+
+	if !s.maybeResetPingTimer() {
+		return ErrSessionNotSuspended
+	}
+	return nil
+}
+
 func (s *sessionWS) maybeResetPingTimer() bool {
 	// If there's already a reset in progress there's no need to wait.
 	if !s.pingTimerCAS.CompareAndSwap(1, 0) {
@@ -279,6 +408,11 @@ func (s *sessionWS) maybeResetPingTimer() bool {
 		s.Unlock()
 		return false
 	}
+	if s.suspended {
+		// Nothing to do until the session is resumed.
+		s.Unlock()
+		return true
+	}
 	// CAS ensures concurrency is not a problem here.
 	if !s.pingTimer.Stop() {
 		select {
@@ -313,7 +447,7 @@ OutgoingLoop:
 				reason = msg
 				break OutgoingLoop
 			}
-		case payload := <-s.outgoingCh:
+		case message := <-s.outgoingCh:
 			s.Lock()
 			if s.stopped {
 				// The connection may have stopped between the payload being queued on the outgoing channel and reaching here.
@@ -321,45 +455,76 @@ OutgoingLoop:
 				s.Unlock()
 				break OutgoingLoop
 			}
-			// Process the outgoing message queue.
-			if err := s.conn.SetWriteDeadline(time.Now().Add(s.writeWaitDuration)); err != nil {
+			if s.suspended || (message.seq != 0 && message.seq <= s.replayedSeq) {
+				// Buffered for the client to receive when it resumes, or already replayed when it did.
 				s.Unlock()
-				s.logger.Warn("Failed to set write deadline", zap.Error(err))
-				reason = err.Error()
-				break OutgoingLoop
+				continue
 			}
-			if err := s.conn.WriteMessage(s.wsMessageType, payload); err != nil {
+			// Process the outgoing message queue.
+			conn := s.conn
+			if err := s.write(message.payload); err != nil {
 				s.Unlock()
-				s.logger.Warn("Could not write message", zap.Error(err))
+				if s.suspend(conn) {
+					continue
+				}
 				reason = err.Error()
 				break OutgoingLoop
 			}
 			s.Unlock()
-
-			// Update outgoing message metrics.
-			s.metrics.MessageBytesSent(int64(len(payload)))
 		}
 	}
 
 	s.Close(reason, runtime.PresenceReasonDisconnect)
 }
 
+// Write a message to the current connection, must be called while holding the session lock.
+func (s *sessionWS) write(payload []byte) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.writeWaitDuration)); err != nil {
+		s.logger.Warn("Failed to set write deadline", zap.Error(err))
+		return err
+	}
+	// Small messages are not worth compressing.
+	var written, compressedBytes int64
+	compress := s.countingConn != nil && len(payload) >= s.compressionMinBytes
+	if s.countingConn != nil {
+		s.conn.EnableWriteCompression(compress)
+		written = s.countingConn.written.Load()
+	}
+	if err := s.conn.WriteMessage(s.wsMessageType, payload); err != nil {
+		s.logger.Warn("Could not write message", zap.Error(err))
+		return err
+	}
+	if compress {
+		compressedBytes = s.countingConn.written.Load() - written
+	}
+
+	// Update outgoing message metrics.
+	s.metrics.MessageBytesSent(int64(len(payload)), compressedBytes)
+	return nil
+}
+
 func (s *sessionWS) pingNow() (string, bool) {
 	s.Lock()
 	if s.stopped {
 		s.Unlock()
 		return "", false
 	}
-	if err := s.conn.SetWriteDeadline(time.Now().Add(s.writeWaitDuration)); err != nil {
+	if s.suspended {
+		// No connection to ping until the session is resumed.
+		s.Unlock()
+		return "", true
+	}
+	conn := s.conn
+	if err := conn.SetWriteDeadline(time.Now().Add(s.writeWaitDuration)); err != nil {
 		s.Unlock()
 		s.logger.Warn("Could not set write deadline to ping", zap.Error(err))
-		return err.Error(), false
+		return err.Error(), s.suspend(conn)
 	}
-	err := s.conn.WriteMessage(websocket.PingMessage, []byte{})
+	err := conn.WriteMessage(websocket.PingMessage, []byte{})
 	s.Unlock()
 	if err != nil {
 		s.logger.Warn("Could not send ping", zap.Error(err))
-		return err.Error(), false
+		return err.Error(), s.suspend(conn)
 	}
 
 	return "", true
@@ -375,6 +540,8 @@ func (s *sessionWS) Send(envelope *rtapi.Envelope, reliable bool) error {
 	switch s.format {
 	case SessionFormatProtobuf:
 		payload, err = proto.Marshal(envelope)
+	case SessionFormatMsgpack:
+		payload, err = msgpackMarshal(envelope)
 	case SessionFormatJson:
 		fallthrough
 	default:
@@ -406,9 +573,21 @@ func (s *sessionWS) SendBytes(payload []byte, reliable bool) error {
 		return nil
 	}
 
+	message := &sessionWSMessage{payload: payload}
+	if s.resumeGraceDuration != 0 {
+		// Keep recent messages to replay if the session is resumed.
+		s.outgoingSeq++
+		message.seq = s.outgoingSeq
+		if len(s.resumeBuffer) == s.resumeBufferSize {
+			s.resumeBuffer[0] = nil
+			s.resumeBuffer = s.resumeBuffer[1:]
+		}
+		s.resumeBuffer = append(s.resumeBuffer, message)
+	}
+
 	// Attempt to queue messages and observe failures.
 	select {
-	case s.outgoingCh <- payload:
+	case s.outgoingCh <- message:
 		s.Unlock()
 		return nil
 	default:
@@ -429,6 +608,7 @@ func (s *sessionWS) Close(msg string, reason runtime.PresenceReason, envelopes .
 		return
 	}
 	s.stopped = true
+	suspended := s.suspended
 	s.Unlock()
 
 	// Cancel any ongoing operations tied to this session.
@@ -462,6 +642,11 @@ func (s *sessionWS) Close(msg string, reason runtime.PresenceReason, envelopes .
 	s.pingTimer.Stop()
 	close(s.outgoingCh)
 
+	if suspended {
+		// A suspended session has no connection to send final messages on.
+		envelopes = nil
+	}
+
 	// Send final messages, if any are specified.
 	for _, envelope := range envelopes {
 		var payload []byte
@@ -469,6 +654,8 @@ func (s *sessionWS) Close(msg string, reason runtime.PresenceReason, envelopes .
 		switch s.format {
 		case SessionFormatProtobuf:
 			payload, err = proto.Marshal(envelope)
+		case SessionFormatMsgpack:
+			payload, err = msgpackMarshal(envelope)
 		case SessionFormatJson:
 			fallthrough
 		default:
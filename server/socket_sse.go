@@ -0,0 +1,148 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NewSocketSseAcceptor serves read-only real-time sessions as Server-Sent Events, for clients that can't use
+// WebSockets. Sessions receive notifications, status events, channel messages and stream data.
+func NewSocketSseAcceptor(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, runtime *Runtime, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions) func(http.ResponseWriter, *http.Request) {
+	sessionIdGen := uuid.NewGenWithHWAF(func() (net.HardwareAddr, error) {
+		hash := NodeToHash(config.GetName())
+		return hash[:], nil
+	})
+
+	// This handler will be attached to the API Gateway server.
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Check authentication.
+		var token string
+		if auth := r.Header["Authorization"]; len(auth) >= 1 {
+			// Attempt header based authentication.
+			const prefix = "Bearer "
+			if !strings.HasPrefix(auth[0], prefix) {
+				http.Error(w, "Missing or invalid token", 401)
+				return
+			}
+			token = auth[0][len(prefix):]
+		} else {
+			// Attempt query parameter based authentication, browsers can't set headers on event streams.
+			token = r.URL.Query().Get("token")
+		}
+		if token == "" {
+			http.Error(w, "Missing or invalid token", 401)
+			return
+		}
+		userID, username, vars, expiry, _, ok := parseToken([]byte(config.GetSession().EncryptionKey), token)
+		if !ok || !sessionCache.IsValidSession(userID, expiry, token) {
+			http.Error(w, "Missing or invalid token", 401)
+			return
+		}
+
+		// Extract lang query parameter. Use a default if empty or not present.
+		lang := "en"
+		if langParam := r.URL.Query().Get("lang"); langParam != "" {
+			lang = langParam
+		}
+
+		// Take over the connection, the stream outlives the server's request timeouts.
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			http.Error(w, "Streaming unsupported", 500)
+			return
+		}
+		header := w.Header().Clone()
+		conn, rw, err := hijacker.Hijack()
+		if err != nil {
+			logger.Debug("Could not hijack connection for SSE", zap.Error(err))
+			return
+		}
+		if err := conn.SetDeadline(time.Time{}); err != nil {
+			logger.Debug("Could not clear SSE connection deadlines", zap.Error(err))
+			_ = conn.Close()
+			return
+		}
+
+		// The stream has no length, it ends when the connection is closed.
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-store")
+		header.Set("Connection", "close")
+		header.Set("X-Accel-Buffering", "no")
+		_, _ = rw.WriteString("HTTP/1.1 200 OK\r\n")
+		_ = header.Write(rw)
+		_, _ = rw.WriteString("\r\n")
+		if err := rw.Flush(); err != nil {
+			logger.Debug("Could not start SSE stream", zap.Error(err))
+			_ = conn.Close()
+			return
+		}
+
+		clientIP, clientPort := extractClientAddressFromRequest(logger, r)
+		status, _ := strconv.ParseBool(r.URL.Query().Get("status"))
+		sessionID := uuid.Must(sessionIdGen.NewV1())
+
+		// Mark the online status of the user.
+		metrics.GaugeOnlineStatus(userID, true)
+
+		// Wrap the connection for application handling.
+		session := NewSessionSSE(logger, config, sessionID, userID, username, vars, expiry, clientIP, clientPort, lang, protojsonMarshaler, protojsonUnmarshaler, conn, rw.Writer, sessionRegistry, statusRegistry, matchmaker, tracker, metrics, runtime)
+
+		// Add to the session registry.
+		sessionRegistry.Add(session)
+
+		// Register initial status tracking and presence(s) for this session.
+		statusRegistry.Follow(sessionID, map[uuid.UUID]struct{}{userID: {}})
+		if status {
+			// Both notification and status presence.
+			tracker.TrackMulti(session.Context(), sessionID, []*TrackerOp{
+				{
+					Stream: PresenceStream{Mode: StreamModeNotifications, Subject: userID},
+					Meta:   PresenceMeta{Format: SessionFormatJson, Username: username, Hidden: true},
+				},
+				{
+					Stream: PresenceStream{Mode: StreamModeStatus, Subject: userID},
+					Meta:   PresenceMeta{Format: SessionFormatJson, Username: username, Status: ""},
+				},
+			}, userID, true)
+		} else {
+			// Only notification presence.
+			tracker.Track(session.Context(), sessionID, PresenceStream{Mode: StreamModeNotifications, Subject: userID}, userID, PresenceMeta{Format: SessionFormatJson, Username: username, Hidden: true}, true)
+		}
+
+		if config.GetSession().SingleSocket {
+			// Kick any other sockets for this user.
+			go sessionRegistry.SingleSession(session.Context(), tracker, userID, sessionID)
+		}
+
+		// Stream to the client until either side closes the connection.
+		session.Consume()
+
+		// Mark the online status of the user. Considers of single user having multiple sessions.
+		metrics.GaugeOnlineStatus(userID,
+			len(tracker.ListLocalSessionIDByStream(PresenceStream{
+				Mode: StreamModeNotifications, Subject: userID,
+			})) > 0,
+		)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+)
+
+// should stream notifications, status events, channel messages and stream data, and nothing else
+func TestSocketSseStream(t *testing.T) {
+	server := newSocketTestServer(t, &testMetrics{})
+
+	httpServer := httptest.NewServer(http.HandlerFunc(NewSocketSseAcceptor(server.logger, server.config, server.sessionRegistry, server.sessionCache, server.statusRegistry, server.matchmaker, server.tracker, server.metrics, server.runtime, protojsonMarshaler, protojsonUnmarshaler)))
+	defer httpServer.Close()
+
+	if res, err := http.Get(httpServer.URL + "?token=invalid"); err != nil || res.StatusCode != 401 {
+		t.Fatalf("expected invalid token to be rejected, got %v %v", res, err)
+	}
+
+	res, err := http.Get(httpServer.URL + "?token=" + server.newToken())
+	if err != nil {
+		t.Fatalf("error connecting: %v", err)
+	}
+	defer res.Body.Close()
+	if contentType := res.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Fatalf("expected event stream, got %v", contentType)
+	}
+
+	var session Session
+	for i := 0; session == nil; i++ {
+		if i == 100 {
+			t.Fatal("expected session to be registered")
+		}
+		time.Sleep(10 * time.Millisecond)
+		server.sessionRegistry.(*LocalSessionRegistry).sessions.Range(func(_ uuid.UUID, s Session) bool {
+			session = s
+			return false
+		})
+	}
+
+	// Envelopes sent directly and as bytes from the message router are both filtered by type.
+	if err := session.Send(&rtapi.Envelope{Message: &rtapi.Envelope_Pong{Pong: &rtapi.Pong{}}}, true); err != nil {
+		t.Fatalf("error sending pong: %v", err)
+	}
+	if err := session.Send(&rtapi.Envelope{Message: &rtapi.Envelope_Notifications{Notifications: &rtapi.Notifications{
+		Notifications: []*api.Notification{{Id: "1", Subject: "subject"}},
+	}}}, true); err != nil {
+		t.Fatalf("error sending notifications: %v", err)
+	}
+	router := NewLocalMessageRouter(server.sessionRegistry, server.tracker, protojsonMarshaler)
+	presenceIDs := []*PresenceID{{Node: "node", SessionID: session.ID()}}
+	router.SendToPresenceIDs(server.logger, presenceIDs, &rtapi.Envelope{Message: &rtapi.Envelope_Pong{Pong: &rtapi.Pong{}}}, true)
+	router.SendToPresenceIDs(server.logger, presenceIDs, &rtapi.Envelope{Message: &rtapi.Envelope_StreamData{StreamData: &rtapi.StreamData{Data: "data"}}}, true)
+	payload, err := protojsonMarshaler.Marshal(&rtapi.Envelope{Message: &rtapi.Envelope_ChannelMessage{ChannelMessage: &api.ChannelMessage{MessageId: "2"}}})
+	if err != nil {
+		t.Fatalf("error marshalling channel message: %v", err)
+	}
+	if err := session.SendBytes(payload, true); err != nil {
+		t.Fatalf("error sending channel message: %v", err)
+	}
+
+	reader := bufio.NewReader(res.Body)
+	readEvent := func() (string, *rtapi.Envelope) {
+		t.Helper()
+		var name string
+		envelope := &rtapi.Envelope{}
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatalf("error reading event: %v", err)
+			}
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "":
+				return name, envelope
+			case strings.HasPrefix(line, "event: "):
+				name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				if err := protojsonUnmarshaler.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), envelope); err != nil {
+					t.Fatalf("error unmarshalling envelope: %v", err)
+				}
+			}
+		}
+	}
+
+	if name, envelope := readEvent(); name != "notifications" || envelope.GetNotifications().GetNotifications()[0].Id != "1" {
+		t.Fatalf("expected notifications event, got %v %v", name, envelope)
+	}
+	if name, envelope := readEvent(); name != "stream_data" || envelope.GetStreamData().Data != "data" {
+		t.Fatalf("expected stream data event, got %v %v", name, envelope)
+	}
+	if name, envelope := readEvent(); name != "channel_message" || envelope.GetChannelMessage().MessageId != "2" {
+		t.Fatalf("expected channel message event, got %v %v", name, envelope)
+	}
+
+	// Closing the session ends the stream.
+	session.Close("", 0)
+	if _, err := reader.ReadString('\n'); err == nil {
+		t.Fatal("expected stream to end")
+	}
+	if server.sessionRegistry.Get(session.ID()) != nil {
+		t.Fatal("expected session to be removed")
+	}
+}
@@ -0,0 +1,460 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Packets waiting to be processed by a single UDP session. Any more are dropped as if lost in transit.
+	udpIncomingQueueSize = 256
+	// How long a client has to answer the challenge with its handshake.
+	udpHandshakeTimeout = 5 * time.Second
+	// Handshakes in progress at once. Hellos beyond this are dropped, and their clients retry.
+	udpMaxPendingHandshakes = 1024
+	// How often the cookie for a client changes. Cookies are accepted until the end of the next interval.
+	udpCookieInterval = 10 * time.Second
+)
+
+// SocketUdpAcceptor serves real-time sessions over a single UDP socket, dispatching packets to sessions by the
+// remote address they arrive from.
+//
+// Clients open a session with an X25519 key exchange. The handshake carries the session token without its signature,
+// encrypted, and proves knowledge of the signature without sending it. The signature and the exchanged secret then
+// derive the keys that authenticate and encrypt every packet of the session, so packets from the same address but
+// without the keys are dropped. No state is kept for a client until it repeats a cookie sent to its address, and the
+// number of handshakes in progress is capped, so spoofed hellos cannot exhaust the server.
+type SocketUdpAcceptor struct {
+	sync.Mutex
+	logger               *zap.Logger
+	config               Config
+	sessionRegistry      SessionRegistry
+	sessionCache         SessionCache
+	statusRegistry       *StatusRegistry
+	matchmaker           Matchmaker
+	tracker              Tracker
+	metrics              Metrics
+	runtime              *Runtime
+	protojsonMarshaler   *protojson.MarshalOptions
+	protojsonUnmarshaler *protojson.UnmarshalOptions
+	pipeline             *Pipeline
+
+	sessionIdGen uuid.Generator
+	stopped      bool
+	conn         net.PacketConn
+	// Incoming packet queues by remote address, including handshakes still being processed.
+	sessions map[string]chan []byte
+	// Handshakes still being processed, and how many may be at once.
+	pending    int
+	maxPending int
+	// Key for the cookies sent in answer to hellos.
+	cookieKey []byte
+}
+
+func NewSocketUdpAcceptor(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, runtime *Runtime, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, pipeline *Pipeline) *SocketUdpAcceptor {
+	cookieKey := make([]byte, 32)
+	if _, err := rand.Read(cookieKey); err != nil {
+		logger.Fatal("Could not generate UDP cookie key", zap.Error(err))
+	}
+
+	return &SocketUdpAcceptor{
+		logger:               logger,
+		config:               config,
+		sessionRegistry:      sessionRegistry,
+		sessionCache:         sessionCache,
+		statusRegistry:       statusRegistry,
+		matchmaker:           matchmaker,
+		tracker:              tracker,
+		metrics:              metrics,
+		runtime:              runtime,
+		protojsonMarshaler:   protojsonMarshaler,
+		protojsonUnmarshaler: protojsonUnmarshaler,
+		pipeline:             pipeline,
+
+		sessionIdGen: uuid.NewGenWithHWAF(func() (net.HardwareAddr, error) {
+			hash := NodeToHash(config.GetName())
+			return hash[:], nil
+		}),
+		sessions:   make(map[string]chan []byte),
+		maxPending: udpMaxPendingHandshakes,
+		cookieKey:  cookieKey,
+	}
+}
+
+// Serve reads packets from the given connection until it is closed.
+func (a *SocketUdpAcceptor) Serve(conn net.PacketConn) error {
+	a.Lock()
+	if a.stopped {
+		a.Unlock()
+		return conn.Close()
+	}
+	a.conn = conn
+	a.Unlock()
+
+	// Large enough for any UDP datagram, so oversized packets can be detected and dropped.
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		if n == 0 || n > a.config.GetSocket().UdpPacketSizeBytes {
+			continue
+		}
+		packet := make([]byte, n)
+		copy(packet, buf[:n])
+
+		key := addr.String()
+		a.Lock()
+		incomingCh, found := a.sessions[key]
+		a.Unlock()
+		if !found {
+			if packet[0] != udpPacketHello || len(packet) < 1+curve25519.PointSize {
+				// Not part of any session, ignore it.
+				continue
+			}
+			if !a.validCookie(key, packet) {
+				cookie := a.cookie(key, packet[1:1+curve25519.PointSize], time.Now())
+				if _, err := conn.WriteTo(append([]byte{udpPacketCookie}, cookie...), addr); err != nil {
+					a.logger.Debug("Could not send UDP handshake cookie", zap.Error(err))
+				}
+				continue
+			}
+			a.Lock()
+			if a.pending >= a.maxPending {
+				// Too many handshakes in progress, the client will retry.
+				a.Unlock()
+				continue
+			}
+			a.pending++
+			incomingCh = make(chan []byte, udpIncomingQueueSize)
+			a.sessions[key] = incomingCh
+			a.Unlock()
+			go a.accept(conn, addr, packet, incomingCh)
+			continue
+		}
+
+		select {
+		case incomingCh <- packet:
+		default:
+			// The session is not keeping up, drop the packet.
+		}
+	}
+}
+
+func (a *SocketUdpAcceptor) Stop() {
+	a.Lock()
+	a.stopped = true
+	conn := a.conn
+	a.Unlock()
+	if conn != nil {
+		if err := conn.Close(); err != nil {
+			a.logger.Debug("Could not close UDP socket", zap.Error(err))
+		}
+	}
+}
+
+// The cookie for a client address and public key, changing every udpCookieInterval.
+func (a *SocketUdpAcceptor) cookie(key string, clientPublic []byte, now time.Time) []byte {
+	interval := make([]byte, 8)
+	binary.BigEndian.PutUint64(interval, uint64(now.UnixNano()/int64(udpCookieInterval)))
+	mac := hmac.New(sha256.New, a.cookieKey)
+	mac.Write(interval)
+	mac.Write(clientPublic)
+	mac.Write([]byte(key))
+	return mac.Sum(nil)
+}
+
+// Check a hello repeats the cookie for its address and public key from this interval or the one before.
+func (a *SocketUdpAcceptor) validCookie(key string, hello []byte) bool {
+	if len(hello) != 1+curve25519.PointSize+sha256.Size {
+		return false
+	}
+	clientPublic, cookie := hello[1:1+curve25519.PointSize], hello[1+curve25519.PointSize:]
+	now := time.Now()
+	return hmac.Equal(cookie, a.cookie(key, clientPublic, now)) || hmac.Equal(cookie, a.cookie(key, clientPublic, now.Add(-udpCookieInterval)))
+}
+
+func (a *SocketUdpAcceptor) accept(conn net.PacketConn, addr net.Addr, hello []byte, incomingCh chan []byte) {
+	key := addr.String()
+	pending := true
+	handshakeDone := func() {
+		if pending {
+			pending = false
+			a.Lock()
+			a.pending--
+			a.Unlock()
+		}
+	}
+	defer func() {
+		handshakeDone()
+		a.Lock()
+		delete(a.sessions, key)
+		a.Unlock()
+	}()
+
+	reject := func(reason string) {
+		if _, err := conn.WriteTo(append([]byte{udpPacketReject}, reason...), addr); err != nil {
+			a.logger.Debug("Could not reject UDP handshake", zap.Error(err))
+		}
+	}
+
+	// Answer the client's key with one of our own.
+	clientPublic := hello[1 : 1+curve25519.PointSize]
+	serverPrivate := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(serverPrivate); err != nil {
+		a.logger.Error("Could not generate UDP handshake key", zap.Error(err))
+		return
+	}
+	serverPublic, err := curve25519.X25519(serverPrivate, curve25519.Basepoint)
+	if err != nil {
+		a.logger.Error("Could not generate UDP handshake key", zap.Error(err))
+		return
+	}
+	shared, err := curve25519.X25519(serverPrivate, clientPublic)
+	if err != nil {
+		// Low order points would give a predictable secret.
+		reject("Invalid handshake")
+		return
+	}
+	challenge := append([]byte{udpPacketChallenge}, serverPublic...)
+	if _, err := conn.WriteTo(challenge, addr); err != nil {
+		a.logger.Debug("Could not challenge UDP handshake", zap.Error(err))
+		return
+	}
+
+	// Wait for the handshake, answering a repeated hello in case the challenge was lost.
+	var handshake []byte
+	timer := time.NewTimer(udpHandshakeTimeout)
+	defer timer.Stop()
+	for handshake == nil {
+		select {
+		case <-timer.C:
+			return
+		case packet := <-incomingCh:
+			switch {
+			case packet[0] == udpPacketHandshake:
+				handshake = packet
+			case bytes.Equal(packet, hello):
+				if _, err := conn.WriteTo(challenge, addr); err != nil {
+					a.logger.Debug("Could not challenge UDP handshake", zap.Error(err))
+				}
+			}
+		}
+	}
+
+	// Check handshake contents.
+	handshakeCipher, err := udpHandshakeCipher(shared, clientPublic, serverPublic)
+	if err != nil {
+		a.logger.Error("Could not derive UDP handshake key", zap.Error(err))
+		return
+	}
+	if len(handshake) < 1+udpSealOverhead+sha256.Size {
+		reject("Invalid handshake")
+		return
+	}
+	sealed, proof := handshake[:len(handshake)-sha256.Size], handshake[len(handshake)-sha256.Size:]
+	contents, ok := newUdpCipher(nil, handshakeCipher).open(sealed)
+	if !ok {
+		reject("Invalid handshake")
+		return
+	}
+	if len(contents) < 4 || len(contents) < 4+int(contents[3]) {
+		reject("Invalid handshake")
+		return
+	}
+	var format SessionFormat
+	switch SessionFormat(contents[1]) {
+	case SessionFormatJson:
+		format = SessionFormatJson
+	case SessionFormatProtobuf:
+		format = SessionFormatProtobuf
+	default:
+		// Invalid values are rejected.
+		reject("Invalid format parameter")
+		return
+	}
+	status := contents[2] == 1
+	lang := string(contents[4 : 4+int(contents[3])])
+	unsignedToken := string(contents[4+int(contents[3]):])
+
+	// Check authentication. The client proves it holds the token signature, which is never sent, by deriving the
+	// session keys from it.
+	if unsignedToken == "" {
+		reject("Missing or invalid token")
+		return
+	}
+	mac := hmac.New(sha256.New, []byte(a.config.GetSession().EncryptionKey))
+	mac.Write([]byte(unsignedToken))
+	signature := mac.Sum(nil)
+	proofKey, clientCipher, serverCipher, err := udpSessionKeys(shared, signature, clientPublic, serverPublic)
+	if err != nil {
+		a.logger.Error("Could not derive UDP session keys", zap.Error(err))
+		return
+	}
+	if !hmac.Equal(proof, udpHandshakeProof(proofKey, clientPublic, serverPublic, sealed)) {
+		reject("Missing or invalid token")
+		return
+	}
+	token := unsignedToken + "." + base64.RawURLEncoding.EncodeToString(signature)
+	userID, username, vars, expiry, _, ok := parseToken([]byte(a.config.GetSession().EncryptionKey), token)
+	if !ok || !a.sessionCache.IsValidSession(userID, expiry, token) {
+		reject("Missing or invalid token")
+		return
+	}
+
+	// Use a default lang if none is given.
+	if lang == "" {
+		lang = "en"
+	}
+
+	clientIP, clientPort, err := net.SplitHostPort(key)
+	if err != nil {
+		clientIP = key
+	}
+	sessionID := uuid.Must(a.sessionIdGen.NewV1())
+
+	sessionCipher := newUdpCipher(serverCipher, clientCipher)
+	accept := sessionCipher.seal(append([]byte{udpPacketAccept}, sessionID.Bytes()...))
+	if _, err := conn.WriteTo(accept, addr); err != nil {
+		a.logger.Debug("Could not accept UDP handshake", zap.Error(err))
+		return
+	}
+	handshakeDone()
+
+	// Mark the online status of the user.
+	a.metrics.GaugeOnlineStatus(userID, true)
+
+	// Wrap the connection for application handling.
+	session := NewSessionUDP(a.logger, a.config, format, sessionID, userID, username, vars, expiry, clientIP, clientPort, lang, a.protojsonMarshaler, a.protojsonUnmarshaler, conn, addr, incomingCh, sessionCipher, handshake, accept, a.sessionRegistry, a.statusRegistry, a.matchmaker, a.tracker, a.metrics, a.pipeline, a.runtime)
+
+	// Add to the session registry.
+	a.sessionRegistry.Add(session)
+
+	// Register initial status tracking and presence(s) for this session.
+	a.statusRegistry.Follow(sessionID, map[uuid.UUID]struct{}{userID: {}})
+	if status {
+		// Both notification and status presence.
+		a.tracker.TrackMulti(session.Context(), sessionID, []*TrackerOp{
+			{
+				Stream: PresenceStream{Mode: StreamModeNotifications, Subject: userID},
+				Meta:   PresenceMeta{Format: format, Username: username, Hidden: true},
+			},
+			{
+				Stream: PresenceStream{Mode: StreamModeStatus, Subject: userID},
+				Meta:   PresenceMeta{Format: format, Username: username, Status: ""},
+			},
+		}, userID, true)
+	} else {
+		// Only notification presence.
+		a.tracker.Track(session.Context(), sessionID, PresenceStream{Mode: StreamModeNotifications, Subject: userID}, userID, PresenceMeta{Format: format, Username: username, Hidden: true}, true)
+	}
+
+	if a.config.GetSession().SingleSocket {
+		// Kick any other sockets for this user.
+		go a.sessionRegistry.SingleSession(session.Context(), a.tracker, userID, sessionID)
+	}
+
+	// Allow the server to begin processing incoming messages from this session.
+	session.Consume()
+
+	// Mark the online status of the user. Considers of single user having multiple sessions.
+	a.metrics.GaugeOnlineStatus(userID,
+		len(a.tracker.ListLocalSessionIDByStream(PresenceStream{
+			Mode: StreamModeNotifications, Subject: userID,
+		})) > 0,
+	)
+}
+
+// The key sealing the contents of a handshake, known only to the two ends of the key exchange.
+func udpHandshakeCipher(shared, clientPublic, serverPublic []byte) (cipher.AEAD, error) {
+	keys, err := udpDeriveKeys(shared, clientPublic, serverPublic, "nakama udp handshake", 1)
+	if err != nil {
+		return nil, err
+	}
+	return newUdpAEAD(keys[0])
+}
+
+// The keys of a session: the key the handshake proof is made with, and the keys sealing packets from the client and
+// from the server. Each depends on both the exchanged secret and the token signature.
+func udpSessionKeys(shared, signature, clientPublic, serverPublic []byte) ([]byte, cipher.AEAD, cipher.AEAD, error) {
+	secret := append(append([]byte{}, shared...), signature...)
+	keys, err := udpDeriveKeys(secret, clientPublic, serverPublic, "nakama udp session", 3)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	clientCipher, err := newUdpAEAD(keys[1])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	serverCipher, err := newUdpAEAD(keys[2])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return keys[0], clientCipher, serverCipher, nil
+}
+
+// The handshake proof: an HMAC-SHA256 of both public keys and the sealed handshake, under the session proof key.
+func udpHandshakeProof(proofKey, clientPublic, serverPublic, sealed []byte) []byte {
+	mac := hmac.New(sha256.New, proofKey)
+	mac.Write(clientPublic)
+	mac.Write(serverPublic)
+	mac.Write(sealed)
+	return mac.Sum(nil)
+}
+
+// Derive count 32 byte keys from a secret with HKDF-SHA256, bound to both public keys of the exchange.
+func udpDeriveKeys(secret, clientPublic, serverPublic []byte, info string, count int) ([][]byte, error) {
+	salt := append(append([]byte{}, clientPublic...), serverPublic...)
+	reader := hkdf.New(sha256.New, secret, salt, []byte(info))
+	keys := make([][]byte, count)
+	for i := range keys {
+		keys[i] = make([]byte, 32)
+		if _, err := io.ReadFull(reader, keys[i]); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+func newUdpAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
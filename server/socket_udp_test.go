@@ -0,0 +1,464 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/protobuf/proto"
+)
+
+type udpTestClient struct {
+	t    *testing.T
+	conn net.Conn
+	// Set once a handshake is accepted, along with the handshake and the accept it was answered with.
+	cipher          *udpCipher
+	handshakePacket []byte
+	acceptPacket    []byte
+}
+
+func (c *udpTestClient) write(packet []byte) {
+	c.t.Helper()
+	if _, err := c.conn.Write(packet); err != nil {
+		c.t.Fatalf("error writing packet: %v", err)
+	}
+}
+
+// Seal and write a packet, given as its type followed by its contents.
+func (c *udpTestClient) writeSealed(packet []byte) {
+	c.t.Helper()
+	c.write(c.cipher.seal(packet))
+}
+
+func (c *udpTestClient) readRaw() []byte {
+	c.t.Helper()
+	packet, err := c.readWithin(5 * time.Second)
+	if err != nil {
+		c.t.Fatalf("error reading packet: %v", err)
+	}
+	return packet
+}
+
+func (c *udpTestClient) readWithin(timeout time.Duration) ([]byte, error) {
+	c.t.Helper()
+	buf := make([]byte, 65535)
+	if err := c.conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		c.t.Fatalf("error setting read deadline: %v", err)
+	}
+	n, err := c.conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// Read and open the next sealed packet, skipping pings.
+func (c *udpTestClient) read() []byte {
+	c.t.Helper()
+	for {
+		packet, ok := c.cipher.open(c.readRaw())
+		if !ok {
+			c.t.Fatal("expected sealed packet")
+		}
+		if packet[0] != udpPacketPing {
+			return packet
+		}
+	}
+}
+
+// Run the handshake with a session token. Returns the reject packet, or the opened accept packet.
+func (c *udpTestClient) handshake(token string) []byte {
+	c.t.Helper()
+	clientPrivate, hello := c.hello()
+	c.write(hello)
+	challenge := c.readRaw()
+	if challenge[0] != udpPacketChallenge {
+		c.t.Fatalf("expected challenge, got type %v", challenge[0])
+	}
+	return c.finishHandshake(token, clientPrivate, challenge)
+}
+
+// Generate a key pair and get a cookie for it, returning the private key and the hello to send with the cookie.
+func (c *udpTestClient) hello() ([]byte, []byte) {
+	c.t.Helper()
+	clientPrivate := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(clientPrivate); err != nil {
+		c.t.Fatalf("error generating key: %v", err)
+	}
+	clientPublic, err := curve25519.X25519(clientPrivate, curve25519.Basepoint)
+	if err != nil {
+		c.t.Fatalf("error generating key: %v", err)
+	}
+	hello := append([]byte{udpPacketHello}, clientPublic...)
+	c.write(hello)
+	cookie := c.readRaw()
+	if cookie[0] != udpPacketCookie {
+		c.t.Fatalf("expected cookie, got type %v", cookie[0])
+	}
+	return clientPrivate, append(hello, cookie[1:]...)
+}
+
+func (c *udpTestClient) finishHandshake(token string, clientPrivate, challenge []byte) []byte {
+	c.t.Helper()
+	clientPublic, err := curve25519.X25519(clientPrivate, curve25519.Basepoint)
+	if err != nil {
+		c.t.Fatalf("error generating key: %v", err)
+	}
+	serverPublic := challenge[1:]
+	shared, err := curve25519.X25519(clientPrivate, serverPublic)
+	if err != nil {
+		c.t.Fatalf("error exchanging keys: %v", err)
+	}
+
+	// Send the token without its signature, proving knowledge of the signature.
+	split := strings.LastIndex(token, ".")
+	signature, err := base64.RawURLEncoding.DecodeString(token[split+1:])
+	if err != nil {
+		c.t.Fatalf("error decoding token signature: %v", err)
+	}
+	handshakeCipher, err := udpHandshakeCipher(shared, clientPublic, serverPublic)
+	if err != nil {
+		c.t.Fatalf("error deriving keys: %v", err)
+	}
+	lang := "en"
+	contents := []byte{udpPacketHandshake, byte(SessionFormatProtobuf), 0, byte(len(lang))}
+	contents = append(contents, lang...)
+	sealed := newUdpCipher(handshakeCipher, nil).seal(append(contents, token[:split]...))
+	proofKey, clientCipher, serverCipher, err := udpSessionKeys(shared, signature, clientPublic, serverPublic)
+	if err != nil {
+		c.t.Fatalf("error deriving keys: %v", err)
+	}
+	handshake := append(sealed, udpHandshakeProof(proofKey, clientPublic, serverPublic, sealed)...)
+	c.write(handshake)
+
+	packet := c.readRaw()
+	if packet[0] == udpPacketReject {
+		return packet
+	}
+	c.cipher = newUdpCipher(clientCipher, serverCipher)
+	c.handshakePacket = handshake
+	c.acceptPacket = packet
+	accept, ok := c.cipher.open(packet)
+	if !ok {
+		c.t.Fatal("expected sealed accept")
+	}
+	return accept
+}
+
+func (c *udpTestClient) sendReliable(seq uint32, envelope *rtapi.Envelope) {
+	c.t.Helper()
+	packet := make([]byte, udpReliableHeaderSize)
+	packet[0] = udpPacketReliable
+	binary.BigEndian.PutUint32(packet[1:5], seq)
+	packet[5] = 1
+	c.writeSealed(append(packet, c.marshal(envelope)...))
+}
+
+func (c *udpTestClient) marshal(envelope *rtapi.Envelope) []byte {
+	c.t.Helper()
+	payload, err := proto.Marshal(envelope)
+	if err != nil {
+		c.t.Fatalf("error marshalling envelope: %v", err)
+	}
+	return payload
+}
+
+// Read the next reliable envelope, skipping acks for packets sent by the client.
+func (c *udpTestClient) readReliable() (uint32, *rtapi.Envelope) {
+	c.t.Helper()
+	for {
+		packet := c.read()
+		if packet[0] == udpPacketAck {
+			continue
+		}
+		if packet[0] != udpPacketReliable {
+			c.t.Fatalf("expected reliable packet, got type %v", packet[0])
+		}
+		envelope := &rtapi.Envelope{}
+		if err := proto.Unmarshal(packet[udpReliableHeaderSize:], envelope); err != nil {
+			c.t.Fatalf("error unmarshalling envelope: %v", err)
+		}
+		return binary.BigEndian.Uint32(packet[1:5]), envelope
+	}
+}
+
+func (c *udpTestClient) ack(seq uint32) {
+	c.t.Helper()
+	packet := make([]byte, 5)
+	packet[0] = udpPacketAck
+	binary.BigEndian.PutUint32(packet[1:], seq)
+	c.writeSealed(packet)
+}
+
+func startUdpTestAcceptor(t *testing.T) (*SocketUdpAcceptor, string, *socketTestServer) {
+	server := newSocketTestServer(t, &testMetrics{})
+
+	acceptor := NewSocketUdpAcceptor(server.logger, server.config, server.sessionRegistry, server.sessionCache, server.statusRegistry, server.matchmaker, server.tracker, server.metrics, server.runtime, protojsonMarshaler, protojsonUnmarshaler, server.pipeline)
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %v", err)
+	}
+	go func() {
+		if err := acceptor.Serve(conn); err != nil {
+			t.Errorf("error serving: %v", err)
+		}
+	}()
+	t.Cleanup(acceptor.Stop)
+
+	return acceptor, conn.LocalAddr().String(), server
+}
+
+func newUdpTestClient(t *testing.T, addr string) *udpTestClient {
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		t.Fatalf("error dialling: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return &udpTestClient{t: t, conn: conn}
+}
+
+// should only accept handshakes proving knowledge of a valid session token, and answer only the same handshake again
+func TestSocketUdpHandshake(t *testing.T) {
+	acceptor, addr, server := startUdpTestAcceptor(t)
+
+	client := newUdpTestClient(t, addr)
+	token := server.newToken()
+	forged := token[:strings.LastIndex(token, ".")+1] + base64.RawURLEncoding.EncodeToString(make([]byte, 32))
+	if packet := client.handshake(forged); packet[0] != udpPacketReject {
+		t.Fatalf("expected handshake to be rejected, got type %v", packet[0])
+	}
+
+	packet := client.handshake(token)
+	if packet[0] != udpPacketAccept || len(packet) != 17 {
+		t.Fatalf("expected handshake to be accepted, got type %v", packet[0])
+	}
+	if sessionID := uuid.FromBytesOrNil(packet[1:]); acceptor.sessionRegistry.Get(sessionID) == nil {
+		t.Fatalf("expected session %v to be registered", sessionID)
+	}
+
+	// A repeated handshake gets the same accept, while a new one is ignored and the session carries on.
+	client.write(client.handshakePacket)
+	if accept := client.readRaw(); !bytes.Equal(accept, client.acceptPacket) {
+		t.Fatal("expected the same accept packet")
+	}
+	client.write(append([]byte{udpPacketHello}, make([]byte, curve25519.PointSize)...))
+	client.sendReliable(0, &rtapi.Envelope{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+	if _, envelope := client.readReliable(); envelope.Cid != "1" || envelope.GetPong() == nil {
+		t.Fatalf("expected pong, got %v", envelope)
+	}
+}
+
+// should keep no state for hellos without a cookie, and cap the handshakes in progress
+func TestSocketUdpPendingHandshakes(t *testing.T) {
+	acceptor, addr, server := startUdpTestAcceptor(t)
+	acceptor.maxPending = 2
+
+	// Hellos without a valid cookie only get a cookie back.
+	spoofed := newUdpTestClient(t, addr)
+	spoofed.write(append([]byte{udpPacketHello}, make([]byte, curve25519.PointSize+32)...))
+	if packet := spoofed.readRaw(); packet[0] != udpPacketCookie {
+		t.Fatalf("expected cookie, got type %v", packet[0])
+	}
+	acceptor.Lock()
+	if len(acceptor.sessions) != 0 || acceptor.pending != 0 {
+		t.Fatalf("expected no handshake state, got %v sessions and %v pending", len(acceptor.sessions), acceptor.pending)
+	}
+	acceptor.Unlock()
+
+	// Fill the handshakes in progress.
+	clients := make([]*udpTestClient, 3)
+	keys := make([][]byte, 3)
+	hellos := make([][]byte, 3)
+	for i := range clients {
+		clients[i] = newUdpTestClient(t, addr)
+		keys[i], hellos[i] = clients[i].hello()
+	}
+	challenges := make([][]byte, 2)
+	for i := range challenges {
+		clients[i].write(hellos[i])
+		challenges[i] = clients[i].readRaw()
+		if challenges[i][0] != udpPacketChallenge {
+			t.Fatalf("expected challenge, got type %v", challenges[i][0])
+		}
+	}
+
+	// Further hellos are dropped until a handshake completes.
+	clients[2].write(hellos[2])
+	if packet, err := clients[2].readWithin(200 * time.Millisecond); err == nil {
+		t.Fatalf("expected hello over the cap to be dropped, got type %v", packet[0])
+	}
+	if packet := clients[0].finishHandshake(server.newToken(), keys[0], challenges[0]); packet[0] != udpPacketAccept {
+		t.Fatalf("expected handshake to be accepted, got type %v", packet[0])
+	}
+	clients[2].write(hellos[2])
+	if packet := clients[2].readRaw(); packet[0] != udpPacketChallenge {
+		t.Fatalf("expected challenge, got type %v", packet[0])
+	}
+}
+
+// should drop packets that are replayed or were not sealed with the session key
+func TestSocketUdpReplay(t *testing.T) {
+	_, addr, server := startUdpTestAcceptor(t)
+
+	client := newUdpTestClient(t, addr)
+	if packet := client.handshake(server.newToken()); packet[0] != udpPacketAccept {
+		t.Fatalf("expected handshake to be accepted, got type %v", packet[0])
+	}
+
+	ping := func(cid string) []byte {
+		payload := client.marshal(&rtapi.Envelope{Cid: cid, Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+		return client.cipher.seal(append([]byte{udpPacketUnreliable}, payload...))
+	}
+	replayed := ping("1")
+	client.write(replayed)
+	client.write(replayed)
+	tampered := ping("2")
+	tampered[len(tampered)-1] ^= 1
+	client.write(tampered)
+	client.write(ping("3"))
+
+	// Only the first and last pings are answered, skipping retransmissions of pongs already read.
+	var next uint32
+	for _, cid := range []string{"1", "3"} {
+		seq, envelope := client.readReliable()
+		for seq < next {
+			seq, envelope = client.readReliable()
+		}
+		if envelope.Cid != cid || envelope.GetPong() == nil {
+			t.Fatalf("expected pong %v, got %v", cid, envelope)
+		}
+		client.ack(seq)
+		next = seq + 1
+	}
+}
+
+// should accept sealed packets once each, in any order within the replay window
+func TestSocketUdpReplayWindow(t *testing.T) {
+	key := make([]byte, 32)
+	aead, err := newUdpAEAD(key)
+	if err != nil {
+		t.Fatalf("error creating cipher: %v", err)
+	}
+	sender := newUdpCipher(aead, nil)
+	receiver := newUdpCipher(nil, aead)
+
+	packets := make([][]byte, udpReplayWindow+3)
+	for i := range packets {
+		packets[i] = sender.seal([]byte{udpPacketUnreliable, byte(i)})
+	}
+
+	for _, i := range []int{1, 0, udpReplayWindow} {
+		if packet, ok := receiver.open(packets[i]); !ok || packet[1] != byte(i) {
+			t.Fatalf("expected packet %v to open", i)
+		}
+	}
+	for _, i := range []int{1, udpReplayWindow} {
+		if _, ok := receiver.open(packets[i]); ok {
+			t.Fatalf("expected replay of packet %v to be dropped", i)
+		}
+	}
+	if _, ok := receiver.open(packets[udpReplayWindow+2]); !ok {
+		t.Fatal("expected newest packet to open")
+	}
+	// Packet 2 was never received, but is now too old to tell apart from a replay.
+	if _, ok := receiver.open(packets[2]); ok {
+		t.Fatal("expected packet older than the window to be dropped")
+	}
+	if _, ok := receiver.open(packets[3]); !ok {
+		t.Fatal("expected packet within the window to open")
+	}
+
+	tampered := append([]byte{}, packets[udpReplayWindow+1]...)
+	tampered[len(tampered)-1] ^= 1
+	if _, ok := receiver.open(tampered); ok {
+		t.Fatal("expected tampered packet to be dropped")
+	}
+	if _, ok := receiver.open(packets[udpReplayWindow+1]); !ok {
+		t.Fatal("expected untampered packet to open")
+	}
+}
+
+// should deliver reliable messages in order// should deliver reliable messages in order, and retransmit them until they are acknowledged
+func TestSocketUdpReliable(t *testing.T) {
+	_, addr, server := startUdpTestAcceptor(t)
+
+	client := newUdpTestClient(t, addr)
+	if packet := client.handshake(server.newToken()); packet[0] != udpPacketAccept {
+		t.Fatalf("expected handshake to be accepted, got type %v", packet[0])
+	}
+
+	// Send two pings out of order, they must still be processed in order.
+	client.sendReliable(1, &rtapi.Envelope{Cid: "2", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+	client.sendReliable(0, &rtapi.Envelope{Cid: "1", Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}})
+
+	seq, envelope := client.readReliable()
+	if seq != 0 || envelope.Cid != "1" || envelope.GetPong() == nil {
+		t.Fatalf("expected first pong, got %v %v", seq, envelope)
+	}
+	client.ack(seq)
+	seq, envelope = client.readReliable()
+	if seq != 1 || envelope.Cid != "2" || envelope.GetPong() == nil {
+		t.Fatalf("expected second pong, got %v %v", seq, envelope)
+	}
+
+	// The second pong was not acknowledged, so it is sent again.
+	if retransmitSeq, _ := client.readReliable(); retransmitSeq != seq {
+		t.Fatalf("expected retransmission of %v, got %v", seq, retransmitSeq)
+	}
+	client.ack(seq)
+}
+
+// should limit packets in flight to the congestion window, growing it on acks and shrinking it on loss
+func TestSocketUdpCongestionWindow(t *testing.T) {
+	sender := newUdpReliableSender(100)
+	for i := 0; i < 10; i++ {
+		sender.enqueue([]byte("message"), 1200)
+	}
+
+	now := time.Now()
+	if packets := sender.next(now); len(packets) != udpInitialWindow {
+		t.Fatalf("expected %v packets in flight, got %v", udpInitialWindow, len(packets))
+	}
+
+	// Each ack in slow start grows the window by one packet, letting two more packets out.
+	sender.ack(0, now.Add(10*time.Millisecond))
+	if packets := sender.next(now); len(packets) != 2 {
+		t.Fatalf("expected 2 more packets in flight, got %v", len(packets))
+	}
+
+	// Loss halves the window.
+	packets, ok := sender.expired(now.Add(time.Second), 5*time.Second)
+	if !ok || len(packets) != udpInitialWindow+1 {
+		t.Fatalf("expected %v retransmissions, got %v", udpInitialWindow+1, len(packets))
+	}
+	if sender.cwnd != (udpInitialWindow+1)/2.0 {
+		t.Fatalf("expected window %v, got %v", (udpInitialWindow+1)/2.0, sender.cwnd)
+	}
+
+	// Packets that stay unacknowledged too long fail the connection.
+	if _, ok := sender.expired(now.Add(10*time.Second), 5*time.Second); ok {
+		t.Fatal("expected delivery to time out")
+	}
+}
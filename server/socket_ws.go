@@ -15,22 +15,100 @@
 package server
 
 import (
+	"bufio"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
+	jwt "github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/websocket"
+	"github.com/heroiclabs/nakama-common/api"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// wsCountingConn counts the bytes written to a WebSocket connection, to measure the size of compressed messages.
+type wsCountingConn struct {
+	net.Conn
+	written *atomic.Int64
+}
+
+func (c *wsCountingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.written.Add(int64(n))
+	return n, err
+}
+
+// wsCountingResponseWriter hands the WebSocket upgrade a hijacked connection that counts the bytes written to it.
+type wsCountingResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w *wsCountingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not implement http.Hijacker")
+	}
+	conn, rw, err := h.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &wsCountingConn{Conn: conn, written: atomic.NewInt64(0)}, rw, nil
+}
+
+// Issue a token the client may use to resume the given session, valid until the session token expires.
+func generateSessionResumeToken(hmacSecretByte []byte, sessionID, userID uuid.UUID, expiry int64) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sid": sessionID.String(),
+		"uid": userID.String(),
+		"exp": expiry,
+	})
+	return token.SignedString(hmacSecretByte)
+}
+
+func parseSessionResumeToken(hmacSecretByte []byte, tokenString string, userID uuid.UUID) (sessionID uuid.UUID, ok bool) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if s, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || s.Hash != crypto.SHA256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return hmacSecretByte, nil
+	})
+	if err != nil {
+		return
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return uuid.Nil, false
+	}
+	// Sessions may only be resumed by the user they belong to.
+	if uid, _ := claims["uid"].(string); uid != userID.String() {
+		return uuid.Nil, false
+	}
+	sid, _ := claims["sid"].(string)
+	sessionID, err = uuid.FromString(sid)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return sessionID, true
+}
+
 func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry SessionRegistry, sessionCache SessionCache, statusRegistry *StatusRegistry, matchmaker Matchmaker, tracker Tracker, metrics Metrics, runtime *Runtime, protojsonMarshaler *protojson.MarshalOptions, protojsonUnmarshaler *protojson.UnmarshalOptions, pipeline *Pipeline) func(http.ResponseWriter, *http.Request) {
 	upgrader := &websocket.Upgrader{
 		ReadBufferSize:  config.GetSocket().ReadBufferSizeBytes,
 		WriteBufferSize: config.GetSocket().WriteBufferSizeBytes,
 		CheckOrigin:     func(r *http.Request) bool { return true },
+		// Compression is only applied to outgoing messages if it is enabled for the session format.
+		EnableCompression: config.GetSocket().CompressionJson || config.GetSocket().CompressionProtobuf,
 	}
 
 	sessionIdGen := uuid.NewGenWithHWAF(func() (net.HardwareAddr, error) {
@@ -45,6 +123,8 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 		switch r.URL.Query().Get("format") {
 		case "protobuf":
 			format = SessionFormatProtobuf
+		case "msgpack":
+			format = SessionFormatMsgpack
 		case "json":
 			fallthrough
 		case "":
@@ -85,6 +165,40 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 			lang = langParam
 		}
 
+		// Check if the client is resuming a suspended session, or wants a new session to be resumable.
+		var resumeSession *sessionWS
+		var resumeSeq int64
+		if resumeToken := r.URL.Query().Get("resume"); resumeToken != "" {
+			sessionID, ok := parseSessionResumeToken([]byte(config.GetSession().EncryptionKey), resumeToken, userID)
+			if !ok {
+				http.Error(w, "Invalid resume token", 401)
+				return
+			}
+			resumeSession, ok = sessionRegistry.Get(sessionID).(*sessionWS)
+			if !ok || resumeSession.Format() != format {
+				http.Error(w, "Session not found", 404)
+				return
+			}
+			var err error
+			if resumeSeq, err = strconv.ParseInt(r.URL.Query().Get("seq"), 10, 64); err != nil || resumeSeq < 0 {
+				http.Error(w, "Invalid seq parameter", 400)
+				return
+			}
+		}
+		resumable, _ := strconv.ParseBool(r.URL.Query().Get("resumable"))
+		resumable = resumable && config.GetSession().ResumeGraceSec > 0
+
+		// Check if compression is enabled for this format, and offered by the client.
+		compress := config.GetSocket().CompressionProtobuf
+		if format == SessionFormatJson {
+			compress = config.GetSocket().CompressionJson
+		}
+		compress = compress && strings.Contains(strings.Join(r.Header.Values("Sec-Websocket-Extensions"), ","), "permessage-deflate")
+		if compress {
+			// Compressed sessions count the bytes written to their connection.
+			w = &wsCountingResponseWriter{ResponseWriter: w}
+		}
+
 		// Upgrade to WebSocket.
 		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
@@ -92,6 +206,33 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 			logger.Debug("Could not upgrade to WebSocket", zap.Error(err))
 			return
 		}
+		if compress {
+			// Level is validated by config checks.
+			_ = conn.SetCompressionLevel(config.GetSocket().CompressionLevel)
+		} else {
+			// Compression may still have been negotiated for the other format, never use it here.
+			conn.EnableWriteCompression(false)
+		}
+
+		if resumeSession != nil {
+			// Continue the suspended session on this connection, it keeps its ID, presences and match memberships.
+			if err := resumeSession.resume(conn, resumeSeq); err != nil {
+				logger.Debug("Could not resume session", zap.Error(err))
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error()), time.Now().Add(time.Duration(config.GetSocket().WriteWaitMs)*time.Millisecond))
+				_ = conn.Close()
+				return
+			}
+
+			metrics.CountWebsocketOpened(1)
+			resumeSession.consume(conn)
+			metrics.CountWebsocketClosed(1)
+			metrics.GaugeOnlineStatus(userID,
+				len(tracker.ListLocalSessionIDByStream(PresenceStream{
+					Mode: StreamModeNotifications, Subject: userID,
+				})) > 0,
+			)
+			return
+		}
 
 		clientIP, clientPort := extractClientAddressFromRequest(logger, r)
 		status, _ := strconv.ParseBool(r.URL.Query().Get("status"))
@@ -104,7 +245,7 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 		metrics.GaugeOnlineStatus(userID, true)
 
 		// Wrap the connection for application handling.
-		session := NewSessionWS(logger, config, format, sessionID, userID, username, vars, expiry, clientIP, clientPort, lang, protojsonMarshaler, protojsonUnmarshaler, conn, sessionRegistry, statusRegistry, matchmaker, tracker, metrics, pipeline, runtime)
+		session := NewSessionWS(logger, config, format, sessionID, userID, username, vars, expiry, clientIP, clientPort, lang, protojsonMarshaler, protojsonUnmarshaler, conn, resumable, sessionRegistry, statusRegistry, matchmaker, tracker, metrics, pipeline, runtime)
 
 		// Add to the session registry.
 		sessionRegistry.Add(session)
@@ -133,6 +274,28 @@ func NewSocketWsAcceptor(logger *zap.Logger, config Config, sessionRegistry Sess
 			go sessionRegistry.SingleSession(session.Context(), tracker, userID, sessionID)
 		}
 
+		if resumable {
+			// Give the client the token it needs to resume this session if its connection is lost.
+			resumeToken, err := generateSessionResumeToken([]byte(config.GetSession().EncryptionKey), sessionID, userID, expiry)
+			if err != nil {
+				logger.Error("Error signing session resume token", zap.Error(err))
+			} else {
+				content, _ := json.Marshal(map[string]interface{}{
+					"token":     resumeToken,
+					"grace_sec": config.GetSession().ResumeGraceSec,
+				})
+				session.Send(&rtapi.Envelope{Message: &rtapi.Envelope_Notifications{Notifications: &rtapi.Notifications{
+					Notifications: []*api.Notification{{
+						Id:         uuid.Must(uuid.NewV4()).String(),
+						Subject:    "session_resume",
+						Content:    string(content),
+						Code:       NotificationCodeSessionResume,
+						CreateTime: &timestamppb.Timestamp{Seconds: time.Now().UTC().Unix()},
+					}},
+				}}}, true)
+			}
+		}
+
 		// Allow the server to begin processing incoming messages from this session.
 		session.Consume()
 
@@ -0,0 +1,251 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/heroiclabs/nakama-common/rtapi"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// socketTestServer holds everything needed to accept real-time sessions in tests, without a database.
+type socketTestServer struct {
+	logger          *zap.Logger
+	config          Config
+	metrics         Metrics
+	sessionRegistry SessionRegistry
+	sessionCache    SessionCache
+	statusRegistry  *StatusRegistry
+	matchmaker      Matchmaker
+	tracker         Tracker
+	runtime         *Runtime
+	pipeline        *Pipeline
+}
+
+func newSocketTestServer(t *testing.T, metrics Metrics) *socketTestServer {
+	logger := loggerForTest(t)
+	cfg := NewConfig(logger)
+	cfg.Runtime.Path = t.TempDir()
+
+	matchmaker, cleanup, err := createTestMatchmaker(t, logger, false, nil)
+	if err != nil {
+		t.Fatalf("error creating test matchmaker: %v", err)
+	}
+	t.Cleanup(func() { _ = cleanup() })
+
+	runtime, _, err := NewRuntime(context.Background(), logger, logger, nil, protojsonMarshaler, protojsonUnmarshaler, cfg, "",
+		nil, nil, nil, nil, &testSessionRegistry{}, nil, nil, nil, &testTracker{}, &testMetrics{}, nil, &testMessageRouter{}, nil)
+	if err != nil {
+		t.Fatalf("error creating runtime: %v", err)
+	}
+
+	sessionRegistry := NewLocalSessionRegistry(&testMetrics{})
+	sessionCache := NewLocalSessionCache(cfg.GetSession().TokenExpirySec)
+	t.Cleanup(sessionCache.Stop)
+	statusRegistry := NewStatusRegistry(logger, cfg, sessionRegistry, protojsonMarshaler)
+	t.Cleanup(statusRegistry.Stop)
+	tracker := &testTracker{}
+
+	return &socketTestServer{
+		logger:          logger,
+		config:          cfg,
+		metrics:         metrics,
+		sessionRegistry: sessionRegistry,
+		sessionCache:    sessionCache,
+		statusRegistry:  statusRegistry,
+		matchmaker:      matchmaker,
+		tracker:         tracker,
+		runtime:         runtime,
+		pipeline:        NewPipeline(logger, cfg, nil, protojsonMarshaler, protojsonUnmarshaler, sessionRegistry, statusRegistry, nil, nil, nil, matchmaker, tracker, &testMessageRouter{}, metrics, runtime),
+	}
+}
+
+// Create a valid session token for a new user.
+func (s *socketTestServer) newToken() string {
+	userID := uuid.Must(uuid.NewV4())
+	token, exp := generateToken(s.config, userID.String(), "alice", nil)
+	s.sessionCache.Add(userID, exp, token, 0, "")
+	return token
+}
+
+type wsTestSentBytes struct {
+	sentBytes       int64
+	compressedBytes int64
+}
+
+// wsTestMetrics captures the size of each message sent.
+type wsTestMetrics struct {
+	testMetrics
+	sentCh chan *wsTestSentBytes
+}
+
+func (m *wsTestMetrics) MessageBytesSent(sentBytes, compressedBytes int64) {
+	m.sentCh <- &wsTestSentBytes{sentBytes: sentBytes, compressedBytes: compressedBytes}
+}
+
+// should compress outgoing messages over the size threshold for formats with compression enabled
+func TestSocketWsCompression(t *testing.T) {
+	metrics := &wsTestMetrics{sentCh: make(chan *wsTestSentBytes, 10)}
+	server := newSocketTestServer(t, metrics)
+
+	httpServer := httptest.NewServer(http.HandlerFunc(NewSocketWsAcceptor(server.logger, server.config, server.sessionRegistry, server.sessionCache, server.statusRegistry, server.matchmaker, server.tracker, server.metrics, server.runtime, protojsonMarshaler, protojsonUnmarshaler, server.pipeline)))
+	defer httpServer.Close()
+
+	dial := func(format string) *websocket.Conn {
+		t.Helper()
+		dialer := &websocket.Dialer{EnableCompression: true}
+		conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+"?format="+format+"&token="+server.newToken(), nil)
+		if err != nil {
+			t.Fatalf("error dialling: %v", err)
+		}
+		return conn
+	}
+
+	ping := func(conn *websocket.Conn, format, cid string) *wsTestSentBytes {
+		t.Helper()
+		envelope := &rtapi.Envelope{Cid: cid, Message: &rtapi.Envelope_Ping{Ping: &rtapi.Ping{}}}
+		messageType := websocket.TextMessage
+		marshal := protojsonMarshaler.Marshal
+		if format == "protobuf" {
+			messageType = websocket.BinaryMessage
+			marshal = proto.Marshal
+		}
+		payload, err := marshal(envelope)
+		if err != nil {
+			t.Fatalf("error marshalling ping: %v", err)
+		}
+		if err := conn.WriteMessage(messageType, payload); err != nil {
+			t.Fatalf("error sending ping: %v", err)
+		}
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Fatalf("error reading pong: %v", err)
+		}
+		select {
+		case sent := <-metrics.sentCh:
+			return sent
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected message bytes sent")
+			return nil
+		}
+	}
+
+	largeCid := strings.Repeat("a", 1024)
+
+	jsonConn := dial("json")
+	defer jsonConn.Close()
+	if sent := ping(jsonConn, "json", largeCid); sent.compressedBytes <= 0 || sent.compressedBytes >= sent.sentBytes {
+		t.Fatalf("expected compressed message, got %v compressed of %v bytes", sent.compressedBytes, sent.sentBytes)
+	}
+	if sent := ping(jsonConn, "json", "1"); sent.compressedBytes != 0 {
+		t.Fatalf("expected small message to be sent uncompressed, got %v compressed bytes", sent.compressedBytes)
+	}
+
+	// Compression is off by default for protobuf.
+	protobufConn := dial("protobuf")
+	defer protobufConn.Close()
+	if sent := ping(protobufConn, "protobuf", largeCid); sent.compressedBytes != 0 {
+		t.Fatalf("expected uncompressed message, got %v compressed bytes", sent.compressedBytes)
+	}
+}
+
+// should keep a resumable session through a lost connection, and replay messages the client missed when it resumes
+func TestSocketWsResume(t *testing.T) {
+	server := newSocketTestServer(t, &testMetrics{})
+	server.config.GetSession().ResumeGraceSec = 10
+
+	httpServer := httptest.NewServer(http.HandlerFunc(NewSocketWsAcceptor(server.logger, server.config, server.sessionRegistry, server.sessionCache, server.statusRegistry, server.matchmaker, server.tracker, server.metrics, server.runtime, protojsonMarshaler, protojsonUnmarshaler, server.pipeline)))
+	defer httpServer.Close()
+	token := server.newToken()
+	userID, _, _, _, _, _ := parseToken([]byte(server.config.GetSession().EncryptionKey), token)
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "?token=" + token
+
+	read := func(conn *websocket.Conn) *rtapi.Envelope {
+		t.Helper()
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("error reading message: %v", err)
+		}
+		envelope := &rtapi.Envelope{}
+		if err := protojsonUnmarshaler.Unmarshal(data, envelope); err != nil {
+			t.Fatalf("error unmarshalling envelope: %v", err)
+		}
+		return envelope
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"&resumable=true", nil)
+	if err != nil {
+		t.Fatalf("error dialling: %v", err)
+	}
+	notifications := read(conn).GetNotifications().GetNotifications()
+	if len(notifications) != 1 || notifications[0].Code != NotificationCodeSessionResume {
+		t.Fatalf("expected session resume notification, got %v", notifications)
+	}
+	var content struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal([]byte(notifications[0].Content), &content); err != nil {
+		t.Fatalf("error unmarshalling notification content: %v", err)
+	}
+
+	// Drop the connection without a close frame.
+	if err := conn.UnderlyingConn().Close(); err != nil {
+		t.Fatalf("error closing connection: %v", err)
+	}
+	sessionID, _ := parseSessionResumeToken([]byte(server.config.GetSession().EncryptionKey), content.Token, userID)
+	session, ok := server.sessionRegistry.Get(sessionID).(*sessionWS)
+	if !ok {
+		t.Fatalf("expected session %v to be registered", sessionID)
+	}
+	for i := 0; ; i++ {
+		session.Lock()
+		suspended := session.suspended
+		session.Unlock()
+		if suspended {
+			break
+		}
+		if i == 100 {
+			t.Fatal("expected session to be suspended")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Messages sent while suspended are buffered for the client.
+	session.Send(&rtapi.Envelope{Cid: "missed", Message: &rtapi.Envelope_Pong{Pong: &rtapi.Pong{}}}, true)
+
+	if _, _, err := websocket.DefaultDialer.Dial(url+"&resume=invalid&seq=1", nil); err == nil {
+		t.Fatal("expected invalid resume token to be rejected")
+	}
+	conn, _, err = websocket.DefaultDialer.Dial(url+"&resume="+content.Token+"&seq=1", nil)
+	if err != nil {
+		t.Fatalf("error resuming: %v", err)
+	}
+	defer conn.Close()
+	if envelope := read(conn); envelope.Cid != "missed" {
+		t.Fatalf("expected missed message to be replayed, got %v", envelope)
+	}
+	if server.sessionRegistry.Get(sessionID) != session {
+		t.Fatal("expected resumed session to stay registered")
+	}
+}
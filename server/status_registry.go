@@ -111,6 +111,7 @@ func NewStatusRegistry(logger *zap.Logger, config Config, sessionRegistry Sessio
 
 				// Prepare payload variables but do not initialize until we hit a session that needs them to avoid unnecessary work.
 				var payloadProtobuf []byte
+				var payloadMsgpack []byte
 				var payloadJSON []byte
 				envelope := &rtapi.Envelope{Message: &rtapi.Envelope_StatusPresenceEvent{StatusPresenceEvent: &rtapi.StatusPresenceEvent{
 					Joins:  e.joins,
@@ -137,6 +138,16 @@ func NewStatusRegistry(logger *zap.Logger, config Config, sessionRegistry Sessio
 							}
 						}
 						err = session.SendBytes(payloadProtobuf, true)
+					case SessionFormatMsgpack:
+						if payloadMsgpack == nil {
+							// Marshal the payload now that we know this format is needed.
+							payloadMsgpack, err = msgpackMarshal(envelope)
+							if err != nil {
+								s.logger.Error("Could not marshal status event", zap.Error(err))
+								return
+							}
+						}
+						err = session.SendBytes(payloadMsgpack, true)
 					case SessionFormatJson:
 						fallthrough
 					default:
@@ -149,7 +160,7 @@ func NewStatusRegistry(logger *zap.Logger, config Config, sessionRegistry Sessio
 								return
 							}
 						}
-						err = session.SendBytes(payloadJSON, true)
+						err = sessionSendJSON(session, envelope, payloadJSON, true)
 					}
 					if err != nil {
 						s.logger.Error("Failed to deliver status event", zap.String("sid", sessionID.String()), zap.Error(err))
@@ -0,0 +1,75 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import "time"
+
+// TokenBucket allows up to burst events at once, refilled at rate events per second. It is not safe for concurrent use.
+type TokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a full token bucket.
+func NewTokenBucket(rate float64, burst int, now time.Time) *TokenBucket {
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// Allow takes a token if one is available.
+func (b *TokenBucket) Allow(now time.Time) bool {
+	if !b.Available(now) {
+		return false
+	}
+	b.Take()
+	return true
+}
+
+// Available refills the bucket up to the given time, and reports whether a token can be taken.
+func (b *TokenBucket) Available(now time.Time) bool {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	return b.tokens >= 1
+}
+
+// Delay refills the bucket up to the given time, and reports how long until a token can be taken.
+func (b *TokenBucket) Delay(now time.Time) time.Duration {
+	if b.Available(now) {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// Take removes a token, expected to follow a successful check for availability.
+func (b *TokenBucket) Take() {
+	b.tokens--
+}
+
+// Full refills the bucket up to the given time, and reports whether it holds its whole burst again.
+func (b *TokenBucket) Full(now time.Time) bool {
+	b.Available(now)
+	return b.tokens >= b.burst
+}
@@ -61,6 +61,8 @@ type PresenceMeta struct {
 	Username    string
 	Status      string
 	Reason      uint32
+	// Only set for authoritative match presences.
+	Spectator bool
 }
 
 func (pm *PresenceMeta) GetHidden() bool {
@@ -128,6 +130,8 @@ type Tracker interface {
 	SetMatchLeaveListener(func(id uuid.UUID, leaves []*MatchPresence))
 	SetPartyJoinListener(func(id uuid.UUID, joins []*Presence))
 	SetPartyLeaveListener(func(id uuid.UUID, leaves []*Presence))
+	SetMatchRelayedJoinListener(func(id uuid.UUID, joins []*Presence))
+	SetMatchRelayedLeaveListener(func(id uuid.UUID, leaves []*Presence))
 	Stop()
 
 	// Track returns success true/false, and new presence true/false.
@@ -183,6 +187,10 @@ type LocalTracker struct {
 	matchLeaveListener func(id uuid.UUID, leaves []*MatchPresence)
 	partyJoinListener  func(id uuid.UUID, joins []*Presence)
 	partyLeaveListener func(id uuid.UUID, leaves []*Presence)
+	// Relayed match listeners are optional, and only set if relayed match hosts are elected.
+	matchRelayedJoinListener  func(id uuid.UUID, joins []*Presence)
+	matchRelayedLeaveListener func(id uuid.UUID, leaves []*Presence)
+
 	sessionRegistry    SessionRegistry
 	statusRegistry     *StatusRegistry
 	metrics            Metrics
@@ -250,6 +258,14 @@ func (t *LocalTracker) SetPartyLeaveListener(f func(id uuid.UUID, leaves []*Pres
 	t.partyLeaveListener = f
 }
 
+func (t *LocalTracker) SetMatchRelayedJoinListener(f func(id uuid.UUID, joins []*Presence)) {
+	t.matchRelayedJoinListener = f
+}
+
+func (t *LocalTracker) SetMatchRelayedLeaveListener(f func(id uuid.UUID, leaves []*Presence)) {
+	t.matchRelayedLeaveListener = f
+}
+
 func (t *LocalTracker) Stop() {
 	// No need to explicitly clean up the events channel, just let the application exit.
 	t.ctxCancelFn()
@@ -919,6 +935,10 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 	partyJoins := make(map[uuid.UUID][]*Presence, 0)
 	partyLeaves := make(map[uuid.UUID][]*Presence, 0)
 
+	// Track grouped relayed match joins and leaves separately from client-bound events.
+	matchRelayedJoins := make(map[uuid.UUID][]*Presence, 0)
+	matchRelayedLeaves := make(map[uuid.UUID][]*Presence, 0)
+
 	for _, p := range e.Joins {
 		pWire := &rtapi.UserPresence{
 			UserId:      p.UserID.String(),
@@ -930,10 +950,13 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 			// Status field is only populated for status stream presences.
 			pWire.Status = &wrapperspb.StringValue{Value: p.Meta.Status}
 		}
-		if j, ok := streamJoins[p.Stream]; ok {
-			streamJoins[p.Stream] = append(j, pWire)
-		} else {
-			streamJoins[p.Stream] = []*rtapi.UserPresence{pWire}
+		// Spectators do not appear in match presence events.
+		if !p.Meta.Spectator {
+			if j, ok := streamJoins[p.Stream]; ok {
+				streamJoins[p.Stream] = append(j, pWire)
+			} else {
+				streamJoins[p.Stream] = []*rtapi.UserPresence{pWire}
+			}
 		}
 
 		// We only care about authoritative match joins where the match host is the current node.
@@ -944,6 +967,7 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				SessionID: p.ID.SessionID,
 				Username:  p.Meta.Username,
 				Reason:    runtime.PresenceReason(syncAtomic.LoadUint32(&p.Meta.Reason)),
+				Spectator: p.Meta.Spectator,
 			}
 			if j, ok := matchJoins[p.Stream.Subject]; ok {
 				matchJoins[p.Stream.Subject] = append(j, mp)
@@ -961,6 +985,15 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				partyJoins[p.Stream.Subject] = []*Presence{c}
 			}
 		}
+
+		if p.Stream.Mode == StreamModeMatchRelayed && t.matchRelayedJoinListener != nil {
+			c := p
+			if j, ok := matchRelayedJoins[p.Stream.Subject]; ok {
+				matchRelayedJoins[p.Stream.Subject] = append(j, c)
+			} else {
+				matchRelayedJoins[p.Stream.Subject] = []*Presence{c}
+			}
+		}
 	}
 	for _, p := range e.Leaves {
 		pWire := &rtapi.UserPresence{
@@ -973,10 +1006,13 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 			// Status field is only populated for status stream presences.
 			pWire.Status = &wrapperspb.StringValue{Value: p.Meta.Status}
 		}
-		if l, ok := streamLeaves[p.Stream]; ok {
-			streamLeaves[p.Stream] = append(l, pWire)
-		} else {
-			streamLeaves[p.Stream] = []*rtapi.UserPresence{pWire}
+		// Spectators do not appear in match presence events.
+		if !p.Meta.Spectator {
+			if l, ok := streamLeaves[p.Stream]; ok {
+				streamLeaves[p.Stream] = append(l, pWire)
+			} else {
+				streamLeaves[p.Stream] = []*rtapi.UserPresence{pWire}
+			}
 		}
 
 		// We only care about authoritative match leaves where the match host is the current node.
@@ -987,6 +1023,7 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				SessionID: p.ID.SessionID,
 				Username:  p.Meta.Username,
 				Reason:    runtime.PresenceReason(syncAtomic.LoadUint32(&p.Meta.Reason)),
+				Spectator: p.Meta.Spectator,
 			}
 			if l, ok := matchLeaves[p.Stream.Subject]; ok {
 				matchLeaves[p.Stream.Subject] = append(l, mp)
@@ -1004,6 +1041,15 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 				partyLeaves[p.Stream.Subject] = []*Presence{c}
 			}
 		}
+
+		if p.Stream.Mode == StreamModeMatchRelayed && t.matchRelayedLeaveListener != nil {
+			c := p
+			if l, ok := matchRelayedLeaves[p.Stream.Subject]; ok {
+				matchRelayedLeaves[p.Stream.Subject] = append(l, c)
+			} else {
+				matchRelayedLeaves[p.Stream.Subject] = []*Presence{c}
+			}
+		}
 	}
 
 	// Notify locally hosted authoritative matches of join and leave events.
@@ -1022,6 +1068,18 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 		t.partyLeaveListener(partyID, leaves)
 	}
 
+	// Notify relayed match host election once the presence events have been delivered.
+	if len(matchRelayedJoins) != 0 || len(matchRelayedLeaves) != 0 {
+		defer func() {
+			for matchID, joins := range matchRelayedJoins {
+				t.matchRelayedJoinListener(matchID, joins)
+			}
+			for matchID, leaves := range matchRelayedLeaves {
+				t.matchRelayedLeaveListener(matchID, leaves)
+			}
+		}()
+	}
+
 	// Send joins, together with any leaves for the same stream.
 	for stream, joins := range streamJoins {
 		leaves, ok := streamLeaves[stream]
@@ -1119,6 +1177,7 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 
 		// Prepare payload variables but do not initialize until we hit a session that needs them to avoid unnecessary work.
 		var payloadProtobuf []byte
+		var payloadMsgpack []byte
 		var payloadJSON []byte
 
 		// Deliver event.
@@ -1141,6 +1200,16 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 					}
 				}
 				err = session.SendBytes(payloadProtobuf, true)
+			case SessionFormatMsgpack:
+				if payloadMsgpack == nil {
+					// Marshal the payload now that we know this format is needed.
+					payloadMsgpack, err = msgpackMarshal(envelope)
+					if err != nil {
+						t.logger.Error("Could not marshal presence event", zap.Error(err))
+						return
+					}
+				}
+				err = session.SendBytes(payloadMsgpack, true)
 			case SessionFormatJson:
 				fallthrough
 			default:
@@ -1153,7 +1222,7 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 						return
 					}
 				}
-				err = session.SendBytes(payloadJSON, true)
+				err = sessionSendJSON(session, envelope, payloadJSON, true)
 			}
 			if err != nil {
 				t.logger.Error("Failed to deliver presence event", zap.String("sid", sessionID.String()), zap.Error(err))
@@ -1253,6 +1322,7 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 
 		// Prepare payload variables but do not initialize until we hit a session that needs them to avoid unnecessary work.
 		var payloadProtobuf []byte
+		var payloadMsgpack []byte
 		var payloadJSON []byte
 
 		// Deliver event.
@@ -1275,6 +1345,16 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 					}
 				}
 				err = session.SendBytes(payloadProtobuf, true)
+			case SessionFormatMsgpack:
+				if payloadMsgpack == nil {
+					// Marshal the payload now that we know this format is needed.
+					payloadMsgpack, err = msgpackMarshal(envelope)
+					if err != nil {
+						t.logger.Error("Could not marshal presence event", zap.Error(err))
+						return
+					}
+				}
+				err = session.SendBytes(payloadMsgpack, true)
 			case SessionFormatJson:
 				fallthrough
 			default:
@@ -1287,7 +1367,7 @@ func (t *LocalTracker) processEvent(e *PresenceEvent) {
 						return
 					}
 				}
-				err = session.SendBytes(payloadJSON, true)
+				err = sessionSendJSON(session, envelope, payloadJSON, true)
 			}
 			if err != nil {
 				t.logger.Error("Failed to deliver presence event", zap.String("sid", sessionID.String()), zap.Error(err))
@@ -0,0 +1,366 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	oidcDefaultUsernameClaim    = "preferred_username"
+	oidcDefaultDisplayNameClaim = "name"
+
+	// How long signing keys are used before they are fetched again.
+	oidcKeysRefreshInterval = 60 * time.Minute
+	// Minimum time between fetches triggered by tokens signed with unknown keys, as when the provider rotates keys.
+	oidcKeysRotationInterval = time.Minute
+)
+
+var ErrOidcProviderNotFound = errors.New("oidc provider not found")
+
+// Signing algorithms accepted for ID tokens. Symmetric algorithms are never accepted, as their keys are not public.
+var oidcValidMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// OidcProviderConfig describes an OpenID Connect provider whose ID tokens are accepted.
+type OidcProviderConfig struct {
+	// Expected "iss" claim of ID tokens.
+	Issuer string
+	// Accepted "aud" claim values of ID tokens, usually the client IDs registered with the provider.
+	Audiences []string
+	// URL of the provider's signing keys. When empty it is read from the provider's discovery document.
+	JwksUrl string
+	// URL of the provider's discovery document, the issuer followed by "/.well-known/openid-configuration" when empty.
+	DiscoveryUrl string
+	// Claims mapped to the username and display name of new accounts.
+	UsernameClaim    string
+	DisplayNameClaim string
+}
+
+// OidcProfile is the identity in an ID token issued by an OpenID Connect provider.
+type OidcProfile struct {
+	Subject     string
+	Username    string
+	DisplayName string
+	Email       string
+	Claims      map[string]interface{}
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JwksUri string `json:"jwks_uri"`
+}
+
+// JWK data for an RSA or elliptic curve signing key.
+type oidcJwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type oidcJwks struct {
+	Keys []*oidcJwk `json:"keys"`
+}
+
+type oidcKey struct {
+	alg string
+	key interface{}
+}
+
+type oidcProvider struct {
+	sync.RWMutex
+	config *OidcProviderConfig
+
+	keys      map[string]*oidcKey
+	fetchedAt time.Time
+}
+
+// SetOidcProvider adds or replaces an OpenID Connect provider tokens may be checked against.
+func (c *Client) SetOidcProvider(name string, config *OidcProviderConfig) {
+	providerConfig := *config
+	if providerConfig.UsernameClaim == "" {
+		providerConfig.UsernameClaim = oidcDefaultUsernameClaim
+	}
+	if providerConfig.DisplayNameClaim == "" {
+		providerConfig.DisplayNameClaim = oidcDefaultDisplayNameClaim
+	}
+
+	c.oidcMutex.Lock()
+	if c.oidcProviders == nil {
+		c.oidcProviders = make(map[string]*oidcProvider)
+	}
+	c.oidcProviders[name] = &oidcProvider{config: &providerConfig}
+	c.oidcMutex.Unlock()
+}
+
+// CheckOidcToken verifies an ID token issued by a configured OpenID Connect provider, and returns the identity in it.
+func (c *Client) CheckOidcToken(ctx context.Context, provider, idToken string) (*OidcProfile, error) {
+	c.logger.Debug("Checking OIDC token", zap.String("provider", provider), zap.String("idToken", idToken))
+
+	c.oidcMutex.RLock()
+	p, found := c.oidcProviders[provider]
+	c.oidcMutex.RUnlock()
+	if !found {
+		return nil, ErrOidcProviderNotFound
+	}
+
+	// Find the signing key before verifying, so keys rotated by the provider can be fetched first.
+	unverified, _, err := jwt.NewParser().ParseUnverified(idToken, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("oidc id token invalid: %s", err.Error())
+	}
+	var kid string
+	if v, ok := unverified.Header["kid"]; ok {
+		if kid, ok = v.(string); !ok {
+			return nil, fmt.Errorf("oidc id token invalid kid: %v", v)
+		}
+	}
+	key, err := c.oidcKey(ctx, provider, p, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := jwt.Parse(idToken, func(token *jwt.Token) (interface{}, error) {
+		if key.alg != "" && token.Method.Alg() != key.alg {
+			return nil, fmt.Errorf("invalid alg: %v, expected %v", token.Method.Alg(), key.alg)
+		}
+		switch key.key.(type) {
+		case *rsa.PublicKey:
+			if !strings.HasPrefix(token.Method.Alg(), "RS") && !strings.HasPrefix(token.Method.Alg(), "PS") {
+				return nil, fmt.Errorf("invalid alg for rsa key: %v", token.Method.Alg())
+			}
+		case *ecdsa.PublicKey:
+			if !strings.HasPrefix(token.Method.Alg(), "ES") {
+				return nil, fmt.Errorf("invalid alg for ec key: %v", token.Method.Alg())
+			}
+		}
+
+		claims := token.Claims.(jwt.MapClaims)
+
+		// Verify the issuer.
+		if !claims.VerifyIssuer(p.config.Issuer, true) {
+			return nil, fmt.Errorf("unexpected issuer: %v", claims["iss"])
+		}
+
+		// Verify the audience matches one of the configured client IDs.
+		var audienceFound bool
+		for _, audience := range p.config.Audiences {
+			if claims.VerifyAudience(audience, true) {
+				audienceFound = true
+				break
+			}
+		}
+		if !audienceFound {
+			return nil, fmt.Errorf("unexpected audience: %v", claims["aud"])
+		}
+
+		// ID tokens must always expire.
+		if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+			return nil, fmt.Errorf("expired or missing exp: %v", claims["exp"])
+		}
+
+		return key.key, nil
+	}, jwt.WithValidMethods(oidcValidMethods))
+
+	// Check if verification attempt has failed.
+	if err != nil {
+		return nil, fmt.Errorf("oidc id token invalid: %s", err.Error())
+	} else if token == nil {
+		return nil, errors.New("oidc id token invalid")
+	}
+
+	// Extract the claims we need now that we know the token is valid.
+	claims := token.Claims.(jwt.MapClaims)
+	profile := &OidcProfile{Claims: claims}
+	if v, ok := claims["sub"]; ok {
+		if profile.Subject, ok = v.(string); !ok || profile.Subject == "" {
+			return nil, errors.New("oidc id token sub field invalid")
+		}
+	} else {
+		return nil, errors.New("oidc id token sub field missing")
+	}
+	if v, ok := claims[p.config.UsernameClaim]; ok {
+		if profile.Username, ok = v.(string); !ok {
+			return nil, fmt.Errorf("oidc id token %v field invalid", p.config.UsernameClaim)
+		}
+	}
+	if v, ok := claims[p.config.DisplayNameClaim]; ok {
+		if profile.DisplayName, ok = v.(string); !ok {
+			return nil, fmt.Errorf("oidc id token %v field invalid", p.config.DisplayNameClaim)
+		}
+	}
+	if v, ok := claims["email"]; ok {
+		if profile.Email, ok = v.(string); !ok {
+			return nil, errors.New("oidc id token email field invalid")
+		}
+	}
+
+	return profile, nil
+}
+
+// Get the provider's signing key with the given ID, fetching keys when they are due a refresh or the ID is unknown.
+func (c *Client) oidcKey(ctx context.Context, provider string, p *oidcProvider, kid string) (*oidcKey, error) {
+	now := time.Now()
+
+	p.RLock()
+	key := p.findKey(kid)
+	fetchedAt := p.fetchedAt
+	p.RUnlock()
+	if key != nil && now.Sub(fetchedAt) < oidcKeysRefreshInterval {
+		return key, nil
+	}
+	if key == nil && now.Sub(fetchedAt) < oidcKeysRotationInterval {
+		return nil, fmt.Errorf("oidc id token invalid kid: %v", kid)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if p.fetchedAt.Equal(fetchedAt) {
+		// No other request has fetched keys in the meantime.
+		keys, err := c.fetchOidcKeys(ctx, provider, p.config)
+		if err != nil {
+			if key != nil {
+				// Keep using known keys while the provider cannot be reached.
+				c.logger.Warn("Error refreshing OIDC signing keys", zap.String("provider", provider), zap.Error(err))
+				return key, nil
+			}
+			return nil, err
+		}
+		p.keys = keys
+		p.fetchedAt = now
+	}
+	if key = p.findKey(kid); key == nil {
+		return nil, fmt.Errorf("oidc id token invalid kid: %v", kid)
+	}
+	return key, nil
+}
+
+// Find a key by ID, tokens without a key ID may only be used with providers that have a single key.
+// Must be called while holding the provider lock.
+func (p *oidcProvider) findKey(kid string) *oidcKey {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key
+		}
+	}
+	return p.keys[kid]
+}
+
+func (c *Client) fetchOidcKeys(ctx context.Context, provider string, config *OidcProviderConfig) (map[string]*oidcKey, error) {
+	jwksUrl := config.JwksUrl
+	if jwksUrl == "" {
+		discoveryUrl := config.DiscoveryUrl
+		if discoveryUrl == "" {
+			discoveryUrl = strings.TrimSuffix(config.Issuer, "/") + "/.well-known/openid-configuration"
+		}
+		var discovery oidcDiscoveryDocument
+		if err := c.request(ctx, "oidc "+provider+" discovery", discoveryUrl, nil, &discovery); err != nil {
+			return nil, err
+		}
+		if discovery.Issuer != config.Issuer {
+			return nil, fmt.Errorf("oidc discovery document issuer mismatch: %v, expected %v", discovery.Issuer, config.Issuer)
+		}
+		if discovery.JwksUri == "" {
+			return nil, errors.New("oidc discovery document jwks_uri missing")
+		}
+		jwksUrl = discovery.JwksUri
+	}
+
+	var jwks oidcJwks
+	if err := c.request(ctx, "oidc "+provider+" keys", jwksUrl, nil, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*oidcKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			// Not a signing key, skip it.
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Invalid key, skip it.
+			c.logger.Debug("Skipping invalid OIDC signing key", zap.String("provider", provider), zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = &oidcKey{alg: jwk.Alg, key: key}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("error finding valid oidc %v signing key", provider)
+	}
+	return keys, nil
+}
+
+func (k *oidcJwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil || len(nBytes) == 0 {
+			return nil, errors.New("invalid modulus")
+		}
+		eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(eBytes) == 0 || len(eBytes) > 4 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(nBytes),
+			E: int(new(big.Int).SetBytes(eBytes).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %v", k.Crv)
+		}
+		xBytes, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(xBytes) == 0 {
+			return nil, errors.New("invalid x coordinate")
+		}
+		yBytes, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil || len(yBytes) == 0 {
+			return nil, errors.New("invalid y coordinate")
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(xBytes), Y: new(big.Int).SetBytes(yBytes)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("point not on curve")
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %v", k.Kty)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2023 The Nakama Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+// Local OIDC provider serving a discovery document and signing keys that can be rotated.
+type oidcProviderTest struct {
+	sync.Mutex
+	server  *httptest.Server
+	keys    map[string]interface{}
+	fetches int
+}
+
+func newOidcProviderTest(t *testing.T) *oidcProviderTest {
+	p := &oidcProviderTest{keys: make(map[string]interface{})}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&oidcDiscoveryDocument{Issuer: p.server.URL, JwksUri: p.server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		p.Lock()
+		defer p.Unlock()
+		p.fetches++
+		jwks := &oidcJwks{}
+		for kid, key := range p.keys {
+			switch key := key.(type) {
+			case *rsa.PrivateKey:
+				jwks.Keys = append(jwks.Keys, &oidcJwk{Kty: "RSA", Kid: kid, Use: "sig", Alg: "RS256",
+					N: base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+				})
+			case *ecdsa.PrivateKey:
+				jwks.Keys = append(jwks.Keys, &oidcJwk{Kty: "EC", Kid: kid, Crv: "P-256",
+					X: base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+					Y: base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+				})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(jwks)
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *oidcProviderTest) addKey(t *testing.T, kid string, ec bool) {
+	var key interface{}
+	var err error
+	if ec {
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	} else {
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	p.Lock()
+	p.keys[kid] = key
+	p.Unlock()
+}
+
+func (p *oidcProviderTest) sign(t *testing.T, kid string, claims jwt.MapClaims) string {
+	p.Lock()
+	key := p.keys[kid]
+	p.Unlock()
+	method := jwt.SigningMethod(jwt.SigningMethodRS256)
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		method = jwt.SigningMethodES256
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("error signing token: %v", err)
+	}
+	return signed
+}
+
+func (p *oidcProviderTest) claims(sub string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                p.server.URL,
+		"aud":                "game",
+		"sub":                sub,
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": "player",
+		"nickname":           "Player One",
+		"email":              "player@example.com",
+	}
+}
+
+// should verify tokens with keys from the discovery document, and map the configured claims
+func TestCheckOidcToken(t *testing.T) {
+	p := newOidcProviderTest(t)
+	p.addKey(t, "rsa", false)
+	p.addKey(t, "ec", true)
+	c := NewClient(zap.NewNop(), 5*time.Second)
+	c.SetOidcProvider("sso", &OidcProviderConfig{Issuer: p.server.URL, Audiences: []string{"web", "game"}, DisplayNameClaim: "nickname"})
+	ctx := context.Background()
+
+	profile, err := c.CheckOidcToken(ctx, "sso", p.sign(t, "rsa", p.claims("1234")))
+	if err != nil {
+		t.Fatalf("expected token to be valid, got %v", err)
+	}
+	if profile.Subject != "1234" || profile.Username != "player" || profile.DisplayName != "Player One" || profile.Email != "player@example.com" {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+	if _, err := c.CheckOidcToken(ctx, "sso", p.sign(t, "ec", p.claims("1234"))); err != nil {
+		t.Fatalf("expected ec signed token to be valid, got %v", err)
+	}
+
+	invalid := map[string]jwt.MapClaims{
+		"issuer":   {"iss": "https://other.example.com"},
+		"audience": {"aud": "other"},
+		"expired":  {"exp": time.Now().Add(-time.Minute).Unix()},
+		"subject":  {"sub": ""},
+	}
+	for name, overrides := range invalid {
+		claims := p.claims("1234")
+		for k, v := range overrides {
+			claims[k] = v
+		}
+		if _, err := c.CheckOidcToken(ctx, "sso", p.sign(t, "rsa", claims)); err == nil {
+			t.Fatalf("expected token with invalid %v to be refused", name)
+		}
+	}
+
+	if _, err := c.CheckOidcToken(ctx, "unknown", p.sign(t, "rsa", p.claims("1234"))); err != ErrOidcProviderNotFound {
+		t.Fatalf("expected unknown provider to be refused, got %v", err)
+	}
+	if p.fetches != 1 {
+		t.Fatalf("expected keys to be fetched once, got %v", p.fetches)
+	}
+}
+
+// should fetch keys again when a token is signed with an unknown key, at most once per rotation interval
+func TestCheckOidcTokenKeyRotation(t *testing.T) {
+	p := newOidcProviderTest(t)
+	p.addKey(t, "old", false)
+	c := NewClient(zap.NewNop(), 5*time.Second)
+	c.SetOidcProvider("sso", &OidcProviderConfig{Issuer: p.server.URL, Audiences: []string{"game"}, JwksUrl: p.server.URL + "/keys"})
+	ctx := context.Background()
+
+	if _, err := c.CheckOidcToken(ctx, "sso", p.sign(t, "old", p.claims("1234"))); err != nil {
+		t.Fatalf("expected token to be valid, got %v", err)
+	}
+
+	// Unknown keys are not fetched again within the rotation interval.
+	p.addKey(t, "new", false)
+	if _, err := c.CheckOidcToken(ctx, "sso", p.sign(t, "new", p.claims("1234"))); err == nil {
+		t.Fatal("expected token signed with unknown key to be refused")
+	}
+	if p.fetches != 1 {
+		t.Fatalf("expected keys to be fetched once, got %v", p.fetches)
+	}
+
+	c.oidcProviders["sso"].fetchedAt = time.Now().Add(-oidcKeysRotationInterval)
+	if _, err := c.CheckOidcToken(ctx, "sso", p.sign(t, "new", p.claims("1234"))); err != nil {
+		t.Fatalf("expected token signed with rotated key to be valid, got %v", err)
+	}
+	if p.fetches != 2 {
+		t.Fatalf("expected keys to be fetched again, got %v", p.fetches)
+	}
+}
@@ -56,6 +56,9 @@ type Client struct {
 	appleMutex          sync.RWMutex
 	appleCerts          map[string]*JwksCert
 	appleCertsRefreshAt int64
+
+	oidcMutex     sync.RWMutex
+	oidcProviders map[string]*oidcProvider
 }
 
 type JwksCerts struct {